@@ -74,3 +74,46 @@ const (
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
 )
+
+const (
+	// IndexResourceGroupKey is the key of the extra params of CreateIndex to specify the resource group of IndexNodes
+	// to build the index.
+	IndexResourceGroupKey = "resource_group"
+
+	// IndexBuildPriorityKey is the key of the extra params of CreateIndex to specify the priority of the index builds,
+	// such as the rebuilds triggered by users.
+	IndexBuildPriorityKey = "build_priority"
+
+	// IndexBuildDeadlineKey is the key of the extra params of CreateIndex to specify the unix time in seconds by which
+	// the index builds started by the CreateIndex must be finished.
+	IndexBuildDeadlineKey = "build_deadline"
+
+	// IndexShadowBuildKey is the key of the extra params of CreateIndex to mark the index builds as shadow builds.
+	IndexShadowBuildKey = "shadow_build"
+
+	// IndexPinnedNodeKey is the key of the extra params of CreateIndex to assign the index builds to the IndexNode only.
+	IndexPinnedNodeKey = "pinned_node_id"
+)
+
+// IndexBuildOptionKeys are the keys of the extra params of CreateIndex which schedule the index builds rather than
+// build the index, RootCoord moves them from the index params to the fields of BuildIndexRequest. They are not saved
+// with the index, so they neither apply to the builds of the segments flushed later nor make a different index.
+var IndexBuildOptionKeys = []string{
+	IndexResourceGroupKey,
+	IndexBuildPriorityKey,
+	IndexBuildDeadlineKey,
+	IndexShadowBuildKey,
+	IndexPinnedNodeKey,
+}
+
+// ReservedIndexParamKeys are the keys of the index params which are set by the coordinators, such as the collection
+// and the origin of the segment, they are rejected in the extra params of CreateIndex.
+var ReservedIndexParamKeys = []string{"collection_id", "segment_origin"}
+
+const (
+	// SegmentOriginFlushed is the origin of the segment flushed by DataNode, it is the default origin.
+	SegmentOriginFlushed = "flushed"
+
+	// SegmentOriginImported is the origin of the segment created by bulk insert.
+	SegmentOriginImported = "imported"
+)
@@ -218,6 +218,24 @@ func (m *meta) GetAllSegment(segID UniqueID) *SegmentInfo {
 	return nil
 }
 
+// GetCompactionTo returns the healthy segment which the segment with provided id is compacted into
+// if the segment is not compacted or the compacted segment is empty, nil will be returned
+func (m *meta) GetCompactionTo(segID UniqueID) *SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	for _, segment := range m.segments.GetSegments() {
+		if !isSegmentHealthy(segment) {
+			continue
+		}
+		for _, from := range segment.GetCompactionFrom() {
+			if from == segID {
+				return segment
+			}
+		}
+	}
+	return nil
+}
+
 // SetState setting segment with provided ID state
 func (m *meta) SetState(segmentID UniqueID, state commonpb.SegmentState) error {
 	m.Lock()
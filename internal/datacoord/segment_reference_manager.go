@@ -81,6 +81,8 @@ func generateLocKey(taskID, nodeID UniqueID) string {
 }
 
 // AddSegmentsLock adds a reference lock on segments to ensure the segments does not compaction during the reference period.
+// The lock is shared, the segments can be referenced by several tasks at the same time, the compaction and the garbage
+// collection skip the segments until all the references are released.
 func (srm *SegmentReferenceManager) AddSegmentsLock(taskID int64, segIDs []UniqueID, nodeID UniqueID) error {
 	srm.lock.Lock()
 	defer srm.lock.Unlock()
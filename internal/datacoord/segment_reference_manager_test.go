@@ -122,6 +122,23 @@ func Test_SegmentReferenceManager(t *testing.T) {
 		assert.False(t, has)
 	})
 
+	t.Run("SharedSegmentsLock", func(t *testing.T) {
+		// several tasks, such as the index builds of different fields, hold the lock of the same segment at the same time.
+		err = segRefer.AddSegmentsLock(UniqueID(20), []UniqueID{20}, nodeID)
+		assert.NoError(t, err)
+		err = segRefer.AddSegmentsLock(UniqueID(21), []UniqueID{20}, nodeID+1)
+		assert.NoError(t, err)
+		assert.True(t, segRefer.HasSegmentLock(20))
+
+		err = segRefer.ReleaseSegmentsLock(UniqueID(20), nodeID)
+		assert.NoError(t, err)
+		assert.True(t, segRefer.HasSegmentLock(20))
+
+		err = segRefer.ReleaseSegmentsLock(UniqueID(21), nodeID+1)
+		assert.NoError(t, err)
+		assert.False(t, segRefer.HasSegmentLock(20))
+	})
+
 	t.Run("ReleaseSegmentsLockByNodeID", func(t *testing.T) {
 		segIDs = []UniqueID{10, 11, 12, 13, 14, 15}
 		nodeID = 2
@@ -341,6 +341,33 @@ func TestGetSegmentStates(t *testing.T) {
 		}
 	})
 
+	t.Run("compacting and compacted segments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		segments := []*datapb.SegmentInfo{
+			{ID: 1000, CollectionID: 100, State: commonpb.SegmentState_Flushed},
+			{ID: 1001, CollectionID: 100, State: commonpb.SegmentState_Dropped},
+			{ID: 1002, CollectionID: 100, State: commonpb.SegmentState_Flushed, CreatedByCompaction: true, CompactionFrom: []int64{1001}},
+		}
+		for _, segment := range segments {
+			err := svr.meta.AddSegment(NewSegmentInfo(segment))
+			assert.Nil(t, err)
+		}
+		svr.meta.SetSegmentCompacting(1000, true)
+
+		resp, err := svr.GetSegmentStates(context.TODO(), &datapb.GetSegmentStatesRequest{
+			SegmentIDs: []int64{1000, 1001, 1003},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.EqualValues(t, 3, len(resp.States))
+		assert.True(t, resp.States[0].GetCompacting())
+		assert.EqualValues(t, commonpb.SegmentState_NotExist, resp.States[1].GetState())
+		assert.EqualValues(t, 1002, resp.States[1].GetCompactedTo())
+		assert.EqualValues(t, commonpb.SegmentState_NotExist, resp.States[2].GetState())
+		assert.EqualValues(t, 0, resp.States[2].GetCompactedTo())
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		closeTestServer(t, svr)
@@ -197,9 +197,14 @@ func (s *Server) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentSta
 		segmentInfo := s.meta.GetSegment(segmentID)
 		if segmentInfo == nil {
 			state.State = commonpb.SegmentState_NotExist
+			// the segment dropped by compaction is replaced by the segment it is compacted into
+			if compactionTo := s.meta.GetCompactionTo(segmentID); compactionTo != nil {
+				state.CompactedTo = compactionTo.GetID()
+			}
 		} else {
 			state.State = segmentInfo.GetState()
 			state.StartPosition = segmentInfo.GetStartPosition()
+			state.Compacting = segmentInfo.isCompacting
 		}
 		resp.States = append(resp.States, state)
 	}
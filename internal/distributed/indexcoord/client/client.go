@@ -240,3 +240,213 @@ func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 	}
 	return ret.(*milvuspb.GetMetricsResponse), err
 }
+
+// GetShadowIndexFilePaths gets the index file paths of the shadow builds from IndexCoord.
+func (c *Client) GetShadowIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).GetShadowIndexFilePaths(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.GetIndexFilePathsResponse), err
+}
+
+// ValidateIndexParams checks the index params of the request by IndexCoord.
+func (c *Client) ValidateIndexParams(ctx context.Context, req *indexpb.BuildIndexRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).ValidateIndexParams(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// EstimateBuildTime gets the estimated time to build the index of the request from IndexCoord.
+func (c *Client) EstimateBuildTime(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.EstimateBuildTimeResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).EstimateBuildTime(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.EstimateBuildTimeResponse), err
+}
+
+// ListIndexTasks lists the index tasks of IndexCoord.
+func (c *Client) ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).ListIndexTasks(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.ListIndexTasksResponse), err
+}
+
+// CancelIndexTask cancels the build of a segment index by IndexCoord.
+func (c *Client) CancelIndexTask(ctx context.Context, req *indexpb.CancelIndexTaskRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).CancelIndexTask(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// CancelIndexTasksOlderThan cancels the builds enqueued before the cutoff by IndexCoord.
+func (c *Client) CancelIndexTasksOlderThan(ctx context.Context, req *indexpb.CancelIndexTasksOlderThanRequest) (*indexpb.CancelIndexTasksOlderThanResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).CancelIndexTasksOlderThan(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.CancelIndexTasksOlderThanResponse), err
+}
+
+// ForceReassignIndexTask reassigns the build of a segment index by IndexCoord.
+func (c *Client) ForceReassignIndexTask(ctx context.Context, req *indexpb.ForceReassignIndexTaskRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).ForceReassignIndexTask(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// SetIndexTaskPriority changes the priority of an index task of IndexCoord.
+func (c *Client) SetIndexTaskPriority(ctx context.Context, req *indexpb.SetIndexTaskPriorityRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).SetIndexTaskPriority(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// PauseIndexTask pauses an index task of IndexCoord.
+func (c *Client) PauseIndexTask(ctx context.Context, req *indexpb.PauseIndexTaskRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).PauseIndexTask(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ResumeIndexTask resumes an index task of IndexCoord.
+func (c *Client) ResumeIndexTask(ctx context.Context, req *indexpb.ResumeIndexTaskRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).ResumeIndexTask(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ReconcileIndexTasks reconciles the index tasks of IndexCoord with the index meta.
+func (c *Client) ReconcileIndexTasks(ctx context.Context, req *indexpb.ReconcileIndexTasksRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).ReconcileIndexTasks(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// PauseIndexBuilder stops IndexCoord from assigning index tasks.
+func (c *Client) PauseIndexBuilder(ctx context.Context, req *indexpb.PauseIndexBuilderRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).PauseIndexBuilder(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ResumeIndexBuilder lets IndexCoord continue assigning index tasks.
+func (c *Client) ResumeIndexBuilder(ctx context.Context, req *indexpb.ResumeIndexBuilderRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).ResumeIndexBuilder(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// SetIndexNodeResourceGroup sets the resource group of an IndexNode by IndexCoord.
+func (c *Client) SetIndexNodeResourceGroup(ctx context.Context, req *indexpb.SetIndexNodeResourceGroupRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).SetIndexNodeResourceGroup(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// DrainIndexNode drains an IndexNode by IndexCoord.
+func (c *Client) DrainIndexNode(ctx context.Context, req *indexpb.DrainIndexNodeRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).DrainIndexNode(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
@@ -139,6 +139,111 @@ func TestIndexCoordClient(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("GetShadowIndexFilePaths", func(t *testing.T) {
+		req := &indexpb.GetIndexFilePathsRequest{}
+		resp, err := icc.GetShadowIndexFilePaths(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("ValidateIndexParams", func(t *testing.T) {
+		req := &indexpb.BuildIndexRequest{}
+		resp, err := icc.ValidateIndexParams(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("EstimateBuildTime", func(t *testing.T) {
+		req := &indexpb.BuildIndexRequest{}
+		resp, err := icc.EstimateBuildTime(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("ListIndexTasks", func(t *testing.T) {
+		req := &indexpb.ListIndexTasksRequest{}
+		resp, err := icc.ListIndexTasks(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("CancelIndexTask", func(t *testing.T) {
+		req := &indexpb.CancelIndexTaskRequest{}
+		resp, err := icc.CancelIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("CancelIndexTasksOlderThan", func(t *testing.T) {
+		req := &indexpb.CancelIndexTasksOlderThanRequest{}
+		resp, err := icc.CancelIndexTasksOlderThan(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("ForceReassignIndexTask", func(t *testing.T) {
+		req := &indexpb.ForceReassignIndexTaskRequest{}
+		resp, err := icc.ForceReassignIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexTaskPriority", func(t *testing.T) {
+		req := &indexpb.SetIndexTaskPriorityRequest{}
+		resp, err := icc.SetIndexTaskPriority(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("PauseIndexTask", func(t *testing.T) {
+		req := &indexpb.PauseIndexTaskRequest{}
+		resp, err := icc.PauseIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexTask", func(t *testing.T) {
+		req := &indexpb.ResumeIndexTaskRequest{}
+		resp, err := icc.ResumeIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ReconcileIndexTasks", func(t *testing.T) {
+		req := &indexpb.ReconcileIndexTasksRequest{}
+		resp, err := icc.ReconcileIndexTasks(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("PauseIndexBuilder", func(t *testing.T) {
+		req := &indexpb.PauseIndexBuilderRequest{}
+		resp, err := icc.PauseIndexBuilder(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexBuilder", func(t *testing.T) {
+		req := &indexpb.ResumeIndexBuilderRequest{}
+		resp, err := icc.ResumeIndexBuilder(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexNodeResourceGroup", func(t *testing.T) {
+		req := &indexpb.SetIndexNodeResourceGroupRequest{}
+		resp, err := icc.SetIndexNodeResourceGroup(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("DrainIndexNode", func(t *testing.T) {
+		req := &indexpb.DrainIndexNodeRequest{}
+		resp, err := icc.DrainIndexNode(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 
@@ -236,6 +236,81 @@ func (s *Server) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFil
 	return s.indexcoord.GetIndexFilePaths(ctx, req)
 }
 
+// GetShadowIndexFilePaths gets the index file paths of the shadow builds from IndexCoord.
+func (s *Server) GetShadowIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	return s.indexcoord.GetShadowIndexFilePaths(ctx, req)
+}
+
+// ValidateIndexParams checks the index params of the request by IndexCoord.
+func (s *Server) ValidateIndexParams(ctx context.Context, req *indexpb.BuildIndexRequest) (*commonpb.Status, error) {
+	return s.indexcoord.ValidateIndexParams(ctx, req)
+}
+
+// EstimateBuildTime gets the estimated time to build the index of the request from IndexCoord.
+func (s *Server) EstimateBuildTime(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.EstimateBuildTimeResponse, error) {
+	return s.indexcoord.EstimateBuildTime(ctx, req)
+}
+
+// ListIndexTasks lists the index tasks of IndexCoord.
+func (s *Server) ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	return s.indexcoord.ListIndexTasks(ctx, req)
+}
+
+// CancelIndexTask cancels the build of a segment index by IndexCoord.
+func (s *Server) CancelIndexTask(ctx context.Context, req *indexpb.CancelIndexTaskRequest) (*commonpb.Status, error) {
+	return s.indexcoord.CancelIndexTask(ctx, req)
+}
+
+// CancelIndexTasksOlderThan cancels the builds enqueued before the cutoff by IndexCoord.
+func (s *Server) CancelIndexTasksOlderThan(ctx context.Context, req *indexpb.CancelIndexTasksOlderThanRequest) (*indexpb.CancelIndexTasksOlderThanResponse, error) {
+	return s.indexcoord.CancelIndexTasksOlderThan(ctx, req)
+}
+
+// ForceReassignIndexTask reassigns the build of a segment index by IndexCoord.
+func (s *Server) ForceReassignIndexTask(ctx context.Context, req *indexpb.ForceReassignIndexTaskRequest) (*commonpb.Status, error) {
+	return s.indexcoord.ForceReassignIndexTask(ctx, req)
+}
+
+// SetIndexTaskPriority changes the priority of an index task of IndexCoord.
+func (s *Server) SetIndexTaskPriority(ctx context.Context, req *indexpb.SetIndexTaskPriorityRequest) (*commonpb.Status, error) {
+	return s.indexcoord.SetIndexTaskPriority(ctx, req)
+}
+
+// PauseIndexTask pauses an index task of IndexCoord.
+func (s *Server) PauseIndexTask(ctx context.Context, req *indexpb.PauseIndexTaskRequest) (*commonpb.Status, error) {
+	return s.indexcoord.PauseIndexTask(ctx, req)
+}
+
+// ResumeIndexTask resumes an index task of IndexCoord.
+func (s *Server) ResumeIndexTask(ctx context.Context, req *indexpb.ResumeIndexTaskRequest) (*commonpb.Status, error) {
+	return s.indexcoord.ResumeIndexTask(ctx, req)
+}
+
+// ReconcileIndexTasks reconciles the index tasks of IndexCoord with the index meta.
+func (s *Server) ReconcileIndexTasks(ctx context.Context, req *indexpb.ReconcileIndexTasksRequest) (*commonpb.Status, error) {
+	return s.indexcoord.ReconcileIndexTasks(ctx, req)
+}
+
+// PauseIndexBuilder stops IndexCoord from assigning index tasks.
+func (s *Server) PauseIndexBuilder(ctx context.Context, req *indexpb.PauseIndexBuilderRequest) (*commonpb.Status, error) {
+	return s.indexcoord.PauseIndexBuilder(ctx, req)
+}
+
+// ResumeIndexBuilder lets IndexCoord continue assigning index tasks.
+func (s *Server) ResumeIndexBuilder(ctx context.Context, req *indexpb.ResumeIndexBuilderRequest) (*commonpb.Status, error) {
+	return s.indexcoord.ResumeIndexBuilder(ctx, req)
+}
+
+// SetIndexNodeResourceGroup sets the resource group of an IndexNode by IndexCoord.
+func (s *Server) SetIndexNodeResourceGroup(ctx context.Context, req *indexpb.SetIndexNodeResourceGroupRequest) (*commonpb.Status, error) {
+	return s.indexcoord.SetIndexNodeResourceGroup(ctx, req)
+}
+
+// DrainIndexNode drains an IndexNode by IndexCoord.
+func (s *Server) DrainIndexNode(ctx context.Context, req *indexpb.DrainIndexNodeRequest) (*commonpb.Status, error) {
+	return s.indexcoord.DrainIndexNode(ctx, req)
+}
+
 // GetMetrics gets the metrics info of IndexCoord.
 func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.indexcoord.GetMetrics(ctx, request)
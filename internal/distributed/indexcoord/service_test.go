@@ -131,6 +131,111 @@ func TestIndexCoordinateServer(t *testing.T) {
 		assert.Equal(t, "IndexCoord", resp.ComponentName)
 	})
 
+	t.Run("GetShadowIndexFilePaths", func(t *testing.T) {
+		req := &indexpb.GetIndexFilePathsRequest{}
+		resp, err := server.GetShadowIndexFilePaths(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("ValidateIndexParams", func(t *testing.T) {
+		req := &indexpb.BuildIndexRequest{}
+		resp, err := server.ValidateIndexParams(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("EstimateBuildTime", func(t *testing.T) {
+		req := &indexpb.BuildIndexRequest{}
+		resp, err := server.EstimateBuildTime(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("ListIndexTasks", func(t *testing.T) {
+		req := &indexpb.ListIndexTasksRequest{}
+		resp, err := server.ListIndexTasks(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("CancelIndexTask", func(t *testing.T) {
+		req := &indexpb.CancelIndexTaskRequest{}
+		resp, err := server.CancelIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("CancelIndexTasksOlderThan", func(t *testing.T) {
+		req := &indexpb.CancelIndexTasksOlderThanRequest{}
+		resp, err := server.CancelIndexTasksOlderThan(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("ForceReassignIndexTask", func(t *testing.T) {
+		req := &indexpb.ForceReassignIndexTaskRequest{}
+		resp, err := server.ForceReassignIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexTaskPriority", func(t *testing.T) {
+		req := &indexpb.SetIndexTaskPriorityRequest{}
+		resp, err := server.SetIndexTaskPriority(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("PauseIndexTask", func(t *testing.T) {
+		req := &indexpb.PauseIndexTaskRequest{}
+		resp, err := server.PauseIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexTask", func(t *testing.T) {
+		req := &indexpb.ResumeIndexTaskRequest{}
+		resp, err := server.ResumeIndexTask(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ReconcileIndexTasks", func(t *testing.T) {
+		req := &indexpb.ReconcileIndexTasksRequest{}
+		resp, err := server.ReconcileIndexTasks(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("PauseIndexBuilder", func(t *testing.T) {
+		req := &indexpb.PauseIndexBuilderRequest{}
+		resp, err := server.PauseIndexBuilder(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexBuilder", func(t *testing.T) {
+		req := &indexpb.ResumeIndexBuilderRequest{}
+		resp, err := server.ResumeIndexBuilder(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexNodeResourceGroup", func(t *testing.T) {
+		req := &indexpb.SetIndexNodeResourceGroupRequest{}
+		resp, err := server.SetIndexNodeResourceGroup(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("DrainIndexNode", func(t *testing.T) {
+		req := &indexpb.DrainIndexNodeRequest{}
+		resp, err := server.DrainIndexNode(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
@@ -150,6 +150,20 @@ func (c *Client) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	return ret.(*commonpb.Status), err
 }
 
+// CancelIndex sends the cancel index request to IndexNode.
+func (c *Client) CancelIndex(ctx context.Context, req *indexpb.CancelIndexRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexNodeClient).CancelIndex(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 // GetMetrics gets the metrics info of IndexNode.
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
@@ -84,6 +84,9 @@ func Test_NewClient(t *testing.T) {
 
 		r5, err := client.GetMetrics(ctx, nil)
 		retCheck(retNotNil, r5, err)
+
+		r6, err := client.CancelIndex(ctx, nil)
+		retCheck(retNotNil, r6, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase{
@@ -179,6 +182,15 @@ func TestIndexNodeClient(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndex", func(t *testing.T) {
+		req := &indexpb.CancelIndexRequest{
+			IndexBuildID: 0,
+		}
+		resp, err := inc.CancelIndex(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
 		assert.Nil(t, err)
@@ -237,6 +237,11 @@ func (s *Server) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	return s.indexnode.CreateIndex(ctx, req)
 }
 
+// CancelIndex sends the cancel index request to IndexNode.
+func (s *Server) CancelIndex(ctx context.Context, req *indexpb.CancelIndexRequest) (*commonpb.Status, error) {
+	return s.indexnode.CancelIndex(ctx, req)
+}
+
 func (s *Server) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error) {
 	return s.indexnode.GetTaskSlots(ctx, req)
 }
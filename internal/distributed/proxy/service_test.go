@@ -307,6 +307,66 @@ func (m *MockIndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetric
 	return nil, nil
 }
 
+func (m *MockIndexCoord) GetShadowIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) ValidateIndexParams(ctx context.Context, req *indexpb.BuildIndexRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) EstimateBuildTime(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.EstimateBuildTimeResponse, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) CancelIndexTask(ctx context.Context, req *indexpb.CancelIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) CancelIndexTasksOlderThan(ctx context.Context, req *indexpb.CancelIndexTasksOlderThanRequest) (*indexpb.CancelIndexTasksOlderThanResponse, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) ForceReassignIndexTask(ctx context.Context, req *indexpb.ForceReassignIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) SetIndexTaskPriority(ctx context.Context, req *indexpb.SetIndexTaskPriorityRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) PauseIndexTask(ctx context.Context, req *indexpb.PauseIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) ResumeIndexTask(ctx context.Context, req *indexpb.ResumeIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) ReconcileIndexTasks(ctx context.Context, req *indexpb.ReconcileIndexTasksRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) PauseIndexBuilder(ctx context.Context, req *indexpb.PauseIndexBuilderRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) ResumeIndexBuilder(ctx context.Context, req *indexpb.ResumeIndexBuilderRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) SetIndexNodeResourceGroup(ctx context.Context, req *indexpb.SetIndexNodeResourceGroupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) DrainIndexNode(ctx context.Context, req *indexpb.DrainIndexNodeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockQueryCoord struct {
 	MockBase
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// AuditEvent records a state transition of an index task.
+type AuditEvent struct {
+	Time     time.Time `json:"time"`
+	BuildID  UniqueID  `json:"build_id"`
+	OldState string    `json:"old_state"`
+	NewState string    `json:"new_state"`
+	NodeID   UniqueID  `json:"node_id"` // The IndexNode which the task is assigned to, zero if the task is not assigned.
+	Reason   string    `json:"reason"`
+}
+
+// AuditSink records the state transitions of the index tasks. Record is called with the taskMutex of the index
+// builder held, so it should not block.
+type AuditSink interface {
+	Record(event *AuditEvent)
+}
+
+// logAuditSink is the default AuditSink, it writes the events as JSON to the log.
+type logAuditSink struct{}
+
+func (s logAuditSink) Record(event *AuditEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Warn("index builder marshal audit event failed", zap.Int64("buildID", event.BuildID), zap.Error(err))
+		return
+	}
+	log.Info("index task audit", zap.String("event", string(data)))
+}
+
+// transitionReason returns the reason of the state transition of the task.
+func transitionReason(task *indexTask, state indexTaskState) string {
+	switch state {
+	case indexTaskRetry, indexTaskFailed:
+		return task.failReason
+	case indexTaskDeleted:
+		return "index task is deleted"
+	case indexTaskPaused:
+		return "index task is paused"
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordAuditSink struct {
+	events []*AuditEvent
+}
+
+func (s *recordAuditSink) Record(event *AuditEvent) {
+	s.events = append(s.events, event)
+}
+
+func TestIndexBuilder_AuditSink(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	_, ok := ib.auditSink.(logAuditSink)
+	assert.True(t, ok)
+
+	sink := &recordAuditSink{}
+	ib.SetAuditSink(sink)
+
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskInProgress)
+	sink.events = nil
+
+	task.nodeID = 1
+	task.failReason = "node down"
+	ib.setTaskState(task, indexTaskRetry)
+	// the same state is not a transition.
+	ib.setTaskState(task, indexTaskRetry)
+	ib.markTaskAsDeleted(4)
+
+	assert.Equal(t, 2, len(sink.events))
+	assert.Equal(t, UniqueID(4), sink.events[0].BuildID)
+	assert.Equal(t, indexTaskInProgress.String(), sink.events[0].OldState)
+	assert.Equal(t, indexTaskRetry.String(), sink.events[0].NewState)
+	assert.Equal(t, UniqueID(1), sink.events[0].NodeID)
+	assert.Equal(t, "node down", sink.events[0].Reason)
+	assert.False(t, sink.events[0].Time.IsZero())
+	assert.Equal(t, indexTaskRetry.String(), sink.events[1].OldState)
+	assert.Equal(t, indexTaskDeleted.String(), sink.events[1].NewState)
+
+	ib.SetAuditSink(nil)
+	_, ok = ib.auditSink.(logAuditSink)
+	assert.True(t, ok)
+	logAuditSink{}.Record(&AuditEvent{BuildID: 4})
+}
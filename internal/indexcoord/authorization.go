@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+// authorizeRoot checks that the request is sent by the root user, it guards the admin rpcs which can break the index
+// builds of the whole cluster. The request carries the credential in the same header as the requests to Proxy, and it
+// is verified against the credential of the root user saved by RootCoord. Nothing is checked if the authorization is
+// disabled.
+func (i *IndexCoord) authorizeRoot(ctx context.Context) error {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ErrPermissionDenied
+	}
+	authorization := md[strings.ToLower(util.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return ErrPermissionDenied
+	}
+	// token format: base64<username:password>
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return ErrPermissionDenied
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 || secrets[0] != util.UserRoot {
+		return ErrPermissionDenied
+	}
+
+	v, err := i.metaTable.client.Load(path.Join(credentialPrefix, util.UserRoot))
+	if err != nil {
+		return wrapError(ErrPermissionDenied, err)
+	}
+	credInfo := internalpb.CredentialInfo{}
+	if err := json.Unmarshal([]byte(v), &credInfo); err != nil {
+		return wrapError(ErrPermissionDenied, err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(credInfo.EncryptedPassword), []byte(secrets[1])); err != nil {
+		return ErrPermissionDenied
+	}
+	return nil
+}
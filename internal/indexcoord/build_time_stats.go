@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// BuildTimeEstimate is the predicted time to build an index, see EstimateBuildTime.
+type BuildTimeEstimate struct {
+	Duration time.Duration
+	// SampleCount is the number of the finished builds which the estimate is based on, the estimate is more reliable
+	// with more samples.
+	SampleCount int
+	// SizeMatched means the samples are the builds of the segments of similar size, otherwise they are the builds of
+	// the segments of all sizes with the same index type.
+	SizeMatched bool
+}
+
+// buildTimeKey groups the build durations by the index type and the size class of the segment, the segments in size
+// class n have [2^(n-1), 2^n) rows.
+type buildTimeKey struct {
+	indexType string
+	sizeClass int
+}
+
+// buildTimeSample is the sum of the build durations observed for a key.
+type buildTimeSample struct {
+	count    int
+	rows     int64
+	duration time.Duration
+}
+
+// buildTimeStats keeps the durations of the finished builds to estimate the time to build new indexes. Only the sums
+// are kept for each key, so the memory is bounded by the number of index types. They are kept in memory and lost on
+// restart.
+type buildTimeStats struct {
+	lock    sync.Mutex
+	samples map[buildTimeKey]*buildTimeSample
+}
+
+func newBuildTimeStats() *buildTimeStats {
+	return &buildTimeStats{
+		samples: make(map[buildTimeKey]*buildTimeSample),
+	}
+}
+
+func sizeClass(numRows int64) int {
+	if numRows <= 0 {
+		return 0
+	}
+	return bits.Len64(uint64(numRows))
+}
+
+// observe records the duration to build the index of the segment with numRows rows.
+func (s *buildTimeStats) observe(indexType string, numRows int64, duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := buildTimeKey{indexType: indexType, sizeClass: sizeClass(numRows)}
+	sample, ok := s.samples[key]
+	if !ok {
+		sample = &buildTimeSample{}
+		s.samples[key] = sample
+	}
+	sample.count++
+	sample.rows += numRows
+	sample.duration += duration
+}
+
+// estimate predicts the duration to build the index of the segment with numRows rows by the average build time per
+// row. The builds of the segments in the same size class are preferred, since the build time is not linear to the
+// number of rows, otherwise all the builds of the index type are used. It returns false if there is no build of the
+// index type.
+func (s *buildTimeStats) estimate(indexType string, numRows int64) (BuildTimeEstimate, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if sample, ok := s.samples[buildTimeKey{indexType: indexType, sizeClass: sizeClass(numRows)}]; ok {
+		return BuildTimeEstimate{
+			Duration:    sample.predict(numRows),
+			SampleCount: sample.count,
+			SizeMatched: true,
+		}, true
+	}
+	total := &buildTimeSample{}
+	for key, sample := range s.samples {
+		if key.indexType != indexType {
+			continue
+		}
+		total.count += sample.count
+		total.rows += sample.rows
+		total.duration += sample.duration
+	}
+	if total.count == 0 {
+		return BuildTimeEstimate{}, false
+	}
+	return BuildTimeEstimate{
+		Duration:    total.predict(numRows),
+		SampleCount: total.count,
+	}, true
+}
+
+// predict returns the build time of numRows rows at the average build time per row of the sample, the average build
+// time is returned if the rows are unknown.
+func (s *buildTimeSample) predict(numRows int64) time.Duration {
+	if s.rows <= 0 || numRows <= 0 {
+		return s.duration / time.Duration(s.count)
+	}
+	return time.Duration(float64(s.duration) / float64(s.rows) * float64(numRows))
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestBuildTimeStats(t *testing.T) {
+	stats := newBuildTimeStats()
+	_, ok := stats.estimate("HNSW", 1000)
+	assert.False(t, ok)
+
+	stats.observe("HNSW", 1000, time.Second*10)
+	stats.observe("HNSW", 1000, time.Second*30)
+	stats.observe("HNSW", 100000, time.Hour)
+	stats.observe("IVF_FLAT", 1000, time.Second)
+
+	t.Run("same size class", func(t *testing.T) {
+		estimate, ok := stats.estimate("HNSW", 1000)
+		assert.True(t, ok)
+		assert.Equal(t, time.Second*20, estimate.Duration)
+		assert.Equal(t, 2, estimate.SampleCount)
+		assert.True(t, estimate.SizeMatched)
+
+		// the estimate scales with the rows in the size class.
+		estimate, ok = stats.estimate("HNSW", 600)
+		assert.True(t, ok)
+		assert.Equal(t, time.Second*12, estimate.Duration)
+	})
+
+	t.Run("other size classes", func(t *testing.T) {
+		estimate, ok := stats.estimate("HNSW", 10000)
+		assert.True(t, ok)
+		assert.Equal(t, 3, estimate.SampleCount)
+		assert.False(t, estimate.SizeMatched)
+		// the average build time per row of all the builds.
+		assert.InDelta(t, (time.Hour+time.Second*40).Seconds()/102000*10000, estimate.Duration.Seconds(), 0.001)
+	})
+
+	t.Run("unknown rows", func(t *testing.T) {
+		stats.observe("DISKANN", 0, time.Second*5)
+		stats.observe("DISKANN", 0, time.Second*15)
+		estimate, ok := stats.estimate("DISKANN", 0)
+		assert.True(t, ok)
+		assert.Equal(t, time.Second*10, estimate.Duration)
+	})
+}
+
+func Test_sizeClass(t *testing.T) {
+	assert.Equal(t, 0, sizeClass(0))
+	assert.Equal(t, 0, sizeClass(-1))
+	assert.Equal(t, 1, sizeClass(1))
+	assert.Equal(t, 10, sizeClass(1000))
+	assert.Equal(t, 10, sizeClass(600))
+	assert.Equal(t, 14, sizeClass(10000))
+}
+
+func TestIndexBuilder_BuildDuration(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	clock := newManualClock()
+	ib.clock = clock
+
+	// the task recovered from meta has no assignment time
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	assert.True(t, task.inProgressTime.IsZero())
+
+	task, ok = ib.tasks.Get(2)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskInProgress)
+	assert.False(t, task.inProgressTime.IsZero())
+	clock.Advance(time.Minute)
+
+	indexParams := []*commonpb.KeyValuePair{
+		{
+			Key:   "index_type",
+			Value: "IVF_FLAT",
+		},
+	}
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 2,
+		State:        commonpb.IndexState_Finished,
+		NodeID:       1,
+		Req: &indexpb.BuildIndexRequest{
+			IndexParams: indexParams,
+			NumRows:     1000,
+		},
+	})
+	assert.Equal(t, indexTaskDone, task.state)
+
+	m := &dto.Metric{}
+	err := metrics.IndexCoordIndexBuildDuration.WithLabelValues("IVF_FLAT").(prometheus.Histogram).Write(m)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+
+	// the build time is estimated by the finished build.
+	estimate, err := ib.EstimateBuildTime(&indexpb.BuildIndexRequest{IndexParams: indexParams, NumRows: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, estimate.Duration)
+	assert.Equal(t, 1, estimate.SampleCount)
+	assert.True(t, estimate.SizeMatched)
+	_, err = ib.EstimateBuildTime(&indexpb.BuildIndexRequest{NumRows: 1000})
+	assert.Error(t, err)
+}
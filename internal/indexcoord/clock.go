@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import "time"
+
+// clock is the source of time for the durations measured by the index builder, such as the retry backoff, the task
+// timeout and the age of the tasks. It is replaced in the unit tests to control the time.
+type clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+}
+
+// monotonicClock is the default clock. The time returned by Now carries the monotonic clock reading, which is used
+// to measure the durations instead of the wall clock, so the durations are not affected by the adjustment of the wall
+// clock, such as by NTP. The monotonic reading is lost once the time is persisted, the persisted time is only used as
+// a timestamp.
+type monotonicClock struct{}
+
+func (monotonicClock) Now() time.Time {
+	return time.Now()
+}
+
+func (monotonicClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
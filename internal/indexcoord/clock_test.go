@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// manualClock is the clock whose time only moves when it is advanced.
+type manualClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+func newManualClock() *manualClock {
+	return &manualClock{now: time.Unix(1600000000, 0)}
+}
+
+func (c *manualClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *manualClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func Test_monotonicClock(t *testing.T) {
+	c := monotonicClock{}
+	start := c.Now()
+	assert.GreaterOrEqual(t, c.Since(start), time.Duration(0))
+}
+
+func TestIndexBuilder_Clock(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	clk := newManualClock()
+	ib.clock = clk
+
+	t.Run("task timeout", func(t *testing.T) {
+		ib.taskTimeout = time.Minute
+		task, ok := ib.tasks.Get(4)
+		assert.True(t, ok)
+		task.lastActiveTime = clk.Now()
+
+		clk.Advance(time.Minute - time.Second)
+		ib.reclaimTimeoutTasks()
+		assert.Equal(t, indexTaskInProgress, task.state)
+
+		clk.Advance(time.Second)
+		ib.reclaimTimeoutTasks()
+		assert.Equal(t, indexTaskRetry, task.state)
+	})
+
+	t.Run("retry backoff", func(t *testing.T) {
+		task, ok := ib.tasks.Get(3)
+		assert.True(t, ok)
+		ib.setTaskState(task, indexTaskInProgress)
+		ib.setTaskState(task, indexTaskRetry)
+		assert.Equal(t, clk.Now(), task.lastRetryTime)
+		task.retryDelay = time.Minute
+		task.retryJitter = 0
+
+		ib.process(3)
+		assert.Equal(t, indexTaskRetry, task.state)
+
+		clk.Advance(time.Minute)
+		ib.process(3)
+		assert.Equal(t, indexTaskInit, task.state)
+	})
+}
@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// completedTaskInfo is the summary of a completed index task.
+type completedTaskInfo struct {
+	buildID UniqueID
+	// nodeID is the IndexNode which built the index, zero if the task failed before it was assigned.
+	nodeID UniqueID
+	// duration is the time from the task was added to the index builder to it was completed.
+	duration     time.Duration
+	result       commonpb.IndexState
+	completeTime time.Time
+}
+
+// completedTaskHistory keeps the summaries of the most recently completed tasks in a ring buffer, so the memory is
+// bounded by its size no matter how many tasks are completed. It has its own lock, since the tasks are completed
+// by the schedule loop and the cleanup workers without the taskMutex held.
+type completedTaskHistory struct {
+	lock  sync.Mutex
+	tasks []completedTaskInfo
+	// next is the position of the next summary, the oldest summary is overwritten once the buffer is full.
+	next int
+	full bool
+}
+
+// newCompletedTaskHistory creates the history of the last size completed tasks, zero size means nothing is kept.
+func newCompletedTaskHistory(size int) *completedTaskHistory {
+	if size < 0 {
+		size = 0
+	}
+	return &completedTaskHistory{
+		tasks: make([]completedTaskInfo, size),
+	}
+}
+
+func (h *completedTaskHistory) add(info completedTaskInfo) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if len(h.tasks) == 0 {
+		return
+	}
+	h.tasks[h.next] = info
+	h.next++
+	if h.next == len(h.tasks) {
+		h.next = 0
+		h.full = true
+	}
+}
+
+// list returns the summaries from the most recently completed to the oldest.
+func (h *completedTaskHistory) list() []completedTaskInfo {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	num := h.next
+	if h.full {
+		num = len(h.tasks)
+	}
+	infos := make([]completedTaskInfo, 0, num)
+	for i := 1; i <= num; i++ {
+		infos = append(infos, h.tasks[(h.next-i+len(h.tasks))%len(h.tasks)])
+	}
+	return infos
+}
+
+func (h *completedTaskHistory) clear() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for i := range h.tasks {
+		h.tasks[i] = completedTaskInfo{}
+	}
+	h.next = 0
+	h.full = false
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestCompletedTaskHistory(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		history := newCompletedTaskHistory(0)
+		history.add(completedTaskInfo{buildID: 1})
+		assert.Equal(t, 0, len(history.list()))
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		history := newCompletedTaskHistory(3)
+		history.add(completedTaskInfo{buildID: 1})
+		history.add(completedTaskInfo{buildID: 2})
+		infos := history.list()
+		assert.Equal(t, 2, len(infos))
+		assert.Equal(t, UniqueID(2), infos[0].buildID)
+		assert.Equal(t, UniqueID(1), infos[1].buildID)
+
+		for buildID := UniqueID(3); buildID <= 5; buildID++ {
+			history.add(completedTaskInfo{buildID: buildID, result: commonpb.IndexState_Finished})
+		}
+		// the oldest summaries are overwritten.
+		infos = history.list()
+		assert.Equal(t, 3, len(infos))
+		assert.Equal(t, UniqueID(5), infos[0].buildID)
+		assert.Equal(t, UniqueID(4), infos[1].buildID)
+		assert.Equal(t, UniqueID(3), infos[2].buildID)
+
+		history.clear()
+		assert.Equal(t, 0, len(history.list()))
+		history.add(completedTaskInfo{buildID: 6})
+		assert.Equal(t, 1, len(history.list()))
+	})
+}
+
+func TestIndexBuilder_CompletedTasks(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.completedTasks = newCompletedTaskHistory(2)
+
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+	ib.failTask(4, 1, "build failed", log.With())
+	assert.Equal(t, indexTaskFailed, ib.ListTasks()[4])
+
+	completed := ib.ListCompletedTasks()
+	assert.Equal(t, 2, len(completed))
+	assert.Equal(t, UniqueID(4), completed[0].buildID)
+	assert.Equal(t, UniqueID(1), completed[0].nodeID)
+	assert.Equal(t, commonpb.IndexState_Failed, completed[0].result)
+	assert.Equal(t, UniqueID(6), completed[1].buildID)
+	assert.Equal(t, UniqueID(2), completed[1].nodeID)
+	assert.Equal(t, commonpb.IndexState_Finished, completed[1].result)
+	assert.True(t, completed[1].duration >= 0)
+
+	// the history is cleared on stop.
+	ib.Stop()
+	assert.Equal(t, 0, len(ib.ListCompletedTasks()))
+}
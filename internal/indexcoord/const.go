@@ -16,9 +16,60 @@
 
 package indexcoord
 
+import "time"
+
 const (
 	indexSizeFactor = 6
 	indexFilePrefix = "indexes"
+	// taskRetryMetaPrefix must not start with indexFilePrefix, all the values under indexFilePrefix are index metas.
+	taskRetryMetaPrefix = "index-task-retry"
+	// orphanedLockMetaPrefix must not start with indexFilePrefix either.
+	orphanedLockMetaPrefix = "index-orphaned-lock"
+
+	// defaultScheduleDuration is used when the schedule interval is not configured.
+	defaultScheduleDuration = 3 * time.Second
+	drainCheckInterval      = 100 * time.Millisecond
+	// nodeDrainInterval is the interval to move the tasks of the draining IndexNode, the rate of draining is the
+	// number of tasks moved per interval.
+	nodeDrainInterval = time.Second
+	// cleanupQueueSize is the maximum number of finished tasks waiting for the cleanup workers.
+	cleanupQueueSize = 1024
+	// syncLockRelease releases the segment reference locks of the finished tasks in the schedule loop, so the segments
+	// are unblocked for garbage collection as soon as the tasks are processed.
+	syncLockRelease = "sync"
+	// asyncLockRelease releases the segment reference locks of the finished tasks by the cleanup workers apart from
+	// the schedule loop, so the slow releases do not block scheduling.
+	asyncLockRelease = "async"
+	// indexNodesBusyBackoff is the time to wait before assigning tasks again when all IndexNodes are busy.
+	indexNodesBusyBackoff = time.Second
+	// rebuildCheckInterval is the interval to check the indexes to rebuild in the background.
+	rebuildCheckInterval = time.Minute
+	// waitTaskTimeout is the maximum time to wait for an index task in WaitForTask.
+	waitTaskTimeout = time.Hour
+	// backlogGrowthRuns is the number of consecutive schedule runs in which the task backlog grows without completed
+	// tasks, after which the index scheduler is reported as unhealthy.
+	backlogGrowthRuns = 10
+
+	indexTypeKey     = "index_type"
+	unknownIndexType = "unknown"
+
+	canceledFailReason = "index task is canceled"
+	// forceReassignReason is the fail reason of the task which is reassigned by the administrator.
+	forceReassignReason = "index task is reassigned by force"
+	// nodeDrainedReason is the fail reason of the task which is moved from the draining IndexNode.
+	nodeDrainedReason = "IndexNode is drained"
+	// segmentDroppedFailReason is the fail reason of the task whose segment is dropped before it is assigned.
+	segmentDroppedFailReason = "segment is dropped, such as compacted into a new segment"
+	// deadlineExceededFailReason is the fail reason of the task which is not finished before its build deadline.
+	deadlineExceededFailReason = "deadline exceeded"
+	// maxTaskErrorLength is the maximum length of the last error kept on the task in bytes, the errors of the remote
+	// side may carry long messages.
+	maxTaskErrorLength = 512
+
+	indexSchedulerRole = "IndexScheduler"
+
+	// credentialPrefix is the prefix of the credentials of the users saved by RootCoord.
+	credentialPrefix = "root-coord/credential/users"
 )
 
 const (
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// defaultIndexParams are the documented defaults of the build params of each index type, they are filled in if the
+// user omits them, so that all IndexNodes build the index with the same params. The params without a safe default,
+// such as the m of IVF_PQ which depends on the dimension, are not listed.
+var defaultIndexParams = map[string][]*commonpb.KeyValuePair{
+	string(indexparamcheck.IndexFaissIvfFlat): {
+		{Key: indexparamcheck.NLIST, Value: "1024"},
+	},
+	string(indexparamcheck.IndexFaissIvfSQ8): {
+		{Key: indexparamcheck.NLIST, Value: "1024"},
+	},
+	string(indexparamcheck.IndexFaissIvfPQ): {
+		{Key: indexparamcheck.NLIST, Value: "1024"},
+		{Key: indexparamcheck.NBITS, Value: "8"},
+	},
+	string(indexparamcheck.IndexFaissBinIvfFlat): {
+		{Key: indexparamcheck.NLIST, Value: "1024"},
+	},
+	string(indexparamcheck.IndexHNSW): {
+		{Key: indexparamcheck.HNSWM, Value: "16"},
+		{Key: indexparamcheck.EFConstruction, Value: "200"},
+	},
+	string(indexparamcheck.IndexRHNSWFlat): {
+		{Key: indexparamcheck.HNSWM, Value: "16"},
+		{Key: indexparamcheck.EFConstruction, Value: "200"},
+	},
+	string(indexparamcheck.IndexANNOY): {
+		{Key: indexparamcheck.NTREES, Value: "8"},
+	},
+}
+
+// fillDefaultIndexParams returns the index params with the defaults of the index type filled in, and the keys of the
+// filled defaults. The index params are not modified.
+func fillDefaultIndexParams(indexParams []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, []string) {
+	defaults, ok := defaultIndexParams[getIndexType(indexParams)]
+	if !ok {
+		return indexParams, nil
+	}
+	keys := make(map[string]struct{}, len(indexParams))
+	for _, kvPair := range indexParams {
+		keys[kvPair.GetKey()] = struct{}{}
+	}
+	params := indexParams
+	var filled []string
+	for _, kvPair := range defaults {
+		if _, ok := keys[kvPair.GetKey()]; ok {
+			continue
+		}
+		if filled == nil {
+			params = make([]*commonpb.KeyValuePair, len(indexParams), len(indexParams)+len(defaults))
+			copy(params, indexParams)
+		}
+		params = append(params, &commonpb.KeyValuePair{Key: kvPair.GetKey(), Value: kvPair.GetValue()})
+		filled = append(filled, kvPair.GetKey())
+	}
+	return params, filled
+}
+
+// normalizeIndexParams returns the index params which the index is built with, the defaults are filled.
+func normalizeIndexParams(indexParams []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	params, _ := fillDefaultIndexParams(indexParams)
+	return params
+}
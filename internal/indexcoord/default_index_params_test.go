@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func Test_fillDefaultIndexParams(t *testing.T) {
+	indexParams := []*commonpb.KeyValuePair{
+		{Key: "index_type", Value: "HNSW"},
+		{Key: "metric_type", Value: "L2"},
+		{Key: "M", Value: "32"},
+	}
+	params, filled := fillDefaultIndexParams(indexParams)
+	assert.Equal(t, []string{"efConstruction"}, filled)
+	assert.Equal(t, 4, len(params))
+	assert.Equal(t, "efConstruction", params[3].GetKey())
+	assert.Equal(t, "200", params[3].GetValue())
+	// the user params are kept and not modified.
+	assert.Equal(t, "32", params[2].GetValue())
+	assert.Equal(t, 3, len(indexParams))
+
+	// no defaults are filled if all params are specified.
+	params, filled = fillDefaultIndexParams(params)
+	assert.Nil(t, filled)
+	assert.Equal(t, 4, len(params))
+
+	// the index type without defaults.
+	indexParams = []*commonpb.KeyValuePair{
+		{Key: "index_type", Value: "FLAT"},
+	}
+	params, filled = fillDefaultIndexParams(indexParams)
+	assert.Nil(t, filled)
+	assert.Equal(t, indexParams, params)
+
+	// the defaults are valid params of the index type.
+	for indexType := range defaultIndexParams {
+		params, _ := fillDefaultIndexParams([]*commonpb.KeyValuePair{
+			{Key: "index_type", Value: indexType},
+			{Key: "metric_type", Value: "L2"},
+		})
+		paramsMap := map[string]string{"dim": "128", "m": "8"}
+		for _, kvPair := range params {
+			paramsMap[kvPair.GetKey()] = kvPair.GetValue()
+		}
+		if indexType == string(indexparamcheck.IndexFaissBinIvfFlat) {
+			paramsMap["metric_type"] = "JACCARD"
+		}
+		adapter, err := indexparamcheck.GetConfAdapterMgrInstance().GetAdapter(indexType)
+		assert.NoError(t, err)
+		assert.True(t, adapter.CheckTrain(paramsMap), indexType)
+	}
+}
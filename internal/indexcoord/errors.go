@@ -23,8 +23,35 @@ import (
 
 var (
 	ErrCompareVersion = errors.New("failed to save meta in etcd because version compare failure")
+
+	// ErrNoIndexNode means there is no IndexNode online.
+	ErrNoIndexNode = errors.New("there is no IndexNode online")
+	// ErrIndexNodesBusy means all the IndexNodes are busy, there are no free task slots.
+	ErrIndexNodesBusy = errors.New("all IndexNodes are busy")
+	// ErrBuilderBusy means the index builder tracks the maximum number of tasks, the new tasks should be retried later.
+	ErrBuilderBusy = errors.New("index builder is busy, too many index tasks")
+	// ErrPinnedNodeBusy means the IndexNode which the task is pinned to has no free task slot.
+	ErrPinnedNodeBusy = errors.New("the pinned IndexNode is busy")
+	// ErrLockAcquireFailed means the segment reference lock of the index task can not be acquired from DataCoord.
+	ErrLockAcquireFailed = errors.New("failed to acquire segment reference lock")
+	// ErrLockReleaseFailed means the segment reference lock of the index task can not be released by DataCoord.
+	ErrLockReleaseFailed = errors.New("failed to release segment reference lock")
+	// ErrMetaUpdateFailed means the index meta of the task can not be saved in etcd.
+	ErrMetaUpdateFailed = errors.New("failed to update index meta")
+	// ErrAssignFailed means the index task can not be sent to the IndexNode.
+	ErrAssignFailed = errors.New("failed to assign index task to IndexNode")
+	// ErrPriorityIgnored means the task priority is ignored by the ordering policy, it only applies with the priority
+	// ordering policy.
+	ErrPriorityIgnored = errors.New("the priority of index tasks is ignored by the ordering policy, use the priority policy")
+	// ErrPermissionDenied means the admin rpc is not sent by the root user.
+	ErrPermissionDenied = errors.New("permission denied, only the root user is allowed")
 )
 
+// wrapError returns an error which matches kind by errors.Is and keeps the message of the cause.
+func wrapError(kind error, cause error) error {
+	return fmt.Errorf("%w: %v", kind, cause)
+}
+
 // errIndexNodeIsNotOnService return an error that the specified IndexNode is not exists.
 func errIndexNodeIsNotOnService(id UniqueID) error {
 	return fmt.Errorf("index node %d is not on service", id)
@@ -38,3 +65,18 @@ func msgIndexCoordIsUnhealthy(coordID UniqueID) string {
 func errIndexCoordIsUnhealthy(coordID UniqueID) error {
 	return errors.New(msgIndexCoordIsUnhealthy(coordID))
 }
+
+// errIndexTaskIsDeleted return an error that the specified index task is deleted before it is finished.
+func errIndexTaskIsDeleted(buildID UniqueID) error {
+	return fmt.Errorf("index task %d is deleted", buildID)
+}
+
+// errNoBuildTimeSamples return an error that no index of the index type has been built to estimate the build time.
+func errNoBuildTimeSamples(indexType string) error {
+	return fmt.Errorf("no index of type %s has been built to estimate the build time", indexType)
+}
+
+// errIndexTaskNotExist return an error that the specified index task is not in the index builder.
+func errIndexTaskNotExist(buildID UniqueID) error {
+	return fmt.Errorf("index task %d does not exist", buildID)
+}
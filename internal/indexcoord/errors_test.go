@@ -17,8 +17,10 @@
 package indexcoord
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -48,3 +50,10 @@ func TestErrIndexCoordIsUnhealthy(t *testing.T) {
 		log.Info("TestErrIndexCoordIsUnhealthy", zap.Error(errIndexCoordIsUnhealthy(nodeID)))
 	}
 }
+
+func Test_wrapError(t *testing.T) {
+	err := wrapError(ErrLockReleaseFailed, errors.New("mock error"))
+	assert.True(t, errors.Is(err, ErrLockReleaseFailed))
+	assert.False(t, errors.Is(err, ErrMetaUpdateFailed))
+	assert.Equal(t, "failed to release segment reference lock: mock error", err.Error())
+}
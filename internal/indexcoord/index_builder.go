@@ -18,18 +18,35 @@ package indexcoord
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
 	"path"
 	"sort"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
+// TaskHook is called with the index meta when an index task is finished or failed.
+type TaskHook func(buildID UniqueID, meta *indexpb.IndexMeta)
+
+// CollectionIndexReadyHook is called with the collection id when all the index tasks of the collection are finished.
+type CollectionIndexReadyHook func(collectionID UniqueID)
+
 type indexBuilder struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -37,10 +54,124 @@ type indexBuilder struct {
 	wg               sync.WaitGroup
 	taskMutex        sync.RWMutex
 	scheduleDuration time.Duration
+	// notifyDebounce is the delay from the first notification to the run it triggers, zero means no delay.
+	notifyDebounce time.Duration
+	// scheduleChan receives the new scheduleDuration when it is changed at runtime.
+	scheduleChan chan time.Duration
+	// triggerChan receives the runs requested by triggerRun, each channel is closed when the run is finished.
+	triggerChan chan chan struct{}
+	// scheduling means the schedule loop is started, it is guarded by the taskMutex.
+	scheduling bool
+
+	// maxTaskRetry is the maximum number of times a task can be reassigned, zero means no limit.
+	maxTaskRetry int
+	// retryBackoffBase and retryBackoffMax control the delay between consecutive retries of a task.
+	retryBackoffBase time.Duration
+	retryBackoffMax  time.Duration
+	// retryJitter is the upper bound of the random delay added to each retry, zero means no jitter.
+	retryJitter time.Duration
+	// taskTimeout is the duration after which an in-progress task without progress is reassigned, zero means no timeout.
+	taskTimeout time.Duration
+	// livenessCheckInterval is the interval of checking the IndexNodes of the in-progress tasks, zero means no check.
+	livenessCheckInterval time.Duration
+	// lastLivenessCheck is the time of the last liveness check, it is only accessed by run.
+	lastLivenessCheck time.Time
+	// maxReleaseLockRetry is the maximum number of attempts to release the lock of a finished task, zero means no limit.
+	maxReleaseLockRetry int
+	// lockHoldWarnThreshold is the hold time of a segment reference lock beyond which a warning is logged.
+	lockHoldWarnThreshold time.Duration
+	// maxConcurrentTasksPerNode is the maximum number of in-progress tasks on an IndexNode, zero means no limit.
+	maxConcurrentTasksPerNode int
+	// maxConcurrentTasksPerCollection is the maximum number of in-progress tasks of a collection, zero means no limit.
+	maxConcurrentTasksPerCollection int
+	// balanceByCost means the task is assigned to the IndexNode with the least estimated work.
+	balanceByCost bool
+	// allowNodePinning means the task pinned to an IndexNode by the request is only assigned to the IndexNode.
+	allowNodePinning bool
+	// verifyIndexFiles means the index files of a finished task are checked to exist before the task is completed.
+	verifyIndexFiles bool
+	// starvingTaskThreshold is the time since enqueued after which an unfinished task counts as starving.
+	starvingTaskThreshold time.Duration
+	// pausedTaskLockGracePeriod is the time after which the paused task releases its segment reference lock.
+	pausedTaskLockGracePeriod time.Duration
+	// failedTaskRetention is the time for which the failed tasks are kept for inspection before they are removed.
+	failedTaskRetention time.Duration
+	// maxTrackedTasks is the maximum number of tasks tracked by the index builder, zero means no limit.
+	maxTrackedTasks int
+	// lockAcquireLimiter limits the rate of acquiring the segment reference locks, nil if there is no limit.
+	lockAcquireLimiter *rate.Limiter
+	// scheduleLogChangeRatio is the change ratio of the task number beyond which the summary is logged at info.
+	scheduleLogChangeRatio float64
+	// nodeSelector chooses the IndexNode to assign the task to, it is guarded by the assignLock.
+	nodeSelector NodeSelector
+	// costEstimator estimates the work of the tasks, it is guarded by the taskMutex.
+	costEstimator TaskCostEstimator
+	// peakWindows lower the maximum number of in-progress tasks on an IndexNode in peak hours.
+	peakWindows []peakWindow
+	// fairnessWindow is the maximum number of tasks of a collection processed in turn, zero means no fairness.
+	fairnessWindow int
+	// maxTasksPerRun is the maximum number of tasks processed in one run, zero means no limit.
+	maxTasksPerRun int
+	// orderingPolicy decides the order in which the tasks are processed in a run.
+	orderingPolicy OrderingPolicy
+	// processParallelism is the number of workers processing the tasks in one run, zero means the number of IndexNodes.
+	processParallelism int
+	// releaseLockAsync means the segment reference locks of the finished tasks are released by the cleanup workers.
+	releaseLockAsync bool
+	// cleanupParallelism is the number of cleanup workers if releaseLockAsync is set.
+	cleanupParallelism int
 
-	// TODO @xiaocai2333: use priority queue
-	tasks      map[int64]indexTaskState
+	tasks      *taskQueue
 	notifyChan chan struct{}
+	// notifyLock guards notifyClosed, so that notify never sends on the closed notifyChan after Stop.
+	notifyLock   sync.RWMutex
+	notifyClosed bool
+	// processing are the tasks being processed, a task is never processed by two workers at the same time.
+	processing map[UniqueID]struct{}
+	// cleanupChan queues the finished tasks whose locks are released by the cleanup workers.
+	cleanupChan chan UniqueID
+	// cleaning are the finished tasks queued or being cleaned up, they are not processed by the schedule loop.
+	cleaning map[UniqueID]struct{}
+	// assignLock serializes choosing the IndexNodes, so the task slots of an IndexNode are not taken by two workers.
+	assignLock sync.Mutex
+	// stopping means the index builder is draining the pending tasks and no longer accepts new tasks.
+	stopping bool
+	// paused means the index builder does not assign tasks to IndexNodes, the assigned tasks are still tracked.
+	paused bool
+	// drainingNodes are the IndexNodes which are being decommissioned, no task is assigned to them.
+	drainingNodes map[UniqueID]struct{}
+	// unreadyCollections are the collections which have index tasks enqueued since their last ready event.
+	unreadyCollections map[UniqueID]struct{}
+
+	// busyUntil is the time before which no tasks are assigned because all IndexNodes are busy.
+	busyUntil time.Time
+	// noIndexNodeSince is the time when it is found that there is no IndexNode online, zero if there are IndexNodes.
+	noIndexNodeSince time.Time
+	// lastLoggedTaskNum and lastLoggedPaused are the schedule summary last logged at info level.
+	lastLoggedTaskNum int
+	lastLoggedPaused  bool
+
+	hookLock      sync.RWMutex
+	completeHooks []TaskHook
+	failedHooks   []TaskHook
+	readyHooks    []CollectionIndexReadyHook
+
+	// waiters are the one-shot channels of WaitForTask, they are removed once the task is resolved.
+	waiterLock sync.Mutex
+	waiters    map[UniqueID][]chan commonpb.IndexState
+
+	health schedulerHealthTracker
+	// taskMutexStats records the hold time of the taskMutex in the critical sections, see lockTasks.
+	taskMutexStats taskMutexStats
+	// completedTasks are the summaries of the recently completed tasks, they are cleared on Stop.
+	completedTasks *completedTaskHistory
+	// buildTimes are the durations of the finished builds, they are used to estimate the time to build new indexes.
+	buildTimes *buildTimeStats
+
+	// auditSink records every state transition of the tasks, it is guarded by the taskMutex.
+	auditSink AuditSink
+	// clock measures the durations of the tasks, such as the backoff and the timeouts.
+	clock clock
 
 	ic *IndexCoord
 
@@ -51,322 +182,2303 @@ func newIndexBuilder(ctx context.Context, ic *IndexCoord, metaTable *metaTable,
 	ctx, cancel := context.WithCancel(ctx)
 
 	ib := &indexBuilder{
-		ctx:              ctx,
-		cancel:           cancel,
-		meta:             metaTable,
-		ic:               ic,
-		notifyChan:       make(chan struct{}, 1),
-		scheduleDuration: time.Second * 3,
+		ctx:                ctx,
+		cancel:             cancel,
+		meta:               metaTable,
+		ic:                 ic,
+		notifyChan:         make(chan struct{}, 1),
+		processing:         make(map[UniqueID]struct{}),
+		cleaning:           make(map[UniqueID]struct{}),
+		drainingNodes:      make(map[UniqueID]struct{}),
+		unreadyCollections: make(map[UniqueID]struct{}),
+		scheduleDuration:   Params.IndexCoordCfg.ScheduleInterval,
+		notifyDebounce:     Params.IndexCoordCfg.NotifyDebounce,
+		scheduleChan:       make(chan time.Duration, 1),
+		triggerChan:        make(chan chan struct{}),
+		maxTaskRetry:       int(Params.IndexCoordCfg.MaxTaskRetry),
+		retryBackoffBase:   Params.IndexCoordCfg.RetryBackoffBase,
+		retryBackoffMax:    Params.IndexCoordCfg.RetryBackoffMax,
+		retryJitter:        Params.IndexCoordCfg.RetryJitter,
+		taskTimeout:        Params.IndexCoordCfg.TaskTimeout,
+
+		maxReleaseLockRetry:       int(Params.IndexCoordCfg.MaxReleaseLockRetry),
+		lockHoldWarnThreshold:     Params.IndexCoordCfg.LockHoldWarnThreshold,
+		maxConcurrentTasksPerNode: int(Params.IndexCoordCfg.MaxConcurrentTasksPerNode),
+
+		maxConcurrentTasksPerCollection: int(Params.IndexCoordCfg.MaxConcurrentTasksPerCollection),
+		livenessCheckInterval:           Params.IndexCoordCfg.LivenessCheckInterval,
+
+		fairnessWindow:            int(Params.IndexCoordCfg.FairnessWindow),
+		maxTasksPerRun:            int(Params.IndexCoordCfg.MaxTasksPerRun),
+		processParallelism:        int(Params.IndexCoordCfg.ProcessParallelism),
+		releaseLockAsync:          isAsyncLockRelease(Params.IndexCoordCfg.DoneTaskLockRelease),
+		cleanupParallelism:        int(Params.IndexCoordCfg.CleanupParallelism),
+		orderingPolicy:            NewOrderingPolicy(Params.IndexCoordCfg.OrderingPolicy),
+		balanceByCost:             Params.IndexCoordCfg.BalanceByCost,
+		verifyIndexFiles:          Params.IndexCoordCfg.VerifyIndexFiles,
+		allowNodePinning:          Params.IndexCoordCfg.AllowNodePinning,
+		costEstimator:             rowCountCost,
+		starvingTaskThreshold:     Params.IndexCoordCfg.StarvingTaskThreshold,
+		pausedTaskLockGracePeriod: Params.IndexCoordCfg.PausedTaskLockGracePeriod,
+		failedTaskRetention:       Params.IndexCoordCfg.FailedTaskRetention,
+		maxTrackedTasks:           int(Params.IndexCoordCfg.MaxTrackedTasks),
+		scheduleLogChangeRatio:    Params.IndexCoordCfg.ScheduleLogChangeRatio,
+		nodeSelector:              &nodeManagerSelector{ic: ic},
+
+		waiters:        make(map[UniqueID][]chan commonpb.IndexState),
+		auditSink:      logAuditSink{},
+		clock:          monotonicClock{},
+		completedTasks: newCompletedTaskHistory(int(Params.IndexCoordCfg.CompletedTaskHistorySize)),
+		buildTimes:     newBuildTimeStats(),
+	}
+	if ib.scheduleDuration <= 0 {
+		ib.scheduleDuration = defaultScheduleDuration
 	}
+	ib.setPeakWindows(Params.IndexCoordCfg.PeakWindows)
+	ib.lockAcquireLimiter = newLockAcquireLimiter(Params.IndexCoordCfg.LockAcquireRate)
 	ib.refreshTasks(aliveNodes)
 	return ib
 }
 
 func (ib *indexBuilder) Start() {
+	ib.startCleanupWorkers()
+	ib.taskMutex.Lock()
+	ib.scheduling = true
+	ib.taskMutex.Unlock()
 	ib.wg.Add(1)
 	go ib.schedule()
 }
 
+// isAsyncLockRelease returns whether the locks of the finished tasks are released asynchronously, which is the default.
+func isAsyncLockRelease(strategy string) bool {
+	switch strategy {
+	case syncLockRelease:
+		return false
+	case asyncLockRelease:
+		return true
+	default:
+		log.Warn("unknown lock release strategy of the finished index tasks, use async", zap.String("strategy", strategy))
+		return true
+	}
+}
+
 func (ib *indexBuilder) Stop() {
 	ib.cancel()
-	close(ib.notifyChan)
+	ib.notifyLock.Lock()
+	if !ib.notifyClosed {
+		ib.notifyClosed = true
+		close(ib.notifyChan)
+	}
+	ib.notifyLock.Unlock()
 	ib.wg.Wait()
+	ib.completedTasks.clear()
 }
 
-func (ib *indexBuilder) refreshTasks(aliveNodes []UniqueID) {
+// GracefulStop stops accepting new tasks and waits up to timeout for the pending tasks before stopping.
+func (ib *indexBuilder) GracefulStop(timeout time.Duration) {
 	ib.taskMutex.Lock()
-	defer ib.taskMutex.Unlock()
-	ib.tasks = make(map[int64]indexTaskState, 1024)
+	ib.stopping = true
+	ib.taskMutex.Unlock()
+	ib.notify()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for !ib.isDrained() {
+		if reason := ib.drainStalledReason(); reason != "" {
+			log.Warn("index builder can not drain the pending tasks, stop it directly", zap.String("reason", reason))
+			ib.Stop()
+			return
+		}
+		select {
+		case <-timer.C:
+			log.Warn("index builder drain pending tasks timeout, stop it directly", zap.Duration("timeout", timeout))
+			ib.Stop()
+			return
+		case <-ticker.C:
+		}
+	}
+	log.Info("index builder has drained all pending tasks")
+	ib.Stop()
+}
+
+// isDrained returns whether there are no tasks waiting to be assigned or reset.
+func (ib *indexBuilder) isDrained() bool {
+	return len(ib.GetBuildIDsInState(indexTaskInit, indexTaskRetry)) == 0
+}
+
+// drainStalledReason returns why the pending tasks can not be assigned or reset by waiting, empty if they can.
+func (ib *indexBuilder) drainStalledReason() string {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	if !ib.scheduling {
+		return "the schedule loop is not started"
+	}
+	if ib.paused {
+		return "the index builder is paused"
+	}
+	for _, task := range ib.tasks.tasks {
+		if task.state == indexTaskRetry {
+			return ""
+		}
+	}
+	if len(ib.ic.nodeManager.ListAllNodes()) == 0 {
+		return "there is no IndexNode to assign the pending tasks"
+	}
+	return ""
+}
+
+// refreshTasks rebuilds all the tasks from meta, it is used on startup.
+func (ib *indexBuilder) refreshTasks(aliveNodes []UniqueID) {
+	defer ib.lockTasks(refreshSection)()
+	if ib.tasks != nil {
+		for task := ib.tasks.Pop(); task != nil; task = ib.tasks.Pop() {
+			finishTaskSpan(task)
+		}
+	}
+	ib.tasks = newTaskQueue(ib.orderingPolicy)
+	ib.unreadyCollections = make(map[UniqueID]struct{})
+	defer ib.restoreRetryMetas()
 
+	alive := aliveNodeSet(aliveNodes)
 	metas := ib.meta.GetAllIndexMeta()
 	for build, indexMeta := range metas {
-		// deleted, need to release lock and clean meta
+		state, ok := expectedTaskState(indexMeta, alive)
+		if !ok {
+			continue
+		}
+		task := ib.addTask(build, state)
+		ib.applyTaskMeta(task, indexMeta)
+	}
+}
 
-		if indexMeta.MarkDeleted {
-			if indexMeta.NodeID != 0 {
-				ib.tasks[build] = indexTaskDeleted
-			}
-		} else if indexMeta.State == commonpb.IndexState_Unissued && indexMeta.NodeID == 0 {
-			// unissued, need to acquire lock and assign task
-			ib.tasks[build] = indexTaskInit
-		} else if indexMeta.State == commonpb.IndexState_Unissued && indexMeta.NodeID != 0 {
-			// retry, need to release lock and reassign task
-			// need to release reference lock
-			ib.tasks[build] = indexTaskRetry
-		} else if indexMeta.State == commonpb.IndexState_InProgress {
-			// need to check IndexNode is still alive.
-			alive := false
-			for _, nodeID := range aliveNodes {
-				if nodeID == indexMeta.NodeID {
-					alive = true
-					break
-				}
-			}
-			if !alive {
-				// IndexNode is down, need to retry
-				ib.tasks[build] = indexTaskRetry
-			} else {
-				// in_progress, nothing to do
-				ib.tasks[build] = indexTaskInProgress
+// reconcileTasks updates the tasks to match meta and keeps the retry and backoff state of the surviving tasks.
+func (ib *indexBuilder) reconcileTasks(aliveNodes []UniqueID) {
+	defer ib.lockTasks(reconcileSection)()
+
+	alive := aliveNodeSet(aliveNodes)
+	metas := ib.meta.GetAllIndexMeta()
+	var added, removed, updated []UniqueID
+	for buildID := range ib.tasks.tasks {
+		if _, ok := metas[buildID]; !ok {
+			ib.removeTask(buildID)
+			removed = append(removed, buildID)
+		}
+	}
+	for build, indexMeta := range metas {
+		state, ok := expectedTaskState(indexMeta, alive)
+		task, exist := ib.tasks.Get(build)
+		switch {
+		case !ok:
+			// the failed task is removed by the scheduler once its retention expires.
+			if exist && task.state != indexTaskFailed {
+				ib.removeTask(build)
+				removed = append(removed, build)
 			}
-		} else if indexMeta.State == commonpb.IndexState_Finished || indexMeta.State == commonpb.IndexState_Failed {
-			if indexMeta.NodeID != 0 {
-				// task is done, but the lock has not been released, need to release.
-				ib.tasks[build] = indexTaskDone
+		case !exist:
+			task = ib.addTask(build, state)
+			ib.applyTaskMeta(task, indexMeta)
+			added = append(added, build)
+		case task.state == indexTaskPaused && state != indexTaskDeleted:
+			// the paused task is reconciled when it is resumed.
+		case task.state != state && task.state != indexTaskDeleted && task.state != indexTaskFailed:
+			if ib.setTaskState(task, state) {
+				ib.applyTaskMeta(task, indexMeta)
+				updated = append(updated, build)
 			}
-			// else: task is done, and lock has been released, no need to add to index builder.
 		}
 	}
+	log.Info("index builder reconcile tasks with meta", zap.Int64s("added", added), zap.Int64s("removed", removed),
+		zap.Int64s("updated", updated), zap.Int("tasks", ib.tasks.Len()))
 }
 
-// notify is an unblocked notify function
-func (ib *indexBuilder) notify() {
-	select {
-	case ib.notifyChan <- struct{}{}:
-	default:
+// Reconcile reloads meta from etcd and reconciles the tasks with it on demand.
+func (ib *indexBuilder) Reconcile() error {
+	if err := ib.meta.reloadFromKV(); err != nil {
+		log.Error("index builder reload meta failed", zap.Error(err))
+		return err
 	}
+	ib.reconcileTasks(ib.ic.nodeManager.ListAllNodes())
+	ib.notify()
+	return nil
 }
 
-func (ib *indexBuilder) enqueue(buildID UniqueID) {
-	defer ib.notify()
+// aliveNodeSet builds the set of the alive IndexNodes, the invalid node ids are dropped.
+func aliveNodeSet(aliveNodes []UniqueID) map[UniqueID]struct{} {
+	alive := make(map[UniqueID]struct{}, len(aliveNodes))
+	for _, nodeID := range aliveNodes {
+		if nodeID <= 0 {
+			log.Warn("index builder ignore the invalid alive IndexNode", zap.Int64("nodeID", nodeID))
+			continue
+		}
+		alive[nodeID] = struct{}{}
+	}
+	if len(alive) != len(aliveNodes) {
+		log.Warn("index builder got duplicated or invalid alive IndexNodes", zap.Int("nodes", len(aliveNodes)),
+			zap.Int("valid nodes", len(alive)))
+	}
+	return alive
+}
 
-	ib.taskMutex.Lock()
-	defer ib.taskMutex.Unlock()
+// expectedTaskState returns the state of the task recovered from the index meta, false if the task needs no more process.
+func expectedTaskState(indexMeta *indexpb.IndexMeta, aliveNodes map[UniqueID]struct{}) (indexTaskState, bool) {
+	switch {
+	case indexMeta.MarkDeleted:
+		// deleted, need to release lock and clean meta
+		return indexTaskDeleted, indexMeta.NodeID != 0
+	case indexMeta.State == commonpb.IndexState_Unissued && indexMeta.NodeID == 0:
+		// unissued, need to acquire lock and assign task
+		return indexTaskInit, true
+	case indexMeta.State == commonpb.IndexState_Unissued && indexMeta.NodeID != 0:
+		// retry, need to release lock and reassign task
+		return indexTaskRetry, true
+	case indexMeta.State == commonpb.IndexState_InProgress:
+		// need to check IndexNode is still alive, retry if it is down.
+		if _, ok := aliveNodes[indexMeta.NodeID]; !ok {
+			return indexTaskRetry, true
+		}
+		return indexTaskInProgress, true
+	case indexMeta.State == commonpb.IndexState_Finished || indexMeta.State == commonpb.IndexState_Failed:
+		// task is done, but the lock has not been released, need to release.
+		// else: task is done, and lock has been released, no need to add to index builder.
+		return indexTaskDone, indexMeta.NodeID != 0
+	}
+	return indexTaskInit, false
+}
 
-	ib.tasks[buildID] = indexTaskInit
+// applyTaskMeta sets the fields of the task recovered from the index meta, the caller should hold the taskMutex.
+func (ib *indexBuilder) applyTaskMeta(task *indexTask, indexMeta *indexpb.IndexMeta) {
+	task.checkpointPath = indexMeta.GetCheckpointPath()
+	switch task.state {
+	case indexTaskInit:
+		ib.tasks.Update(task.buildID, int(indexMeta.GetReq().GetBuildPriority()))
+	case indexTaskRetry:
+		task.nodeID = indexMeta.NodeID
+		if indexMeta.State == commonpb.IndexState_Unissued {
+			// the task is reset by the IndexNode, prefer to reassign it to the same IndexNode.
+			task.preferNodeID = indexMeta.NodeID
+		}
+	case indexTaskInProgress:
+		task.nodeID = indexMeta.NodeID
+		task.cost = ib.costEstimator(indexMeta)
+		// the time when the task was assigned is unknown after recovery.
+		task.inProgressTime = time.Time{}
+	default:
+		task.nodeID = indexMeta.NodeID
+	}
 }
 
-func (ib *indexBuilder) schedule() {
-	// receive notifyChan
-	// time ticker
-	defer ib.wg.Done()
-	ticker := time.NewTicker(ib.scheduleDuration)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ib.ctx.Done():
-			log.Warn("index builder ctx done")
-			return
-		case _, ok := <-ib.notifyChan:
-			if ok {
-				ib.run()
+func (ib *indexBuilder) restoreRetryMetas() {
+	for _, buildID := range ib.meta.GetAllTaskRetryMetaBuildIDs() {
+		retryMeta, ok := ib.meta.GetTaskRetryMeta(buildID)
+		if !ok {
+			continue
+		}
+		task, ok := ib.tasks.Get(buildID)
+		if !ok {
+			if err := ib.meta.RemoveTaskRetryMeta(buildID); err != nil {
+				log.Warn("index builder remove useless task retry meta failed", zap.Int64("buildID", buildID), zap.Error(err))
 			}
-			// !ok means indexBuild is closed.
-		case <-ticker.C:
-			ib.run()
+			continue
+		}
+		task.retryCount = retryMeta.RetryCount
+		task.failReason = retryMeta.FailReason
+		if task.state != indexTaskInProgress {
+			// the backoff is reset once the task is in progress.
+			task.retryDelay = retryMeta.RetryDelay
 		}
 	}
 }
 
-func (ib *indexBuilder) run() {
-	ib.taskMutex.RLock()
-	log.Info("index builder task schedule", zap.Int("task num", len(ib.tasks)))
-	buildIDs := make([]UniqueID, 0, len(ib.tasks))
-	for tID := range ib.tasks {
-		buildIDs = append(buildIDs, tID)
+// addTask adds the task to the task queue, the caller should hold the taskMutex.
+func (ib *indexBuilder) addTask(buildID UniqueID, state indexTaskState) *indexTask {
+	ib.removeTask(buildID)
+	task := &indexTask{
+		buildID:     buildID,
+		enqueueTime: ib.clock.Now(),
+		priority:    defaultTaskPriority,
 	}
-	ib.taskMutex.RUnlock()
-
-	sort.Slice(buildIDs, func(i, j int) bool {
-		return buildIDs[i] < buildIDs[j]
-	})
-	for _, buildID := range buildIDs {
-		ib.process(buildID)
+	meta, ok := ib.meta.GetMeta(buildID)
+	var opts []opentracing.StartSpanOption
+	if ok {
+		// the task span follows the BuildIndex request, so the logs of the task can be correlated with the request.
+		if sc := trace.ExtractContextFromMap(meta.indexMeta.GetReq().GetTraceContext()); sc != nil {
+			opts = append(opts, opentracing.FollowsFrom(sc))
+		}
 	}
+	task.span, _ = trace.StartSpanFromContextWithOperationName(ib.ctx, "IndexCoord-IndexTask", opts...)
+	task.span.SetTag("buildID", buildID)
+	if ok {
+		req := meta.indexMeta.GetReq()
+		task.indexID = req.GetIndexID()
+		task.indexName = req.GetIndexName()
+		task.indexType = getIndexType(req.GetIndexParams())
+		task.indexParams = summarizeIndexParams(req.GetIndexParams())
+		task.segmentID = req.GetSegmentID()
+		task.shadow = req.GetShadowBuild()
+		task.segmentOrigin = getSegmentOrigin(req)
+		task.collectionID = req.GetCollectionID()
+		task.cost = ib.costEstimator(meta.indexMeta)
+	}
+	ib.tasks.Push(task)
+	ib.setTaskState(task, state)
+	if task.collectionID != 0 && task.isUnfinished() {
+		// the collection is not ready until the new task is finished, including the collection which is ready.
+		ib.unreadyCollections[task.collectionID] = struct{}{}
+	}
+	return task
 }
 
-func (ib *indexBuilder) process(buildID UniqueID) {
-	ib.taskMutex.RLock()
-	state := ib.tasks[buildID]
-	ib.taskMutex.RUnlock()
-
-	updateStateFunc := func(buildID UniqueID, state indexTaskState) {
-		ib.taskMutex.Lock()
-		defer ib.taskMutex.Unlock()
-		ib.tasks[buildID] = state
+// removeTask removes the task from the task queue and finishes its span, the caller should hold the taskMutex.
+func (ib *indexBuilder) removeTask(buildID UniqueID) {
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return
 	}
+	ib.tasks.Remove(buildID)
+	finishTaskSpan(task)
+}
 
-	deleteFunc := func(buildID UniqueID) {
-		ib.taskMutex.Lock()
-		defer ib.taskMutex.Unlock()
-		delete(ib.tasks, buildID)
+// finishTaskSpan finishes the trace span of the task which is removed from the queue.
+func finishTaskSpan(task *indexTask) {
+	if task.span != nil {
+		task.span.LogKV("removed state", task.state.String())
+		task.span.Finish()
 	}
+}
 
-	log.Info("index task is processing", zap.Int64("buildID", buildID), zap.String("task state", state.String()))
-	meta, exist := ib.meta.GetMeta(buildID)
-
-	switch state {
-	case indexTaskInit:
-		// peek client
-		// if all IndexNodes are executing task, wait for one of them to finish the task.
-		nodeID, client := ib.ic.nodeManager.PeekClient(meta)
-		if client == nil {
-			log.Error("index builder peek client error, there is no available")
-			return
-		}
-		// update version and set nodeID
-		if err := ib.meta.UpdateVersion(buildID, nodeID); err != nil {
-			log.Error("index builder update index version failed", zap.Int64("build", buildID), zap.Error(err))
-			return
+// taskLogger returns the logger with the context of the index task.
+func (ib *indexBuilder) taskLogger(task *indexTask, meta *Meta) *zap.Logger {
+	fields := []zap.Field{zap.Int64("buildID", task.buildID)}
+	if meta != nil {
+		fields = append(fields,
+			zap.Int64("indexID", meta.indexMeta.GetReq().GetIndexID()),
+			zap.Int64("segmentID", meta.indexMeta.GetReq().GetSegmentID()))
+		if nodeID := meta.indexMeta.GetNodeID(); nodeID != 0 {
+			fields = append(fields, zap.Int64("nodeID", nodeID))
 		}
+	}
+	if task.collectionID != 0 {
+		fields = append(fields, zap.Int64("collectionID", task.collectionID))
+	}
+	if traceID, _, found := trace.InfoFromSpan(task.span); found {
+		fields = append(fields, zap.String("traceID", traceID))
+	}
+	return log.With(fields...)
+}
 
-		// acquire lock
-		if err := ib.ic.tryAcquireSegmentReferLock(ib.ctx, buildID, nodeID, []UniqueID{meta.indexMeta.Req.SegmentID}); err != nil {
-			log.Error("index builder acquire segment reference lock failed", zap.Int64("buildID", buildID),
-				zap.Int64("nodeID", nodeID), zap.Error(err))
-			updateStateFunc(buildID, indexTaskRetry)
-			return
-		}
+// checkTransition returns whether the task can move between the states, the illegal transition is logged and rejected.
+func checkTransition(buildID UniqueID, from, to indexTaskState) bool {
+	if from.canTransitTo(to) {
+		return true
+	}
+	log.Warn("reject the illegal state transition of the index task", zap.Int64("buildID", buildID),
+		zap.String("from", from.String()), zap.String("to", to.String()))
+	metrics.IndexCoordIndexBuilderIllegalTransitionCounter.WithLabelValues(from.String(), to.String()).Inc()
+	return false
+}
 
-		req := &indexpb.CreateIndexRequest{
-			IndexBuildID: buildID,
-			IndexName:    meta.indexMeta.Req.IndexName,
-			IndexID:      meta.indexMeta.Req.IndexID,
-			Version:      meta.indexMeta.IndexVersion + 1,
-			MetaPath:     path.Join(indexFilePrefix, strconv.FormatInt(buildID, 10)),
-			DataPaths:    meta.indexMeta.Req.DataPaths,
-			TypeParams:   meta.indexMeta.Req.TypeParams,
-			IndexParams:  meta.indexMeta.Req.IndexParams,
+// setTaskState changes the state of the task if the transition is allowed, the caller should hold the taskMutex.
+func (ib *indexBuilder) setTaskState(task *indexTask, state indexTaskState) bool {
+	if !checkTransition(task.buildID, task.state, state) {
+		return false
+	}
+	if task.span != nil {
+		task.span.LogKV("state", state.String())
+	}
+	if state != indexTaskInit {
+		task.blockedReason = ""
+	}
+	if task.state != state && ib.auditSink != nil {
+		ib.auditSink.Record(&AuditEvent{
+			Time:     time.Now(),
+			BuildID:  task.buildID,
+			OldState: task.state.String(),
+			NewState: state.String(),
+			NodeID:   task.nodeID,
+			Reason:   transitionReason(task, state),
+		})
+	}
+	switch state {
+	case indexTaskRetry:
+		if task.state != indexTaskRetry {
+			task.lastRetryTime = ib.clock.Now()
+			task.retryJitter = ib.nextRetryJitter()
 		}
-		if err := ib.ic.assignTask(client, req); err != nil {
-			// need to release lock then reassign, so set task state to retry
-			log.Error("index builder assign task to IndexNode failed", zap.Int64("buildID", buildID),
-				zap.Int64("nodeID", nodeID), zap.Error(err))
-			updateStateFunc(buildID, indexTaskRetry)
-			return
+		task.progress = nil
+		if task.nodeID != 0 {
+			if task.failedNodes == nil {
+				task.failedNodes = make(map[UniqueID]struct{})
+			}
+			task.failedNodes[task.nodeID] = struct{}{}
 		}
-		// update index meta state to InProgress
-		if err := ib.meta.BuildIndex(buildID); err != nil {
-			// need to release lock then reassign, so set task state to retry
-			log.Error("index builder update index meta to InProgress failed", zap.Int64("buildID", buildID),
-				zap.Int64("nodeID", nodeID), zap.Error(err))
-			updateStateFunc(buildID, indexTaskRetry)
-			return
+		if task.priority < retryTaskPriority {
+			ib.tasks.Update(task.buildID, retryTaskPriority)
 		}
-		updateStateFunc(buildID, indexTaskInProgress)
-
-	case indexTaskDone:
-		if err := ib.releaseLockAndResetNode(buildID, meta.indexMeta.NodeID); err != nil {
-			// release lock failed, no need to modify state, wait to retry
-			log.Error("index builder try to release reference lock failed", zap.Error(err))
-			return
+	case indexTaskFailed:
+		if task.state != indexTaskFailed {
+			task.failTime = ib.clock.Now()
 		}
-		deleteFunc(buildID)
-	case indexTaskRetry:
-		if err := ib.releaseLockAndResetTask(buildID, meta.indexMeta.NodeID); err != nil {
-			// release lock failed, no need to modify state, wait to retry
-			log.Error("index builder try to release reference lock failed", zap.Error(err))
-			return
+	case indexTaskInProgress:
+		task.lastRetryTime = time.Time{}
+		task.retryDelay = 0
+		task.failedNodes = nil
+		task.deferUntil = time.Time{}
+		if task.state != indexTaskInProgress {
+			task.inProgressTime = ib.clock.Now()
+			task.lastActiveTime = task.inProgressTime
+			task.progress = nil
 		}
-		updateStateFunc(buildID, indexTaskInit)
-		ib.notify()
+	}
+	task.state = state
+	return true
+}
 
-	case indexTaskDeleted:
-		if exist && meta.indexMeta.NodeID != 0 {
-			if err := ib.releaseLockAndResetNode(buildID, meta.indexMeta.NodeID); err != nil {
-				// release lock failed, no need to modify state, wait to retry
-				log.Error("index builder try to release reference lock failed", zap.Error(err))
-				return
-			}
+// updateTaskState changes the state of the task, only recording it if the task is paused.
+func (ib *indexBuilder) updateTaskState(task *indexTask, state indexTaskState) bool {
+	if task.state == indexTaskPaused && state != indexTaskDeleted && state != indexTaskFailed {
+		if !checkTransition(task.buildID, task.pausedState, state) {
+			return false
 		}
-		// reset nodeID success, remove task.
-		deleteFunc(buildID)
+		task.pausedState = state
+		return true
 	}
+	return ib.setTaskState(task, state)
 }
 
-func (ib *indexBuilder) releaseLockAndResetNode(buildID UniqueID, nodeID UniqueID) error {
-	log.Info("release segment reference lock and reset nodeID", zap.Int64("buildID", buildID),
-		zap.Int64("nodeID", nodeID))
-	if err := ib.ic.tryReleaseSegmentReferLock(ib.ctx, buildID, nodeID); err != nil {
-		// release lock failed, no need to modify state, wait to retry
-		log.Error("index builder try to release reference lock failed", zap.Error(err))
-		return err
+// nextRetryDelay returns the backoff delay after the current retry, capped by retryBackoffMax.
+func (ib *indexBuilder) nextRetryDelay(delay time.Duration) time.Duration {
+	if ib.retryBackoffBase <= 0 {
+		return 0
 	}
-	if err := ib.meta.ResetNodeID(buildID); err != nil {
-		log.Error("index builder try to reset nodeID failed", zap.Error(err))
-		return err
+	if delay <= 0 {
+		delay = ib.retryBackoffBase
+	} else {
+		delay *= 2
 	}
-	log.Info("release segment reference lock and reset nodeID success", zap.Int64("buildID", buildID),
-		zap.Int64("nodeID", nodeID))
-	return nil
+	if ib.retryBackoffMax > 0 && delay > ib.retryBackoffMax {
+		delay = ib.retryBackoffMax
+	}
+	return delay
 }
 
-func (ib *indexBuilder) releaseLockAndResetTask(buildID UniqueID, nodeID UniqueID) error {
-	log.Info("release segment reference lock and reset task", zap.Int64("buildID", buildID),
-		zap.Int64("nodeID", nodeID))
-	if nodeID != 0 {
-		if err := ib.ic.tryReleaseSegmentReferLock(ib.ctx, buildID, nodeID); err != nil {
-			// release lock failed, no need to modify state, wait to retry
-			log.Error("index builder try to release reference lock failed", zap.Error(err))
-			return err
-		}
-	}
-	if err := ib.meta.ResetMeta(buildID); err != nil {
-		log.Error("index builder try to reset task failed", zap.Error(err))
-		return err
+// nextRetryJitter returns a random delay in [0, retryJitter).
+func (ib *indexBuilder) nextRetryJitter() time.Duration {
+	if ib.retryJitter <= 0 {
+		return 0
 	}
-	log.Info("release segment reference lock and reset task success", zap.Int64("buildID", buildID),
-		zap.Int64("nodeID", nodeID))
-	return nil
+	return time.Duration(rand.Int63n(int64(ib.retryJitter)))
 }
 
-func (ib *indexBuilder) updateStateByMeta(meta *indexpb.IndexMeta) {
-	ib.taskMutex.Lock()
-	defer ib.taskMutex.Unlock()
-
-	state, ok := ib.tasks[meta.IndexBuildID]
-	if !ok {
-		log.Warn("index task has been processed", zap.Int64("buildId", meta.IndexBuildID), zap.Any("meta", meta))
-		// no need to return error, this task must have been deleted.
+// notify wakes up the scheduler, it is dropped silently after the index builder is stopped.
+func (ib *indexBuilder) notify() {
+	ib.notifyLock.RLock()
+	defer ib.notifyLock.RUnlock()
+	if ib.notifyClosed {
 		return
 	}
-
-	if meta.State == commonpb.IndexState_Finished || meta.State == commonpb.IndexState_Failed {
-		ib.tasks[meta.IndexBuildID] = indexTaskDone
-		ib.notify()
-		log.Info("this task has been finished", zap.Int64("buildID", meta.IndexBuildID),
-			zap.String("original state", state.String()), zap.String("finish or failed", meta.State.String()))
-		return
+	select {
+	case ib.notifyChan <- struct{}{}:
+	default:
 	}
+}
 
-	// index state must be Unissued and NodeID is not zero
-	ib.tasks[meta.IndexBuildID] = indexTaskRetry
-	log.Info("this task need to retry", zap.Int64("buildID", meta.IndexBuildID),
-		zap.String("original state", state.String()), zap.String("index state", meta.State.String()),
-		zap.Int64("original nodeID", meta.NodeID))
-	ib.notify()
+// enqueue enqueues the task, ErrBuilderBusy is returned if the index builder tracks the maximum number of tasks.
+func (ib *indexBuilder) enqueue(buildID UniqueID) error {
+	return ib.enqueueWithPriority(buildID, defaultTaskPriority)
 }
 
-func (ib *indexBuilder) markTaskAsDeleted(buildID UniqueID) {
+// enqueueWithPriority enqueues the task with the priority, the task with higher priority is assigned first.
+func (ib *indexBuilder) enqueueWithPriority(buildID UniqueID, priority int) error {
+	return ib.enqueueBatch([]UniqueID{buildID}, priority)
+}
+
+// enqueueBatch enqueues the tasks with the priority under a single lock and notifies the scheduler once.
+func (ib *indexBuilder) enqueueBatch(buildIDs []UniqueID, priority int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
 	defer ib.notify()
 
-	ib.taskMutex.Lock()
-	defer ib.taskMutex.Unlock()
+	defer ib.lockTasks(enqueueSection)()
 
-	if _, ok := ib.tasks[buildID]; ok {
-		ib.tasks[buildID] = indexTaskDeleted
+	if err := ib.checkCapacity(buildIDs); err != nil {
+		return err
 	}
+	for _, buildID := range buildIDs {
+		ib.enqueueTask(buildID, priority)
+	}
+	return nil
 }
 
-func (ib *indexBuilder) nodeDown(nodeID UniqueID) {
+// acceptNewTasks returns ErrBuilderBusy if the index builder cannot track num more tasks.
+func (ib *indexBuilder) acceptNewTasks(num int) error {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	return ib.checkNewTasks(num)
+}
+
+// checkCapacity returns ErrBuilderBusy if tracking the tasks exceeds the maximum number of tracked tasks.
+func (ib *indexBuilder) checkCapacity(buildIDs []UniqueID) error {
+	newTasks := make(map[UniqueID]struct{}, len(buildIDs))
+	for _, buildID := range buildIDs {
+		if _, ok := ib.tasks.Get(buildID); !ok {
+			newTasks[buildID] = struct{}{}
+		}
+	}
+	return ib.checkNewTasks(len(newTasks))
+}
+
+// checkNewTasks returns ErrBuilderBusy if tracking num more tasks exceeds the maximum number of tracked tasks.
+func (ib *indexBuilder) checkNewTasks(num int) error {
+	if ib.maxTrackedTasks <= 0 || ib.tasks.Len()+num <= ib.maxTrackedTasks {
+		return nil
+	}
+	metrics.IndexCoordIndexBuilderRejectedTaskCounter.WithLabelValues().Add(float64(num))
+	log.Warn("index builder tracks too many tasks, reject the new tasks", zap.Int("tracked", ib.tasks.Len()),
+		zap.Int("new", num), zap.Int("maxTrackedTasks", ib.maxTrackedTasks))
+	return ErrBuilderBusy
+}
+
+// enqueueTask adds the task in init state unless it is being processed, the caller should hold the taskMutex.
+func (ib *indexBuilder) enqueueTask(buildID UniqueID, priority int) {
+	if ib.stopping {
+		// the task is still unissued in meta, it will be recovered by refreshTasks.
+		log.Warn("index builder is stopping, ignore the new task", zap.Int64("buildID", buildID))
+		return
+	}
+	if task, ok := ib.tasks.Get(buildID); ok && !task.state.isTerminal() {
+		log.Warn("index task is already being processed, ignore the duplicate task", zap.Int64("buildID", buildID),
+			zap.String("state", task.state.String()))
+		return
+	}
+	ib.addTask(buildID, indexTaskInit)
+	ib.tasks.Update(buildID, priority)
+}
+
+func (ib *indexBuilder) schedule() {
+	// receive notifyChan
+	// time ticker
+	defer ib.wg.Done()
+	ib.health.start(ib.scheduleDuration)
+	defer ib.health.stop()
+	ticker := time.NewTicker(ib.scheduleDuration)
+	defer ticker.Stop()
+	// debounceC fires the run triggered by the notifications, it is nil if no run is pending. The delay is not
+	// extended by the later notifications, so a task waits for notifyDebounce at most.
+	var debounceTimer *time.Timer
+	var debounceC <-chan time.Time
+	stopDebounce := func() {
+		if debounceTimer != nil {
+			debounceTimer.Stop()
+		}
+		debounceC = nil
+	}
+	defer stopDebounce()
+	for {
+		select {
+		case <-ib.ctx.Done():
+			log.Warn("index builder ctx done")
+			return
+		case duration := <-ib.scheduleChan:
+			// the ticker is reset between two runs, so the running schedule is not affected.
+			log.Info("index builder schedule duration changed", zap.Duration("old", ib.scheduleDuration),
+				zap.Duration("new", duration))
+			ib.scheduleDuration = duration
+			ib.health.setScheduleDuration(duration)
+			ticker.Reset(duration)
+		case _, ok := <-ib.notifyChan:
+			// !ok means indexBuild is closed.
+			if !ok {
+				continue
+			}
+			if ib.notifyDebounce <= 0 {
+				ib.run()
+				continue
+			}
+			if debounceC == nil {
+				debounceTimer = time.NewTimer(ib.notifyDebounce)
+				debounceC = debounceTimer.C
+			}
+		case <-debounceC:
+			debounceC = nil
+			ib.run()
+		case done := <-ib.triggerChan:
+			stopDebounce()
+			ib.run()
+			close(done)
+		case <-ticker.C:
+			// the pending notifications are served by this run.
+			stopDebounce()
+			ib.run()
+		}
+	}
+}
+
+// reclaimTimeoutTasks moves the in-progress tasks without progress for taskTimeout to retry.
+func (ib *indexBuilder) reclaimTimeoutTasks() {
+	if ib.taskTimeout <= 0 {
+		return
+	}
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	for buildID, task := range ib.tasks.tasks {
+		if task.state != indexTaskInProgress || ib.clock.Since(task.lastActiveTime) < ib.taskTimeout {
+			continue
+		}
+		task.failReason = fmt.Sprintf("index task has no progress for %s", ib.taskTimeout)
+		// the IndexNode may be hung, do not reassign the task to it.
+		task.preferNodeID = 0
+		ib.setTaskState(task, indexTaskRetry)
+		log.Warn("index task is timeout, reassign it", zap.Int64("buildID", buildID),
+			zap.Duration("timeout", ib.taskTimeout), zap.Time("last active time", task.lastActiveTime))
+	}
+}
+
+// reclaimOrphanedTasks moves the in-progress tasks whose IndexNode is no longer alive to retry.
+func (ib *indexBuilder) reclaimOrphanedTasks() {
+	if ib.livenessCheckInterval <= 0 {
+		return
+	}
+	now := ib.clock.Now()
+	if now.Sub(ib.lastLivenessCheck) < ib.livenessCheckInterval {
+		return
+	}
+	ib.lastLivenessCheck = now
+	alive := aliveNodeSet(ib.ic.nodeManager.ListAllNodes())
+
+	defer ib.lockTasks(livenessSection)()
+
+	reclaimed := 0
+	for buildID, task := range ib.tasks.tasks {
+		if task.effectiveState() != indexTaskInProgress || task.nodeID == 0 {
+			continue
+		}
+		if _, ok := alive[task.nodeID]; ok {
+			continue
+		}
+		task.failReason = errIndexNodeIsNotOnService(task.nodeID).Error()
+		ib.updateTaskState(task, indexTaskRetry)
+		reclaimed++
+		log.Warn("the IndexNode of the index task is not alive, reassign the task", zap.Int64("buildID", buildID),
+			zap.Int64("nodeID", task.nodeID))
+	}
+	if reclaimed > 0 {
+		log.Info("index builder reclaim the tasks of the IndexNodes which are not alive", zap.Int("tasks", reclaimed))
+	}
+}
+
+// OnTaskComplete registers the hook which is called when an index task is finished.
+func (ib *indexBuilder) OnTaskComplete(hook TaskHook) {
+	ib.hookLock.Lock()
+	defer ib.hookLock.Unlock()
+	ib.completeHooks = append(ib.completeHooks, hook)
+}
+
+// OnTaskFailed registers the hook which is called when an index task is failed.
+func (ib *indexBuilder) OnTaskFailed(hook TaskHook) {
+	ib.hookLock.Lock()
+	defer ib.hookLock.Unlock()
+	ib.failedHooks = append(ib.failedHooks, hook)
+}
+
+// OnCollectionIndexReady registers the hook called when all the index tasks of a collection are finished.
+func (ib *indexBuilder) OnCollectionIndexReady(hook CollectionIndexReadyHook) {
+	ib.hookLock.Lock()
+	defer ib.hookLock.Unlock()
+	ib.readyHooks = append(ib.readyHooks, hook)
+}
+
+// SetAuditSink replaces the sink of the state transitions of the tasks, nil restores the log sink.
+func (ib *indexBuilder) SetAuditSink(sink AuditSink) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	if sink == nil {
+		sink = logAuditSink{}
+	}
+	ib.auditSink = sink
+}
+
+// runCollectionIndexReadyHooks calls the collection index ready hooks if the collection has no unfinished tasks.
+func (ib *indexBuilder) runCollectionIndexReadyHooks(collectionID UniqueID) {
+	if _, ok := ib.unreadyCollections[collectionID]; !ok {
+		return
+	}
+	for _, task := range ib.tasks.tasks {
+		if task.collectionID == collectionID && task.isUnfinished() {
+			return
+		}
+	}
+	delete(ib.unreadyCollections, collectionID)
+
+	ib.hookLock.RLock()
+	hooks := ib.readyHooks
+	ib.hookLock.RUnlock()
+	log.Info("all the index tasks of the collection are finished", zap.Int64("collectionID", collectionID))
+	for _, hook := range hooks {
+		go hook(collectionID)
+	}
+}
+
+// runTaskHooks calls the hooks of the final state of the index meta asynchronously.
+func (ib *indexBuilder) runTaskHooks(meta *indexpb.IndexMeta) {
+	ib.hookLock.RLock()
+	var hooks []TaskHook
+	switch meta.GetState() {
+	case commonpb.IndexState_Finished:
+		hooks = ib.completeHooks
+	case commonpb.IndexState_Failed:
+		hooks = ib.failedHooks
+	}
+	ib.hookLock.RUnlock()
+
+	for _, hook := range hooks {
+		go hook(meta.GetIndexBuildID(), proto.Clone(meta).(*indexpb.IndexMeta))
+	}
+}
+
+// WaitForTask blocks until the index task is finished or failed, and returns the final index state.
+func (ib *indexBuilder) WaitForTask(ctx context.Context, buildID UniqueID) (commonpb.IndexState, error) {
+	ctx, cancel := context.WithTimeout(ctx, waitTaskTimeout)
+	defer cancel()
+
+	// register the waiter before checking the meta, so the final state saved after the check is not missed.
+	ch := make(chan commonpb.IndexState, 1)
+	ib.waiterLock.Lock()
+	ib.waiters[buildID] = append(ib.waiters[buildID], ch)
+	ib.waiterLock.Unlock()
+
+	meta, ok := ib.meta.GetMeta(buildID)
+	if !ok {
+		ib.removeWaiter(buildID, ch)
+		return commonpb.IndexState_IndexStateNone, errIndexTaskNotExist(buildID)
+	}
+	if meta.indexMeta.MarkDeleted {
+		ib.removeWaiter(buildID, ch)
+		return commonpb.IndexState_IndexStateNone, errIndexTaskIsDeleted(buildID)
+	}
+	if state := meta.indexMeta.GetState(); state == commonpb.IndexState_Finished || state == commonpb.IndexState_Failed {
+		ib.removeWaiter(buildID, ch)
+		return state, nil
+	}
+
+	select {
+	case state := <-ch:
+		if state == commonpb.IndexState_IndexStateNone {
+			return state, errIndexTaskIsDeleted(buildID)
+		}
+		return state, nil
+	case <-ctx.Done():
+		ib.removeWaiter(buildID, ch)
+		return commonpb.IndexState_IndexStateNone, ctx.Err()
+	}
+}
+
+func (ib *indexBuilder) removeWaiter(buildID UniqueID, ch chan commonpb.IndexState) {
+	ib.waiterLock.Lock()
+	defer ib.waiterLock.Unlock()
+
+	waiters := ib.waiters[buildID]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(ib.waiters, buildID)
+		return
+	}
+	ib.waiters[buildID] = waiters
+}
+
+// resolveWaiters wakes up all the waiters of the index task with the final state.
+func (ib *indexBuilder) resolveWaiters(buildID UniqueID, state commonpb.IndexState) {
+	ib.waiterLock.Lock()
+	defer ib.waiterLock.Unlock()
+
+	for _, ch := range ib.waiters[buildID] {
+		// the channel is buffered and used only once, it never blocks.
+		ch <- state
+	}
+	delete(ib.waiters, buildID)
+}
+
+// setScheduleDuration changes the interval to schedule the tasks without restarting the index builder.
+func (ib *indexBuilder) setScheduleDuration(duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+	for {
+		select {
+		case ib.scheduleChan <- duration:
+			return
+		default:
+		}
+		// drop the duration which is not received yet.
+		select {
+		case <-ib.scheduleChan:
+		default:
+		}
+	}
+}
+
+// triggerRun runs the tasks once and returns when the run is finished, false if the index builder is stopped.
+func (ib *indexBuilder) triggerRun() bool {
+	ib.taskMutex.RLock()
+	scheduling := ib.scheduling
+	ib.taskMutex.RUnlock()
+	if ib.ctx.Err() != nil {
+		return false
+	}
+	if !scheduling {
+		ib.run()
+		return true
+	}
+	done := make(chan struct{})
+	select {
+	case ib.triggerChan <- done:
+	case <-ib.ctx.Done():
+		return false
+	}
+	// the schedule loop always finishes the run it receives.
+	<-done
+	return true
+}
+
+func (ib *indexBuilder) run() {
+	ib.reclaimTimeoutTasks()
+	ib.reclaimOrphanedTasks()
+
+	unlock := ib.rlockTasks(runSection)
+	taskNum, paused := ib.tasks.Len(), ib.paused
+	// the summary is logged at info level only when it changes significantly, so it does not flood the logs.
+	if paused != ib.lastLoggedPaused || taskNumChanged(ib.lastLoggedTaskNum, taskNum, ib.scheduleLogChangeRatio) {
+		log.Info("index builder task schedule", zap.Int("task num", taskNum), zap.Bool("paused", paused))
+		ib.lastLoggedTaskNum, ib.lastLoggedPaused = taskNum, paused
+	} else {
+		log.Debug("index builder task schedule", zap.Int("task num", taskNum), zap.Bool("paused", paused))
+	}
+	// the run processes the tasks in the order of a single snapshot of the queue.
+	buildIDs := ib.tasks.BuildIDs()
+	ib.updateTaskMetrics()
+	unlock()
+
+	if ib.fairnessWindow > 0 {
+		buildIDs = interleaveTasks(buildIDs, ib.meta.GetCollectionIDs(buildIDs), ib.fairnessWindow)
+	}
+	if ib.maxTasksPerRun > 0 && len(buildIDs) > ib.maxTasksPerRun {
+		deferred := len(buildIDs) - ib.maxTasksPerRun
+		log.Info("index builder defers the tasks to the next run", zap.Int("deferred task num", deferred))
+		metrics.IndexCoordIndexBuilderDeferredTaskCounter.WithLabelValues().Add(float64(deferred))
+		buildIDs = buildIDs[:ib.maxTasksPerRun]
+	}
+	ib.processTasks(buildIDs)
+	ib.health.runCompleted(taskNum)
+	ib.taskMutexStats.export()
+}
+
+// processTasks processes the tasks by a bounded number of workers, so that a slow task does not block the others.
+func (ib *indexBuilder) processTasks(buildIDs []UniqueID) {
+	workerNum := ib.processParallelism
+	if workerNum <= 0 {
+		workerNum = len(ib.ic.nodeManager.ListAllNodes())
+	}
+	if workerNum > len(buildIDs) {
+		workerNum = len(buildIDs)
+	}
+	if workerNum <= 1 {
+		for _, buildID := range buildIDs {
+			ib.process(buildID)
+		}
+		return
+	}
+
+	// the tasks are sent in order, so the tasks in front are still processed first.
+	buildIDChan := make(chan UniqueID)
+	var wg sync.WaitGroup
+	for i := 0; i < workerNum; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for buildID := range buildIDChan {
+				ib.process(buildID)
+			}
+		}()
+	}
+	for _, buildID := range buildIDs {
+		buildIDChan <- buildID
+	}
+	close(buildIDChan)
+	wg.Wait()
+}
+
+// startProcess marks the task as being processed, it returns false if the task is being processed by another worker.
+func (ib *indexBuilder) startProcess(buildID UniqueID) bool {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	if _, ok := ib.processing[buildID]; ok {
+		return false
+	}
+	if _, ok := ib.cleaning[buildID]; ok {
+		// the lock of the finished task is being released by the cleanup workers.
+		return false
+	}
+	ib.processing[buildID] = struct{}{}
+	return true
+}
+
+func (ib *indexBuilder) finishProcess(buildID UniqueID) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	delete(ib.processing, buildID)
+}
+
+// SchedulerHealth returns the health of the schedule loop.
+func (ib *indexBuilder) SchedulerHealth() SchedulerHealth {
+	return ib.health.snapshot()
+}
+
+// componentInfo reports the health of the schedule loop as a subcomponent of IndexCoord.
+func (ib *indexBuilder) componentInfo(nodeID UniqueID) *internalpb.ComponentInfo {
+	info := &internalpb.ComponentInfo{
+		NodeID:    nodeID,
+		Role:      indexSchedulerRole,
+		StateCode: internalpb.StateCode_Healthy,
+	}
+	if err := ib.health.check(); err != nil {
+		info.StateCode = internalpb.StateCode_Abnormal
+		info.ExtraInfo = []*commonpb.KeyValuePair{{Key: "reason", Value: err.Error()}}
+	}
+	return info
+}
+
+// updateTaskMetrics updates the gauges of the task queue, the caller should hold the taskMutex.
+func (ib *indexBuilder) updateTaskMetrics() {
+	taskNums := make(map[indexTaskState]int, len(TaskStateNames))
+	now := ib.clock.Now()
+	var oldestAge time.Duration
+	var weight int64
+	starvingNum := 0
+	for _, task := range ib.tasks.tasks {
+		taskNums[task.state]++
+		if !task.state.isTerminal() {
+			weight += task.cost
+		}
+		if task.state.isTerminal() || task.state == indexTaskPaused {
+			// the paused task is held on purpose.
+			continue
+		}
+		// the ordering policy may keep a task waiting while the number of tasks stays the same.
+		age := now.Sub(task.enqueueTime)
+		if age > oldestAge {
+			oldestAge = age
+		}
+		if ib.starvingTaskThreshold > 0 && age > ib.starvingTaskThreshold {
+			starvingNum++
+		}
+	}
+	metrics.IndexCoordIndexBuilderOldestTaskAge.WithLabelValues().Set(oldestAge.Seconds())
+	metrics.IndexCoordIndexBuilderStarvingTaskNum.WithLabelValues().Set(float64(starvingNum))
+	for state, name := range TaskStateNames {
+		metrics.IndexCoordIndexBuilderTaskStateNum.WithLabelValues(name).Set(float64(taskNums[state]))
+	}
+	metrics.IndexCoordIndexBuilderTaskNum.WithLabelValues().Set(float64(ib.tasks.Len()))
+	metrics.IndexCoordIndexBuilderTaskWeight.WithLabelValues().Set(float64(weight))
+	// the IndexNodes without tasks are removed, so the metric does not grow with the down IndexNodes.
+	metrics.IndexCoordIndexNodeEstimatedLoad.Reset()
+	for nodeID, load := range ib.getNodeLoads() {
+		metrics.IndexCoordIndexNodeEstimatedLoad.WithLabelValues(strconv.FormatInt(nodeID, 10)).Set(float64(load))
+	}
+	ib.updateNodeStateMetrics(now)
+}
+
+// updateNodeStateMetrics records the number of idle and busy IndexNodes, the caller should hold the taskMutex.
+func (ib *indexBuilder) updateNodeStateMetrics(now time.Time) {
+	taskNums := make(map[UniqueID]int)
+	for _, task := range ib.tasks.tasks {
+		if task.takesTaskSlot() {
+			taskNums[task.nodeID]++
+		}
+	}
+	limit := ib.concurrencyLimit(now)
+	nodeIDs := ib.ic.nodeManager.ListAllNodes()
+	idleNum, busyNum := 0, 0
+	for _, nodeID := range nodeIDs {
+		if taskNums[nodeID] == 0 {
+			idleNum++
+		} else if limit > 0 && taskNums[nodeID] >= limit {
+			busyNum++
+		}
+	}
+	metrics.IndexCoordIndexNodeStateNum.WithLabelValues(metrics.IdleIndexNodeLabel).Set(float64(idleNum))
+	metrics.IndexCoordIndexNodeStateNum.WithLabelValues(metrics.BusyIndexNodeLabel).Set(float64(busyNum))
+	metrics.IndexCoordIndexNodeStateNum.WithLabelValues(metrics.TotalLabel).Set(float64(len(nodeIDs)))
+}
+
+func (ib *indexBuilder) process(buildID UniqueID) {
+	if !ib.startProcess(buildID) {
+		log.Debug("index task is being processed", zap.Int64("buildID", buildID))
+		return
+	}
+	defer ib.finishProcess(buildID)
+	if ib.ctx.Err() != nil {
+		// the index builder is stopped, the task is recovered from meta after restart.
+		return
+	}
+
+	unlock := ib.rlockTasks(processSection)
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		unlock()
+		return
+	}
+	state := task.state
+	unlock()
+
+	updateStateFunc := func(buildID UniqueID, state indexTaskState) {
+		defer ib.lockTasks(processSection)()
+		if task, ok := ib.tasks.Get(buildID); ok {
+			ib.updateTaskState(task, state)
+		}
+	}
+
+	retryFunc := func(buildID UniqueID, err error) {
+		if ib.ctx.Err() != nil {
+			// the RPC is interrupted by stopping the index builder, the IndexNode recorded in meta is released and
+			// the task is reassigned when it is recovered from meta.
+			log.Warn("index builder is stopped during processing the task", zap.Int64("buildID", buildID),
+				zap.Error(err))
+			return
+		}
+		defer ib.lockTasks(processSection)()
+		if task, ok := ib.tasks.Get(buildID); ok {
+			task.failReason = err.Error()
+			ib.setLastError(task, err)
+			if task.span != nil {
+				trace.LogError(task.span, err)
+			}
+			ib.updateTaskState(task, indexTaskRetry)
+		}
+	}
+
+	meta, exist := ib.meta.GetMeta(buildID)
+	logger := ib.taskLogger(task, meta)
+
+	deleteFunc := func(buildID UniqueID) {
+		ib.deleteTask(buildID, logger)
+	}
+
+	if !exist {
+		// the meta is removed concurrently, the segment reference lock is released along with the meta.
+		logger.Warn("index meta of the task is not exist, remove the task", zap.String("task state", state.String()))
+		deleteFunc(buildID)
+		ib.resolveWaiters(buildID, commonpb.IndexState_IndexStateNone)
+		return
+	}
+
+	logger.Info("index task is processing", zap.String("task state", state.String()))
+
+	if state == indexTaskPaused {
+		ib.releasePausedTaskLock(task, meta.indexMeta, logger)
+		return
+	}
+
+	if !state.isTerminal() {
+		// the invalid deadline is rejected when the task is created. The deadline is a unix time, it is compared with
+		// the wall clock.
+		deadline, _ := getBuildDeadline(meta.indexMeta.GetReq())
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			logger.Warn("index task is not finished before the deadline, mark it as failed", zap.Time("deadline", deadline))
+			ib.failTask(buildID, meta.indexMeta.NodeID, deadlineExceededFailReason, logger)
+			return
+		}
+	}
+
+	switch state {
+	case indexTaskInit:
+		// peek client
+		// if all IndexNodes are executing task, wait for one of them to finish the task.
+		// prefer the IndexNode which built the segment last time.
+		ib.taskMutex.RLock()
+		preferNodeID, busyUntil, paused, deferUntil := task.preferNodeID, ib.busyUntil, ib.paused, task.deferUntil
+		ib.taskMutex.RUnlock()
+		if paused {
+			logger.Debug("index builder is paused, wait to assign the task")
+			ib.setBlockedReason(task, "index builder is paused")
+			return
+		}
+		pinnedNodeID := ib.pinnedNodeID(meta.indexMeta)
+		if pinnedNodeID == 0 && ib.clock.Now().Before(busyUntil) {
+			logger.Debug("all IndexNodes are busy, wait for free task slots")
+			ib.setBlockedReason(task, "all IndexNodes are busy, wait for free task slots")
+			return
+		}
+		if ib.clock.Now().Before(deferUntil) {
+			logger.Debug("index task is deferred, only the IndexNodes which failed it are available")
+			ib.setBlockedReason(task, fmt.Sprintf("only the IndexNodes which failed the task are available, deferred until %s",
+				deferUntil.Format(time.RFC3339)))
+			return
+		}
+		dataPaths := buildDataPaths(getSegmentOrigin(meta.indexMeta.GetReq()), meta.indexMeta.GetReq().GetDataPaths())
+		// the task without data fails on any IndexNode, it is not assigned to be retried forever.
+		if err := validateDataPaths(dataPaths); err != nil {
+			logger.Warn("index task has no valid data paths, mark it as failed", zap.Error(err))
+			ib.failTask(buildID, meta.indexMeta.NodeID, err.Error(), logger)
+			return
+		}
+		cost := ib.estimateCost(meta.indexMeta)
+		ib.assignLock.Lock()
+		if ib.collectionIsBusy(task) {
+			ib.assignLock.Unlock()
+			logger.Debug("the collection reaches the maximum number of in-progress tasks, wait to assign the task",
+				zap.Int64("collectionID", task.collectionID))
+			ib.setBlockedReason(task, fmt.Sprintf("collection %d reaches the maximum number of in-progress tasks %d",
+				task.collectionID, ib.maxConcurrentTasksPerCollection))
+			return
+		}
+		busyNodes := ib.getBusyNodes()
+		excludedNodes := ib.excludeFailedNodes(task, busyNodes)
+		group := meta.indexMeta.GetReq().GetResourceGroup()
+		var nodeID UniqueID
+		var client types.IndexNode
+		var err error
+		if pinnedNodeID != 0 {
+			// the pinned task bypasses the node selection, it only waits for a free task slot of the pinned IndexNode.
+			nodeID, client, err = ib.pinnedClient(pinnedNodeID, busyNodes)
+		} else {
+			nodeID, client, err = ib.peekClient(meta, group, preferNodeID, excludedNodes)
+		}
+		deferred := false
+		if pinnedNodeID == 0 && errors.Is(err, ErrIndexNodesBusy) && len(excludedNodes) > len(busyNodes) {
+			// only the IndexNodes which failed the task may be available, the task is deferred instead of being
+			// assigned back and forth between them.
+			_, _, peekErr := ib.peekClient(meta, group, preferNodeID, busyNodes)
+			deferred = peekErr == nil
+		}
+		if err == nil {
+			// the task takes the task slot of the IndexNode from now on.
+			ib.taskMutex.Lock()
+			task.nodeID = nodeID
+			task.preferNodeID = nodeID
+			task.cost = cost
+			ib.taskMutex.Unlock()
+		}
+		ib.assignLock.Unlock()
+		if deferred {
+			ib.deferTask(task, logger)
+			return
+		}
+		if pinnedNodeID != 0 && err != nil {
+			if errors.Is(err, ErrPinnedNodeBusy) {
+				logger.Debug("the pinned IndexNode is busy, wait for free task slots", zap.Int64("pinnedNodeID", pinnedNodeID))
+				ib.setBlockedReason(task, fmt.Sprintf("pinned IndexNode %d is busy", pinnedNodeID))
+				return
+			}
+			// the task is not assigned to another IndexNode, which is not what the operator asks for.
+			logger.Warn("the pinned IndexNode is not available, mark the task as failed",
+				zap.Int64("pinnedNodeID", pinnedNodeID), zap.Error(err))
+			ib.failTask(buildID, meta.indexMeta.NodeID, err.Error(), logger)
+			return
+		}
+		ib.updatePeekClientResult(group, err)
+		if err != nil {
+			if errors.Is(err, ErrIndexNodesBusy) {
+				logger.Info("index builder peek client failed, all IndexNodes are busy", zap.Int("busy nodes", len(busyNodes)))
+			} else {
+				logger.Warn("index builder peek client failed", zap.Error(err))
+			}
+			if group != "" {
+				ib.setBlockedReason(task, fmt.Sprintf("%s in resource group %s", err.Error(), group))
+			} else {
+				ib.setBlockedReason(task, err.Error())
+			}
+			return
+		}
+		logger = logger.With(zap.Int64("nodeID", nodeID))
+		cancelAssignFunc := func() {
+			ib.taskMutex.Lock()
+			task.nodeID = 0
+			ib.taskMutex.Unlock()
+		}
+		// the segment reference lock prevents the segment from being compacted, but the segment may be compacted
+		// before the lock is acquired. The index of the compacting segment is useless once the compaction completes,
+		// so the task waits for the compacted segment and builds its index instead.
+		segmentState, err := ib.ic.getSegmentState(ib.ctx, meta.indexMeta.Req.SegmentID)
+		if err != nil {
+			logger.Warn("index builder check segment state failed, wait to retry", zap.Error(err))
+			cancelAssignFunc()
+			ib.setBlockedReason(task, fmt.Sprintf("failed to check segment state: %s", err.Error()))
+			return
+		}
+		if segmentState.GetCompacting() {
+			logger.Info("segment of the index task is being compacted, wait for the compacted segment")
+			cancelAssignFunc()
+			ib.setBlockedReason(task, fmt.Sprintf("segment %d is being compacted", meta.indexMeta.Req.SegmentID))
+			return
+		}
+		if isSegmentDropped(segmentState) {
+			cancelAssignFunc()
+			if compactedTo := segmentState.GetCompactedTo(); compactedTo != 0 {
+				ib.moveToCompactedSegment(task, meta.indexMeta, compactedTo, logger)
+				return
+			}
+			logger.Info("segment of the index task has been dropped, mark the task as failed")
+			ib.failTask(buildID, 0, segmentDroppedFailReason, logger)
+			return
+		}
+		if ib.lockAcquireLimiter != nil && !ib.lockAcquireLimiter.Allow() {
+			logger.Debug("segment reference lock acquisitions are throttled, wait for the next schedule")
+			cancelAssignFunc()
+			metrics.IndexCoordSegmentLockThrottledCounter.WithLabelValues().Inc()
+			ib.setBlockedReason(task, "segment reference lock acquisitions are throttled")
+			return
+		}
+		// update version and set nodeID
+		if err := ib.meta.UpdateVersion(buildID, nodeID); err != nil {
+			logger.Error("index builder update index version failed", zap.Error(err))
+			cancelAssignFunc()
+			ib.setBlockedReason(task, fmt.Sprintf("failed to update index version: %s", err.Error()))
+			ib.taskMutex.Lock()
+			ib.setLastError(task, wrapError(ErrMetaUpdateFailed, err))
+			ib.taskMutex.Unlock()
+			return
+		}
+		// the IndexNode keeps the version in the index meta it saves, so the reports of the stale assignments
+		// can be told apart.
+		version := meta.indexMeta.IndexVersion + 1
+		ib.taskMutex.Lock()
+		task.assignedVersion = version
+		ib.taskMutex.Unlock()
+
+		// acquire lock
+		if err := ib.ic.tryAcquireSegmentReferLock(ib.ctx, buildID, nodeID, []UniqueID{meta.indexMeta.Req.SegmentID}); err != nil {
+			logger.Error("index builder acquire segment reference lock failed", zap.Error(err))
+			// the task has not been sent to the IndexNode, the version can be reverted. Once it is sent, the
+			// version is kept even if the assignment fails, the IndexNode may have received the task.
+			if rollbackErr := ib.revertVersion(buildID, nodeID, version); rollbackErr != nil {
+				logger.Warn("index builder revert index version failed, reset the task", zap.Error(rollbackErr))
+			} else {
+				ib.taskMutex.Lock()
+				task.assignedVersion = 0
+				ib.taskMutex.Unlock()
+			}
+			retryFunc(buildID, wrapError(ErrLockAcquireFailed, err))
+			return
+		}
+		ib.taskMutex.Lock()
+		task.lockAcquireTime = ib.clock.Now()
+		checkpointPath := task.checkpointPath
+		ib.taskMutex.Unlock()
+
+		req := &indexpb.CreateIndexRequest{
+			IndexBuildID: buildID,
+			IndexName:    meta.indexMeta.Req.IndexName,
+			IndexID:      meta.indexMeta.Req.IndexID,
+			Version:      version,
+			MetaPath:     path.Join(indexFilePrefix, strconv.FormatInt(buildID, 10)),
+			DataPaths:    dataPaths,
+			TypeParams:   meta.indexMeta.Req.TypeParams,
+			IndexParams:  meta.indexMeta.Req.IndexParams,
+			TraceContext: trace.InjectContextToMap(task.span.Context()),
+			// the build is resumed from the checkpoint of the last assignment, such as before the IndexNode is down.
+			CheckpointPath: checkpointPath,
+		}
+		// the span of the task is propagated to the IndexNode, so the IndexNode joins the trace of the request.
+		err = ib.ic.assignTask(opentracing.ContextWithSpan(ib.ctx, task.span), client, req)
+		ib.ic.nodeManager.ReportAssignResult(nodeID, err)
+		if err != nil {
+			// need to release lock then reassign, so set task state to retry
+			logger.Error("index builder assign task to IndexNode failed", zap.Error(err))
+			retryFunc(buildID, wrapError(ErrAssignFailed, err))
+			return
+		}
+		// update index meta state to InProgress
+		if err := ib.meta.BuildIndex(buildID); err != nil {
+			// need to release lock then reassign, so set task state to retry
+			logger.Error("index builder update index meta to InProgress failed", zap.Error(err))
+			retryFunc(buildID, wrapError(ErrMetaUpdateFailed, err))
+			return
+		}
+		updateStateFunc(buildID, indexTaskInProgress)
+		logger.Info("index task is assigned to IndexNode")
+
+	case indexTaskDone:
+		if ib.cleanupChan != nil {
+			ib.enqueueCleanup(buildID, logger)
+			return
+		}
+		ib.completeDoneTask(task, meta.indexMeta, logger)
+	case indexTaskRetry:
+		ib.taskMutex.RLock()
+		retryCount, failReason := task.retryCount, task.failReason
+		lastRetryTime, retryDelay := task.lastRetryTime, task.retryDelay+task.retryJitter
+		ib.taskMutex.RUnlock()
+		if ib.maxTaskRetry > 0 && retryCount >= ib.maxTaskRetry {
+			logger.Warn("index task has been retried too many times, mark it as failed",
+				zap.Int("retry count", retryCount), zap.String("fail reason", failReason))
+			ib.failTask(buildID, meta.indexMeta.NodeID, failReason, logger)
+			return
+		}
+		if wait := retryDelay - ib.clock.Since(lastRetryTime); wait > 0 {
+			logger.Debug("index task is in retry backoff window",
+				zap.Duration("retry delay", retryDelay), zap.Duration("wait", wait))
+			return
+		}
+		if err := ib.releaseLockAndResetTask(buildID, meta.indexMeta.NodeID); err != nil {
+			// release lock failed, no need to modify state, wait to retry
+			logger.Error("index builder try to release reference lock failed", zap.Error(err))
+			ib.taskMutex.Lock()
+			ib.setLastError(task, err)
+			ib.taskMutex.Unlock()
+			return
+		}
+		ib.taskMutex.Lock()
+		task.nodeID = 0
+		task.lockAcquireTime = time.Time{}
+		task.retryCount++
+		task.retryDelay = ib.nextRetryDelay(task.retryDelay)
+		retryMeta := &taskRetryMeta{
+			BuildID:    buildID,
+			RetryCount: task.retryCount,
+			FailReason: task.failReason,
+			RetryDelay: task.retryDelay,
+		}
+		ib.taskMutex.Unlock()
+		if err := ib.meta.SaveTaskRetryMeta(retryMeta); err != nil {
+			// the retry history is only used to limit retries, no need to block the task.
+			logger.Warn("index builder save task retry meta failed", zap.Error(err))
+		}
+		metrics.IndexCoordIndexBuilderTaskRetryCounter.WithLabelValues().Inc()
+		updateStateFunc(buildID, indexTaskInit)
+		logger.Info("index task is reset to be reassigned", zap.Int("retry count", retryMeta.RetryCount))
+		ib.notify()
+
+	case indexTaskFailed:
+		// the lock has been released and the meta has been marked as failed, the task is kept for inspection until
+		// its retention expires.
+		ib.taskMutex.RLock()
+		failTime := task.failTime
+		ib.taskMutex.RUnlock()
+		if ib.failedTaskRetention > 0 && ib.clock.Since(failTime) < ib.failedTaskRetention {
+			return
+		}
+		deleteFunc(buildID)
+
+	case indexTaskDeleted:
+		if exist && meta.indexMeta.NodeID != 0 {
+			if err := ib.releaseLockAndResetNode(buildID, meta.indexMeta.NodeID); err != nil {
+				// release lock failed, no need to modify state, wait to retry
+				logger.Error("index builder try to release reference lock failed", zap.Error(err))
+				ib.taskMutex.Lock()
+				ib.setLastError(task, err)
+				ib.taskMutex.Unlock()
+				return
+			}
+		}
+		// reset nodeID success, remove task.
+		deleteFunc(buildID)
+	}
+}
+
+// revertVersion releases the segment reference lock and reverts the version of the index meta of a failed request.
+func (ib *indexBuilder) revertVersion(buildID UniqueID, nodeID UniqueID, version int64) error {
+	if err := ib.ic.tryReleaseSegmentReferLock(ib.ctx, buildID, nodeID); err != nil {
+		return wrapError(ErrLockReleaseFailed, err)
+	}
+	if err := ib.meta.RevertVersion(buildID, nodeID, version); err != nil {
+		return wrapError(ErrMetaUpdateFailed, err)
+	}
+	return nil
+}
+
+// moveToCompactedSegment moves the build of the unissued task to the segment which its segment is compacted into.
+func (ib *indexBuilder) moveToCompactedSegment(task *indexTask, indexMeta *indexpb.IndexMeta, segmentID UniqueID, logger *zap.Logger) {
+	logger = logger.With(zap.Int64("compactedTo", segmentID))
+	dataPaths, numRows, err := ib.ic.getSegmentDataPaths(ib.ctx, segmentID, indexMeta.GetReq().GetFieldSchema().GetFieldID())
+	if err != nil {
+		logger.Warn("index builder get data paths of the compacted segment failed, wait to retry", zap.Error(err))
+		ib.setBlockedReason(task, fmt.Sprintf("failed to get data paths of compacted segment %d: %s", segmentID, err.Error()))
+		return
+	}
+	req := proto.Clone(indexMeta.GetReq()).(*indexpb.BuildIndexRequest)
+	req.SegmentID = segmentID
+	req.DataPaths = dataPaths
+	req.NumRows = numRows
+	if exist, existBuildID := ib.meta.HasSameReq(req); exist {
+		logger.Info("compacted segment is indexed by another build, mark the task as failed",
+			zap.Int64("existBuildID", existBuildID))
+		ib.failTask(task.buildID, 0, segmentDroppedFailReason, logger)
+		return
+	}
+	if err := ib.meta.MoveToSegment(task.buildID, segmentID, dataPaths, numRows); err != nil {
+		logger.Warn("index builder move the task to the compacted segment failed, wait to retry", zap.Error(err))
+		ib.setBlockedReason(task, fmt.Sprintf("failed to move to compacted segment %d: %s", segmentID, err.Error()))
+		return
+	}
+	logger.Info("segment of the index task has been compacted, build the index of the compacted segment")
+	ib.taskMutex.Lock()
+	task.segmentID = segmentID
+	task.checkpointPath = ""
+	task.blockedReason = ""
+	ib.taskMutex.Unlock()
+	ib.notify()
+}
+
+// failTask releases the segment reference lock held by the IndexNode and marks the task as failed.
+func (ib *indexBuilder) failTask(buildID UniqueID, nodeID UniqueID, failReason string, logger *zap.Logger) {
+	if err := ib.releaseLockAndMarkFailed(buildID, nodeID, failReason); err != nil {
+		// release lock failed, no need to modify state, wait to retry
+		logger.Error("index builder try to release reference lock failed", zap.Error(err))
+		ib.taskMutex.Lock()
+		if task, ok := ib.tasks.Get(buildID); ok {
+			ib.setLastError(task, err)
+		}
+		ib.taskMutex.Unlock()
+		return
+	}
+	ib.taskMutex.Lock()
+	task, ok := ib.tasks.Get(buildID)
+	if ok {
+		task.failReason = failReason
+		ib.setTaskState(task, indexTaskFailed)
+	}
+	ib.taskMutex.Unlock()
+	if ok {
+		ib.recordCompletedTask(task, nodeID, commonpb.IndexState_Failed)
+	}
+	ib.health.taskCompleted()
+	ib.resolveWaiters(buildID, commonpb.IndexState_Failed)
+	if failedMeta, ok := ib.meta.GetMeta(buildID); ok {
+		ib.runTaskHooks(failedMeta.indexMeta)
+	}
+}
+
+// updatePeekClientResult records the result of peeking IndexNode in the resource group.
+func (ib *indexBuilder) updatePeekClientResult(group string, err error) {
+	if group != "" && err != nil {
+		reason := metrics.IndexNodesBusyLabel
+		if errors.Is(err, ErrNoIndexNode) {
+			reason = metrics.NoIndexNodeLabel
+		}
+		metrics.IndexCoordResourceGroupSaturatedCounter.WithLabelValues(group, reason).Inc()
+		return
+	}
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	switch {
+	case err == nil:
+		ib.noIndexNodeSince = time.Time{}
+		metrics.IndexCoordNoIndexNodeDuration.WithLabelValues().Set(0)
+	case errors.Is(err, ErrIndexNodesBusy):
+		ib.busyUntil = ib.clock.Now().Add(indexNodesBusyBackoff)
+		ib.noIndexNodeSince = time.Time{}
+		metrics.IndexCoordNoIndexNodeDuration.WithLabelValues().Set(0)
+		metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.IndexNodesBusyLabel).Inc()
+	case errors.Is(err, ErrNoIndexNode):
+		if ib.noIndexNodeSince.IsZero() {
+			ib.noIndexNodeSince = ib.clock.Now()
+		}
+		duration := ib.clock.Since(ib.noIndexNodeSince)
+		log.Warn("there is no IndexNode to assign index tasks", zap.Duration("duration", duration))
+		metrics.IndexCoordNoIndexNodeDuration.WithLabelValues().Set(duration.Seconds())
+		metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.NoIndexNodeLabel).Inc()
+	}
+}
+
+// SetCostEstimator replaces the estimator of the work of the tasks, nil restores the default estimator.
+func (ib *indexBuilder) SetCostEstimator(estimator TaskCostEstimator) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	if estimator == nil {
+		estimator = rowCountCost
+	}
+	ib.costEstimator = estimator
+}
+
+// SetNodeSelector replaces the selector of the IndexNodes, nil restores the default selector.
+func (ib *indexBuilder) SetNodeSelector(selector NodeSelector) {
+	ib.assignLock.Lock()
+	defer ib.assignLock.Unlock()
+	if selector == nil {
+		selector = &nodeManagerSelector{ic: ib.ic}
+	}
+	ib.nodeSelector = selector
+}
+
+// estimateCost returns the estimated work of the task.
+func (ib *indexBuilder) estimateCost(meta *indexpb.IndexMeta) int64 {
+	ib.taskMutex.RLock()
+	estimator := ib.costEstimator
+	ib.taskMutex.RUnlock()
+	return estimator(meta)
+}
+
+// getNodeLoads returns the estimated work of the tasks on each IndexNode, the caller should hold the taskMutex.
+func (ib *indexBuilder) getNodeLoads() map[UniqueID]int64 {
+	loads := make(map[UniqueID]int64)
+	for _, task := range ib.tasks.tasks {
+		if task.takesTaskSlot() {
+			loads[task.nodeID] += task.cost
+		}
+	}
+	return loads
+}
+
+// setPeakWindows reloads the peak windows, the invalid value is ignored.
+func (ib *indexBuilder) setPeakWindows(value string) {
+	windows, err := parsePeakWindows(value)
+	if err != nil {
+		log.Warn("invalid peak windows of index builder, ignore it", zap.String("value", value), zap.Error(err))
+		return
+	}
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	ib.peakWindows = windows
+	log.Info("index builder peak windows changed", zap.String("value", value))
+}
+
+// concurrencyLimit returns the maximum number of in-progress tasks on an IndexNode at the time, zero means no limit.
+func (ib *indexBuilder) concurrencyLimit(t time.Time) int {
+	limit := ib.maxConcurrentTasksPerNode
+	if peakLimit, ok := peakConcurrency(ib.peakWindows, t); ok && (limit <= 0 || peakLimit < limit) {
+		limit = peakLimit
+	}
+	return limit
+}
+
+// getBusyNodes returns the IndexNodes which have reached the concurrency limit, the caller should hold the assignLock.
+func (ib *indexBuilder) getBusyNodes() map[UniqueID]struct{} {
+	busyNodes := make(map[UniqueID]struct{})
+	ib.taskMutex.RLock()
+	// the draining IndexNodes take no more tasks, as if they are busy.
+	for nodeID := range ib.drainingNodes {
+		busyNodes[nodeID] = struct{}{}
+	}
+	limit := ib.concurrencyLimit(time.Now())
+	if limit <= 0 {
+		ib.taskMutex.RUnlock()
+		return busyNodes
+	}
+	taskNums := make(map[UniqueID]int)
+	for _, task := range ib.tasks.tasks {
+		if task.takesTaskSlot() {
+			taskNums[task.nodeID]++
+		}
+	}
+	ib.taskMutex.RUnlock()
+	for nodeID, taskNum := range taskNums {
+		if taskNum >= limit {
+			busyNodes[nodeID] = struct{}{}
+		}
+	}
+	return busyNodes
+}
+
+// collectionIsBusy returns whether the collection of the task reaches maxConcurrentTasksPerCollection.
+func (ib *indexBuilder) collectionIsBusy(task *indexTask) bool {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	if ib.maxConcurrentTasksPerCollection <= 0 || task.collectionID == 0 {
+		return false
+	}
+	taskNum := 0
+	for _, t := range ib.tasks.tasks {
+		if t.collectionID == task.collectionID && t.takesTaskSlot() {
+			taskNum++
+		}
+	}
+	return taskNum >= ib.maxConcurrentTasksPerCollection
+}
+
+// newLockAcquireLimiter returns the limiter of acquiring the segment reference locks, nil if the rate is not positive.
+func newLockAcquireLimiter(ratePerSecond float64) *rate.Limiter {
+	if ratePerSecond <= 0 {
+		return nil
+	}
+	burst := int(ratePerSecond)
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(ratePerSecond), burst)
+}
+
+// setBlockedReason records the reason why the task in init state is not assigned this time.
+func (ib *indexBuilder) setBlockedReason(task *indexTask, reason string) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	task.blockedReason = reason
+}
+
+// setLastError records the truncated error which the processing of the task failed on.
+func (ib *indexBuilder) setLastError(task *indexTask, err error) {
+	task.lastError = truncateString(err.Error(), maxTaskErrorLength)
+	task.lastErrorTime = ib.clock.Now()
+}
+
+// peekClient peeks the IndexNode by the NodeSelector, the caller should hold the assignLock.
+func (ib *indexBuilder) peekClient(meta *Meta, group string, preferNodeID UniqueID, busyNodes map[UniqueID]struct{}) (UniqueID, types.IndexNode, error) {
+	var loads map[UniqueID]int64
+	if ib.balanceByCost {
+		ib.taskMutex.RLock()
+		loads = ib.getNodeLoads()
+		ib.taskMutex.RUnlock()
+	}
+	return ib.nodeSelector.SelectNode(meta, group, preferNodeID, busyNodes, loads)
+}
+
+// pinnedNodeID returns the IndexNode which the task is pinned to, zero if the task is not pinned.
+func (ib *indexBuilder) pinnedNodeID(indexMeta *indexpb.IndexMeta) UniqueID {
+	if !ib.allowNodePinning {
+		return 0
+	}
+	return indexMeta.GetReq().GetPinnedNodeID()
+}
+
+// pinnedClient returns the client of the IndexNode which the task is pinned to.
+func (ib *indexBuilder) pinnedClient(nodeID UniqueID, busyNodes map[UniqueID]struct{}) (UniqueID, types.IndexNode, error) {
+	client, ok := ib.ic.nodeManager.GetClient(nodeID)
+	if !ok {
+		return 0, nil, errIndexNodeIsNotOnService(nodeID)
+	}
+	if _, ok := busyNodes[nodeID]; ok {
+		return 0, nil, ErrPinnedNodeBusy
+	}
+	return nodeID, client, nil
+}
+
+// excludeFailedNodes returns the busy IndexNodes along with the IndexNodes which failed the task.
+func (ib *indexBuilder) excludeFailedNodes(task *indexTask, busyNodes map[UniqueID]struct{}) map[UniqueID]struct{} {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	if len(task.failedNodes) == 0 {
+		return busyNodes
+	}
+	excludedNodes := make(map[UniqueID]struct{}, len(busyNodes)+len(task.failedNodes))
+	for nodeID := range busyNodes {
+		excludedNodes[nodeID] = struct{}{}
+	}
+	for nodeID := range task.failedNodes {
+		excludedNodes[nodeID] = struct{}{}
+	}
+	return excludedNodes
+}
+
+// deferTask defers the assignment of the task when only the IndexNodes which failed it are available.
+func (ib *indexBuilder) deferTask(task *indexTask, logger *zap.Logger) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	delay := ib.nextRetryDelay(task.retryDelay)
+	task.deferUntil = ib.clock.Now().Add(delay)
+	task.failedNodes = nil
+	task.blockedReason = fmt.Sprintf("only the IndexNodes which failed the task are available, deferred until %s",
+		task.deferUntil.Format(time.RFC3339))
+	logger.Info("only the IndexNodes which failed the index task are available, defer the task",
+		zap.Duration("delay", delay))
+}
+
+// completeDoneTask releases the segment reference lock of the finished task and removes the task.
+func (ib *indexBuilder) completeDoneTask(task *indexTask, indexMeta *indexpb.IndexMeta, logger *zap.Logger) {
+	if !ib.releaseDoneTaskLock(task, indexMeta, logger) {
+		// release lock failed, no need to modify state, wait to retry
+		return
+	}
+	if err := ib.meta.ResetNodeID(task.buildID); err != nil {
+		logger.Error("index builder try to reset nodeID failed", zap.Error(err))
+		return
+	}
+	ib.recordCompletedTask(task, indexMeta.NodeID, indexMeta.State)
+	ib.deleteTask(task.buildID, logger)
+	ib.health.taskCompleted()
+	metrics.IndexCoordIndexBuilderTaskCompletedCounter.WithLabelValues().Inc()
+	logger.Info("index task is completed")
+}
+
+// recordCompletedTask adds the summary of the completed task to the history of the recently completed tasks.
+func (ib *indexBuilder) recordCompletedTask(task *indexTask, nodeID UniqueID, result commonpb.IndexState) {
+	ib.taskMutex.RLock()
+	enqueueTime := task.enqueueTime
+	ib.taskMutex.RUnlock()
+
+	now := ib.clock.Now()
+	ib.completedTasks.add(completedTaskInfo{
+		buildID:      task.buildID,
+		nodeID:       nodeID,
+		duration:     now.Sub(enqueueTime),
+		result:       result,
+		completeTime: now,
+	})
+}
+
+// EstimateBuildTime predicts the time to build the index of the request by the finished builds.
+func (ib *indexBuilder) EstimateBuildTime(req *indexpb.BuildIndexRequest) (BuildTimeEstimate, error) {
+	indexType := getIndexType(req.GetIndexParams())
+	estimate, ok := ib.buildTimes.estimate(indexType, req.GetNumRows())
+	if !ok {
+		return BuildTimeEstimate{}, errNoBuildTimeSamples(indexType)
+	}
+	return estimate, nil
+}
+
+// ListCompletedTasks returns the summaries of the recently completed tasks from the most recent one.
+func (ib *indexBuilder) ListCompletedTasks() []completedTaskInfo {
+	return ib.completedTasks.list()
+}
+
+// deleteTask removes the task along with its retry meta.
+func (ib *indexBuilder) deleteTask(buildID UniqueID, logger *zap.Logger) {
+	if err := ib.meta.RemoveTaskRetryMeta(buildID); err != nil {
+		// the useless retry meta will be removed when the tasks are refreshed.
+		logger.Warn("index builder remove task retry meta failed", zap.Error(err))
+	}
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	ib.removeTask(buildID)
+}
+
+// releaseDoneTaskLock releases the segment reference lock of the finished task with backoff.
+func (ib *indexBuilder) releaseDoneTaskLock(task *indexTask, indexMeta *indexpb.IndexMeta, logger *zap.Logger) bool {
+	ib.taskMutex.RLock()
+	failCount, lastFailTime, delay := task.releaseFailCount, task.lastReleaseFailTime, task.releaseDelay
+	ib.taskMutex.RUnlock()
+	if wait := delay - ib.clock.Since(lastFailTime); wait > 0 {
+		logger.Debug("index task is in release lock backoff window", zap.Duration("wait", wait))
+		return false
+	}
+
+	err := ib.ic.releaseSegmentReferLock(ib.ctx, indexMeta.IndexBuildID, indexMeta.NodeID)
+	if err == nil {
+		ib.observeLockHoldTime(task, logger)
+		return true
+	}
+	metrics.IndexCoordSegmentLockReleaseFailureCounter.WithLabelValues(lockFailureCategory(nil, err)).Inc()
+	failCount++
+	logger.Error("index builder try to release reference lock failed", zap.Int("fail count", failCount), zap.Error(err))
+	if ib.maxReleaseLockRetry <= 0 || failCount < ib.maxReleaseLockRetry {
+		ib.taskMutex.Lock()
+		task.releaseFailCount = failCount
+		task.releaseDelay = ib.nextRetryDelay(task.releaseDelay)
+		task.lastReleaseFailTime = ib.clock.Now()
+		ib.taskMutex.Unlock()
+		return false
+	}
+
+	lockMeta := &orphanedLockMeta{
+		BuildID:    indexMeta.IndexBuildID,
+		NodeID:     indexMeta.NodeID,
+		SegmentID:  indexMeta.GetReq().GetSegmentID(),
+		FailReason: err.Error(),
+		Timestamp:  time.Now().Unix(),
+	}
+	if err := ib.meta.SaveOrphanedLockMeta(lockMeta); err != nil {
+		logger.Error("index builder save orphaned lock meta failed", zap.Error(err))
+		return false
+	}
+	metrics.IndexCoordOrphanedSegmentLockCounter.WithLabelValues().Inc()
+	logger.Error("segment reference lock of the finished index task is orphaned, it needs to be released manually",
+		zap.Int("fail count", failCount))
+	return true
+}
+
+// observeLockHoldTime records how long the segment reference lock of the task has been held.
+func (ib *indexBuilder) observeLockHoldTime(task *indexTask, logger *zap.Logger) {
+	ib.taskMutex.RLock()
+	acquireTime := task.lockAcquireTime
+	ib.taskMutex.RUnlock()
+	if acquireTime.IsZero() {
+		// the time when the lock was acquired is unknown after recovery.
+		return
+	}
+	holdTime := ib.clock.Since(acquireTime)
+	metrics.IndexCoordSegmentLockHoldDuration.WithLabelValues().Observe(holdTime.Seconds())
+	if ib.lockHoldWarnThreshold > 0 && holdTime > ib.lockHoldWarnThreshold {
+		logger.Warn("segment reference lock is held for a long time", zap.Duration("hold time", holdTime),
+			zap.Duration("threshold", ib.lockHoldWarnThreshold))
+	}
+}
+
+func (ib *indexBuilder) releaseLockAndResetNode(buildID UniqueID, nodeID UniqueID) error {
+	log.Info("release segment reference lock and reset nodeID", zap.Int64("buildID", buildID),
+		zap.Int64("nodeID", nodeID))
+	if err := ib.ic.tryReleaseSegmentReferLock(ib.ctx, buildID, nodeID); err != nil {
+		// release lock failed, no need to modify state, wait to retry
+		log.Error("index builder try to release reference lock failed", zap.Error(err))
+		return wrapError(ErrLockReleaseFailed, err)
+	}
+	if err := ib.meta.ResetNodeID(buildID); err != nil {
+		log.Error("index builder try to reset nodeID failed", zap.Error(err))
+		return wrapError(ErrMetaUpdateFailed, err)
+	}
+	log.Info("release segment reference lock and reset nodeID success", zap.Int64("buildID", buildID),
+		zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+func (ib *indexBuilder) releaseLockAndResetTask(buildID UniqueID, nodeID UniqueID) error {
+	log.Info("release segment reference lock and reset task", zap.Int64("buildID", buildID),
+		zap.Int64("nodeID", nodeID))
+	if nodeID != 0 {
+		if err := ib.ic.tryReleaseSegmentReferLock(ib.ctx, buildID, nodeID); err != nil {
+			// release lock failed, no need to modify state, wait to retry
+			log.Error("index builder try to release reference lock failed", zap.Error(err))
+			return wrapError(ErrLockReleaseFailed, err)
+		}
+	}
+	if err := ib.meta.ResetMeta(buildID); err != nil {
+		log.Error("index builder try to reset task failed", zap.Error(err))
+		return wrapError(ErrMetaUpdateFailed, err)
+	}
+	log.Info("release segment reference lock and reset task success", zap.Int64("buildID", buildID),
+		zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+func (ib *indexBuilder) releaseLockAndMarkFailed(buildID UniqueID, nodeID UniqueID, failReason string) error {
+	log.Info("release segment reference lock and mark task as failed", zap.Int64("buildID", buildID),
+		zap.Int64("nodeID", nodeID))
+	if nodeID != 0 {
+		if err := ib.ic.tryReleaseSegmentReferLock(ib.ctx, buildID, nodeID); err != nil {
+			// release lock failed, no need to modify state, wait to retry
+			log.Error("index builder try to release reference lock failed", zap.Error(err))
+			return wrapError(ErrLockReleaseFailed, err)
+		}
+	}
+	if err := ib.meta.MarkIndexAsFailed(buildID, failReason); err != nil {
+		log.Error("index builder try to mark task as failed failed", zap.Error(err))
+		return wrapError(ErrMetaUpdateFailed, err)
+	}
+	log.Info("release segment reference lock and mark task as failed success", zap.Int64("buildID", buildID),
+		zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+// missingIndexFile returns the first index file of the finished task that does not exist in the storage.
+func (ib *indexBuilder) missingIndexFile(meta *indexpb.IndexMeta) string {
+	if !ib.verifyIndexFiles || meta.State != commonpb.IndexState_Finished || ib.ic == nil || ib.ic.chunkManager == nil {
+		return ""
+	}
+	for _, filePath := range meta.IndexFilePaths {
+		exist, err := ib.ic.chunkManager.Exist(filePath)
+		if err != nil {
+			// the storage is unavailable, the files are not checked rather than rebuilding the index.
+			log.Warn("index builder failed to check the index file", zap.Int64("buildID", meta.IndexBuildID),
+				zap.String("file", filePath), zap.Error(err))
+			return ""
+		}
+		if !exist {
+			return filePath
+		}
+	}
+	return ""
+}
+
+func (ib *indexBuilder) updateStateByMeta(meta *indexpb.IndexMeta) {
+	// check the storage before holding the lock.
+	missingFile := ib.missingIndexFile(meta)
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	task, ok := ib.tasks.Get(meta.IndexBuildID)
+	if !ok {
+		if meta.State == commonpb.IndexState_Finished && meta.NodeID != 0 && !meta.MarkDeleted {
+			// the task is lost from the index builder, such as by a restart before the report, but the segment
+			// reference lock is still held. The task is tracked again as done, so the lock is released by the
+			// cleanup of the finished tasks instead of being orphaned.
+			task = ib.addTask(meta.IndexBuildID, indexTaskDone)
+			ib.applyTaskMeta(task, meta)
+			ib.notify()
+			log.Warn("the finished index task is not tracked, track it to release the segment reference lock",
+				zap.Int64("buildID", meta.IndexBuildID), zap.Int64("nodeID", meta.NodeID))
+			return
+		}
+		log.Warn("index task has been processed", zap.Int64("buildId", meta.IndexBuildID), zap.Any("meta", meta))
+		// no need to return error, this task must have been deleted.
+		return
+	}
+
+	state := task.state
+	if task.assignedVersion != 0 && meta.IndexVersion != task.assignedVersion {
+		log.Warn("ignore the index meta of a stale assignment", zap.Int64("buildID", meta.IndexBuildID),
+			zap.Int64("index version", meta.IndexVersion), zap.Int64("assigned version", task.assignedVersion),
+			zap.String("index state", meta.State.String()))
+		return
+	}
+	if meta.GetCheckpointPath() != "" {
+		// the checkpoint is kept in meta, the build is resumed from it when the task is reassigned.
+		task.checkpointPath = meta.GetCheckpointPath()
+	}
+	if missingFile != "" {
+		task.failReason = fmt.Sprintf("index file %s is missing", missingFile)
+		if !ib.updateTaskState(task, indexTaskRetry) {
+			return
+		}
+		log.Warn("the index file of the finished task is missing, retry the task", zap.Int64("buildID", meta.IndexBuildID),
+			zap.String("original state", state.String()), zap.String("file", missingFile))
+		ib.notify()
+		return
+	}
+	if meta.State == commonpb.IndexState_Finished || meta.State == commonpb.IndexState_Failed {
+		if meta.State == commonpb.IndexState_Finished && state == indexTaskInProgress && !task.inProgressTime.IsZero() {
+			indexType, buildTime := getIndexType(meta.GetReq().GetIndexParams()), ib.clock.Since(task.inProgressTime)
+			metrics.IndexCoordIndexBuildDuration.WithLabelValues(indexType).Observe(buildTime.Seconds())
+			ib.buildTimes.observe(indexType, meta.GetReq().GetNumRows(), buildTime)
+		}
+		if !ib.updateTaskState(task, indexTaskDone) {
+			// such as the task has been deleted, the report is stale.
+			return
+		}
+		task.progress = getBuildProgress(meta)
+		// the IndexNode has free task slot now.
+		ib.busyUntil = time.Time{}
+		ib.notify()
+		ib.resolveWaiters(meta.IndexBuildID, meta.State)
+		ib.runTaskHooks(meta)
+		if meta.State == commonpb.IndexState_Finished && !task.shadow {
+			// the shadow build does not replace the active index, the index is not ready for the queries.
+			ib.runCollectionIndexReadyHooks(task.collectionID)
+		}
+		log.Info("this task has been finished", zap.Int64("buildID", meta.IndexBuildID),
+			zap.String("original state", state.String()), zap.String("finish or failed", meta.State.String()))
+		return
+	}
+
+	if meta.State == commonpb.IndexState_InProgress {
+		if state == indexTaskInProgress {
+			// the IndexNode reports the progress of the task, it is not stuck.
+			task.lastActiveTime = ib.clock.Now()
+			task.progress = getBuildProgress(meta)
+		}
+		return
+	}
+
+	// index state must be Unissued and NodeID is not zero
+	task.failReason = fmt.Sprintf("index task is reset by IndexNode %d", meta.NodeID)
+	if !ib.updateTaskState(task, indexTaskRetry) {
+		return
+	}
+	log.Info("this task need to retry", zap.Int64("buildID", meta.IndexBuildID),
+		zap.String("original state", state.String()), zap.String("index state", meta.State.String()),
+		zap.Int64("original nodeID", meta.NodeID))
+	ib.notify()
+}
+
+func (ib *indexBuilder) markTaskAsDeleted(buildID UniqueID) {
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	ib.markTaskAsDeletedLocked(buildID)
+}
+
+// markTasksAsDeleted marks the tasks as deleted under a single lock and notifies the scheduler once.
+func (ib *indexBuilder) markTasksAsDeleted(buildIDs []UniqueID) {
+	if len(buildIDs) == 0 {
+		return
+	}
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	for _, buildID := range buildIDs {
+		ib.markTaskAsDeletedLocked(buildID)
+	}
+}
+
+// MarkIndexDeleted marks all the tasks of the dropped index as deleted.
+func (ib *indexBuilder) MarkIndexDeleted(indexID UniqueID) {
 	defer ib.notify()
 
-	metas := ib.meta.GetMetasByNodeID(nodeID)
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	buildIDs := make(map[UniqueID]struct{})
+	for _, buildID := range ib.meta.GetBuildIDsByIndexID(indexID) {
+		buildIDs[buildID] = struct{}{}
+	}
+	for buildID, task := range ib.tasks.tasks {
+		if task.indexID == indexID {
+			buildIDs[buildID] = struct{}{}
+		}
+	}
+	for buildID := range buildIDs {
+		ib.markTaskAsDeletedLocked(buildID)
+	}
+}
+
+// MarkCollectionDeleted marks all the tasks of the dropped collection as deleted.
+func (ib *indexBuilder) MarkCollectionDeleted(collectionID UniqueID) {
+	defer ib.notify()
 
 	ib.taskMutex.Lock()
 	defer ib.taskMutex.Unlock()
 
-	for _, meta := range metas {
-		if ib.tasks[meta.indexMeta.IndexBuildID] != indexTaskDone {
-			ib.tasks[meta.indexMeta.IndexBuildID] = indexTaskRetry
+	buildIDs := make(map[UniqueID]struct{})
+	for _, buildID := range ib.meta.GetBuildIDsByCollectionID(collectionID) {
+		buildIDs[buildID] = struct{}{}
+	}
+	for buildID, task := range ib.tasks.tasks {
+		if task.collectionID == collectionID {
+			buildIDs[buildID] = struct{}{}
+		}
+	}
+	for buildID := range buildIDs {
+		ib.markTaskAsDeletedLocked(buildID)
+	}
+	// the dropped collection is never ready.
+	delete(ib.unreadyCollections, collectionID)
+	log.Info("index builder mark the tasks of the dropped collection as deleted", zap.Int64("collectionID", collectionID),
+		zap.Int("tasks", len(buildIDs)))
+}
+
+// markTaskAsDeletedLocked marks the task as deleted, the caller should hold the taskMutex.
+func (ib *indexBuilder) markTaskAsDeletedLocked(buildID UniqueID) {
+	ib.resolveWaiters(buildID, commonpb.IndexState_IndexStateNone)
+	if task, ok := ib.tasks.Get(buildID); ok {
+		ib.setTaskState(task, indexTaskDeleted)
+		return
+	}
+	// the task is not tracked, but the segment reference lock is still held, such as the failed task.
+	if meta, ok := ib.meta.GetMeta(buildID); ok && meta.indexMeta.NodeID != 0 {
+		task := ib.addTask(buildID, indexTaskDeleted)
+		task.nodeID = meta.indexMeta.NodeID
+	}
+}
+
+// nodeDown moves the unfinished tasks of the IndexNode to retry.
+func (ib *indexBuilder) nodeDown(nodeID UniqueID) {
+	ib.taskMutex.Lock()
+	delete(ib.drainingNodes, nodeID)
+	ib.taskMutex.Unlock()
+
+	reclaimed := 0
+	// the tasks are moved to retry one by one, so the scheduler is not blocked by an IndexNode with many tasks.
+	ib.meta.RangeMetasByNodeID(nodeID, func(meta *Meta) bool {
+		ib.taskMutex.Lock()
+		defer ib.taskMutex.Unlock()
+
+		task, ok := ib.tasks.Get(meta.indexMeta.IndexBuildID)
+		if !ok {
+			task = ib.addTask(meta.indexMeta.IndexBuildID, indexTaskRetry)
+			task.nodeID = nodeID
+			task.checkpointPath = meta.indexMeta.GetCheckpointPath()
+			reclaimed++
+			return true
+		}
+		// the deleted tasks are released by the scheduler as well.
+		if state := task.effectiveState(); state == indexTaskInit || state == indexTaskInProgress {
+			task.failReason = errIndexNodeIsNotOnService(nodeID).Error()
+			ib.updateTaskState(task, indexTaskRetry)
+			reclaimed++
+		}
+		return true
+	})
+	if reclaimed == 0 {
+		log.Info("the tasks of the down IndexNode have been reclaimed", zap.Int64("nodeID", nodeID))
+		return
+	}
+	log.Info("reclaim the tasks of the down IndexNode", zap.Int64("nodeID", nodeID), zap.Int("tasks", reclaimed))
+	ib.notify()
+}
+
+// GetTaskState returns the current state of the task.
+func (ib *indexBuilder) GetTaskState(buildID UniqueID) (indexTaskState, bool) {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return indexTaskInit, false
+	}
+	return task.state, true
+}
+
+// GetTaskProgress returns the fraction of the rows built by the IndexNode for the task.
+func (ib *indexBuilder) GetTaskProgress(buildID UniqueID) (*float32, bool) {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return nil, false
+	}
+	return task.progress, true
+}
+
+// ListTasks returns a snapshot of the states of all tasks.
+func (ib *indexBuilder) ListTasks() map[int64]indexTaskState {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	tasks := make(map[int64]indexTaskState, ib.tasks.Len())
+	for buildID, task := range ib.tasks.tasks {
+		tasks[buildID] = task.state
+	}
+	return tasks
+}
+
+// Dump returns the scheduling state of all tasks in processing order.
+func (ib *indexBuilder) Dump() *indexpb.IndexBuilderDump {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	dump := &indexpb.IndexBuilderDump{
+		Paused: ib.paused,
+		Tasks:  make([]*indexpb.IndexBuilderTaskDump, 0, ib.tasks.Len()),
+	}
+	for _, buildID := range ib.tasks.BuildIDs() {
+		task, _ := ib.tasks.Get(buildID)
+		taskDump := &indexpb.IndexBuilderTaskDump{
+			IndexBuildID:  buildID,
+			State:         task.state.String(),
+			NodeID:        task.nodeID,
+			PreferNodeID:  task.preferNodeID,
+			Priority:      int64(task.priority),
+			RetryCount:    int64(task.retryCount),
+			FailReason:    task.failReason,
+			EnqueueTime:   task.enqueueTime.UnixNano() / int64(time.Millisecond),
+			BlockedReason: task.blockedReason,
+		}
+		if task.state == indexTaskRetry {
+			retryDeadline := task.lastRetryTime.Add(task.retryDelay + task.retryJitter)
+			taskDump.RetryDeadline = retryDeadline.UnixNano() / int64(time.Millisecond)
+		}
+		dump.Tasks = append(dump.Tasks, taskDump)
+	}
+	return dump
+}
+
+// indexTaskInfo is a snapshot of an index task.
+type indexTaskInfo struct {
+	state       indexTaskState
+	nodeID      UniqueID
+	enqueueTime time.Time
+	retryCount  int
+	priority    int
+	// progress is the fraction of the rows built by the IndexNode, nil if it is not reported.
+	progress *float32
+	// blockedReason is the reason why the task in init state is not assigned, empty if it is unknown.
+	blockedReason string
+
+	indexID       UniqueID
+	indexName     string
+	indexType     string
+	indexParams   string
+	segmentID     UniqueID
+	shadow        bool
+	segmentOrigin string
+
+	// failReason is the reason of the last failure, such as the reason why the task is failed.
+	failReason string
+	// lastError is the error which the processing of the task failed on last time, empty if there is none.
+	lastError     string
+	lastErrorTime time.Time
+}
+
+// ListTaskInfos returns a snapshot of all tasks, including the IndexNodes which the tasks are assigned to.
+func (ib *indexBuilder) ListTaskInfos() map[int64]indexTaskInfo {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	infos := make(map[int64]indexTaskInfo, ib.tasks.Len())
+	for buildID, task := range ib.tasks.tasks {
+		infos[buildID] = indexTaskInfo{
+			state:       task.state,
+			nodeID:      task.nodeID,
+			enqueueTime: task.enqueueTime,
+			retryCount:  task.retryCount,
+			priority:    task.priority,
+			progress:    task.progress,
+
+			blockedReason: task.blockedReason,
+
+			indexID:       task.indexID,
+			indexName:     task.indexName,
+			indexType:     task.indexType,
+			indexParams:   task.indexParams,
+			segmentID:     task.segmentID,
+			shadow:        task.shadow,
+			segmentOrigin: task.segmentOrigin,
+
+			failReason: task.failReason,
+
+			lastError:     task.lastError,
+			lastErrorTime: task.lastErrorTime,
+		}
+	}
+	return infos
+}
+
+// TaskInfo is a snapshot of an index task assigned to an IndexNode.
+type TaskInfo struct {
+	BuildID UniqueID
+	State   indexTaskState
+	// Age is the time since the task is enqueued.
+	Age time.Duration
+}
+
+// ListTasksByNode returns the tasks which are currently assigned to the IndexNode, ordered by build id.
+func (ib *indexBuilder) ListTasksByNode(nodeID UniqueID) []TaskInfo {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	now := ib.clock.Now()
+	infos := make([]TaskInfo, 0)
+	for buildID, task := range ib.tasks.tasks {
+		if task.nodeID != nodeID {
+			continue
+		}
+		infos = append(infos, TaskInfo{
+			BuildID: buildID,
+			State:   task.state,
+			Age:     now.Sub(task.enqueueTime),
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].BuildID < infos[j].BuildID
+	})
+	return infos
+}
+
+// GetBuildIDsInState returns the build ids of the tasks in any of the states ordered by build id.
+func (ib *indexBuilder) GetBuildIDsInState(states ...indexTaskState) []UniqueID {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	buildIDs := make([]UniqueID, 0)
+	for buildID, task := range ib.tasks.tasks {
+		for _, state := range states {
+			if task.state == state {
+				buildIDs = append(buildIDs, buildID)
+				break
+			}
 		}
 	}
+	sort.Slice(buildIDs, func(i, j int) bool {
+		return buildIDs[i] < buildIDs[j]
+	})
+	return buildIDs
 }
 
 func (ib *indexBuilder) hasTask(buildID UniqueID) bool {
 	ib.taskMutex.RLock()
 	defer ib.taskMutex.RUnlock()
 
-	_, ok := ib.tasks[buildID]
+	_, ok := ib.tasks.Get(buildID)
 	return ok
 }
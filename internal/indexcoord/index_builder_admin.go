@@ -0,0 +1,342 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"go.uber.org/zap"
+)
+
+// Pause stops assigning tasks to IndexNodes, the finished and deleted tasks are still cleaned up.
+func (ib *indexBuilder) Pause() {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	ib.paused = true
+	metrics.IndexCoordIndexBuilderPaused.WithLabelValues().Set(1)
+	log.Info("index builder is paused")
+}
+
+// Resume continues assigning tasks to IndexNodes.
+func (ib *indexBuilder) Resume() {
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	ib.paused = false
+	metrics.IndexCoordIndexBuilderPaused.WithLabelValues().Set(0)
+	log.Info("index builder is resumed")
+}
+
+// CancelTask cancels the index task which has not been finished.
+func (ib *indexBuilder) CancelTask(buildID UniqueID) error {
+	defer ib.notify()
+
+	return ib.cancelTask(buildID, nil)
+}
+
+// CancelOlderThan cancels all the unfinished index tasks enqueued before the cutoff and returns the number of them.
+func (ib *indexBuilder) CancelOlderThan(cutoff time.Time) int {
+	defer ib.notify()
+
+	unlock := ib.rlockTasks(cancelSection)
+	buildIDs := make([]UniqueID, 0)
+	for buildID, task := range ib.tasks.tasks {
+		if task.enqueueTime.Before(cutoff) && isCancelable(task) {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	unlock()
+
+	canceled := make([]UniqueID, 0, len(buildIDs))
+	for _, buildID := range buildIDs {
+		// the task may be finished or enqueued again after it is found.
+		err := ib.cancelTask(buildID, func(task *indexTask) bool {
+			return task.enqueueTime.Before(cutoff)
+		})
+		if err != nil {
+			log.Warn("index builder cancel the task enqueued before the cutoff failed", zap.Int64("buildID", buildID),
+				zap.Error(err))
+			continue
+		}
+		canceled = append(canceled, buildID)
+	}
+	log.Info("index builder cancel the tasks enqueued before the cutoff", zap.Time("cutoff", cutoff),
+		zap.Int("canceled", len(canceled)), zap.Int64s("buildIDs", canceled))
+	return len(canceled)
+}
+
+// isCancelable returns whether the task has not been finished, the paused task is judged by the state it is paused in.
+func isCancelable(task *indexTask) bool {
+	switch task.effectiveState() {
+	case indexTaskInit, indexTaskInProgress, indexTaskRetry:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelTask cancels the unfinished task which passes the check, a nil check passes all the tasks.
+func (ib *indexBuilder) cancelTask(buildID UniqueID, check func(task *indexTask) bool) error {
+	if !ib.startProcess(buildID) {
+		return fmt.Errorf("index task %d is being processed, please retry later", buildID)
+	}
+	defer ib.finishProcess(buildID)
+
+	unlock := ib.rlockTasks(cancelSection)
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		unlock()
+		return errIndexTaskNotExist(buildID)
+	}
+	state, effectiveState := task.state, task.effectiveState()
+	cancelable := isCancelable(task) && (check == nil || check(task))
+	unlock()
+	if !cancelable {
+		return fmt.Errorf("index task %d is %s, can not be canceled", buildID, state.String())
+	}
+
+	if err := ib.meta.MarkIndexAsCanceled(buildID); err != nil {
+		log.Error("index builder cancel task failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+	unlock = ib.lockTasks(cancelSection)
+	ib.setTaskState(task, indexTaskDeleted)
+	unlock()
+	ib.resolveWaiters(buildID, commonpb.IndexState_IndexStateNone)
+
+	meta, exist := ib.meta.GetMeta(buildID)
+	logger := ib.taskLogger(task, meta)
+	logger.Info("index task is canceled", zap.String("original state", state.String()))
+	if exist && meta.indexMeta.NodeID != 0 {
+		nodeID := meta.indexMeta.NodeID
+		if effectiveState == indexTaskInProgress {
+			ib.cancelBuildOnNode(buildID, nodeID, logger)
+		}
+		if err := ib.releaseLockAndResetNode(buildID, nodeID); err != nil {
+			logger.Warn("index builder release the lock of the canceled task failed, retry it later", zap.Error(err))
+			unlock = ib.lockTasks(cancelSection)
+			ib.setLastError(task, err)
+			unlock()
+			return nil
+		}
+	}
+	ib.deleteTask(buildID, logger)
+	return nil
+}
+
+// cancelBuildOnNode asks the IndexNode to stop the build of the canceled task.
+func (ib *indexBuilder) cancelBuildOnNode(buildID UniqueID, nodeID UniqueID, logger *zap.Logger) {
+	client, ok := ib.ic.nodeManager.GetClient(nodeID)
+	if !ok {
+		logger.Warn("the IndexNode of the canceled task is not found")
+		return
+	}
+	if err := ib.ic.cancelBuild(ib.ctx, client, buildID); err != nil {
+		logger.Warn("index builder cancel the build on IndexNode failed", zap.Error(err))
+	}
+}
+
+// ForceReassign moves the unfinished index task to retry, so that it is reassigned without backoff.
+func (ib *indexBuilder) ForceReassign(buildID UniqueID) error {
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return errIndexTaskNotExist(buildID)
+	}
+	if task.state.isTerminal() || task.state == indexTaskPaused {
+		return fmt.Errorf("index task %d is %s, can not be reassigned", buildID, task.state.String())
+	}
+	log.Info("index task is reassigned by force", zap.Int64("buildID", buildID),
+		zap.String("original state", task.state.String()))
+	task.failReason = forceReassignReason
+	task.preferNodeID = 0
+	task.retryDelay = 0
+	ib.setTaskState(task, indexTaskRetry)
+	task.retryJitter = 0
+	return nil
+}
+
+// SetPriority changes the priority of the index task which is waiting to be assigned.
+func (ib *indexBuilder) SetPriority(buildID UniqueID, priority int) error {
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	if !honorsPriority(ib.orderingPolicy) {
+		return ErrPriorityIgnored
+	}
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return errIndexTaskNotExist(buildID)
+	}
+	switch task.effectiveState() {
+	case indexTaskInit, indexTaskRetry:
+	default:
+		return fmt.Errorf("index task %d is %s, only the task in Init or Retry state can be reprioritized", buildID,
+			task.state.String())
+	}
+	log.Info("index task is reprioritized", zap.Int64("buildID", buildID), zap.Int("original priority", task.priority),
+		zap.Int("priority", priority))
+	ib.tasks.Update(buildID, priority)
+	return nil
+}
+
+// PauseTask holds the unfinished index task until it is resumed.
+func (ib *indexBuilder) PauseTask(buildID UniqueID) error {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return errIndexTaskNotExist(buildID)
+	}
+	switch task.state {
+	case indexTaskInit, indexTaskInProgress, indexTaskRetry:
+	default:
+		return fmt.Errorf("index task %d is %s, can not be paused", buildID, task.state.String())
+	}
+	log.Info("index task is paused", zap.Int64("buildID", buildID), zap.String("original state", task.state.String()))
+	pausedState := task.state
+	ib.setTaskState(task, indexTaskPaused)
+	task.pausedState = pausedState
+	task.pauseTime = ib.clock.Now()
+	return nil
+}
+
+// ResumeTask returns the paused index task to the state before it was paused.
+func (ib *indexBuilder) ResumeTask(buildID UniqueID) error {
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	task, ok := ib.tasks.Get(buildID)
+	if !ok {
+		return errIndexTaskNotExist(buildID)
+	}
+	if task.state != indexTaskPaused {
+		return fmt.Errorf("index task %d is %s, can not be resumed", buildID, task.state.String())
+	}
+	log.Info("index task is resumed", zap.Int64("buildID", buildID), zap.String("state", task.pausedState.String()),
+		zap.Duration("paused duration", ib.clock.Since(task.pauseTime)))
+	ib.setTaskState(task, task.pausedState)
+	task.pauseTime = time.Time{}
+	return nil
+}
+
+// releasePausedTaskLock releases the segment reference lock of the task paused for pausedTaskLockGracePeriod.
+func (ib *indexBuilder) releasePausedTaskLock(task *indexTask, indexMeta *indexpb.IndexMeta, logger *zap.Logger) {
+	ib.taskMutex.RLock()
+	pausedState, pauseTime := task.pausedState, task.pauseTime
+	ib.taskMutex.RUnlock()
+	if ib.pausedTaskLockGracePeriod <= 0 || indexMeta.NodeID == 0 || pausedState == indexTaskDone ||
+		indexMeta.State == commonpb.IndexState_Finished || indexMeta.State == commonpb.IndexState_Failed {
+		return
+	}
+	if ib.clock.Since(pauseTime) < ib.pausedTaskLockGracePeriod {
+		logger.Debug("index task is paused, keep the segment reference lock in grace period")
+		return
+	}
+	if err := ib.releaseLockAndResetTask(task.buildID, indexMeta.NodeID); err != nil {
+		// release lock failed, no need to modify state, wait to retry
+		logger.Error("index builder try to release reference lock of the paused task failed", zap.Error(err))
+		return
+	}
+	ib.taskMutex.Lock()
+	if task.state == indexTaskPaused {
+		task.nodeID = 0
+		task.lockAcquireTime = time.Time{}
+		task.pausedState = indexTaskInit
+	}
+	ib.taskMutex.Unlock()
+	logger.Info("segment reference lock of the paused index task is released",
+		zap.Duration("grace period", ib.pausedTaskLockGracePeriod))
+}
+
+// DrainNode moves the tasks of the IndexNode to retry at most rate tasks per second and waits until it is drained.
+func (ib *indexBuilder) DrainNode(nodeID UniqueID, rate int) {
+	ib.taskMutex.Lock()
+	ib.drainingNodes[nodeID] = struct{}{}
+	ib.taskMutex.Unlock()
+	log.Info("index builder start to drain the IndexNode", zap.Int64("nodeID", nodeID), zap.Int("rate", rate))
+
+	ticker := time.NewTicker(nodeDrainInterval)
+	defer ticker.Stop()
+	for {
+		moved, remaining, paused := ib.moveNodeTasks(nodeID, rate)
+		if moved > 0 {
+			log.Info("index builder move the tasks of the draining IndexNode", zap.Int64("nodeID", nodeID),
+				zap.Int("moved", moved), zap.Int("remaining", remaining), zap.Int("paused", paused))
+			ib.notify()
+		}
+		if remaining == 0 {
+			if paused > 0 {
+				log.Warn("the IndexNode is drained except the paused tasks, they release the IndexNode once resumed",
+					zap.Int64("nodeID", nodeID), zap.Int("paused", paused))
+				return
+			}
+			log.Info("the IndexNode is drained", zap.Int64("nodeID", nodeID))
+			return
+		}
+		select {
+		case <-ib.ctx.Done():
+			log.Warn("index builder is stopped during draining the IndexNode", zap.Int64("nodeID", nodeID),
+				zap.Int("remaining", remaining))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// moveNodeTasks moves at most limit in-progress tasks of the IndexNode to retry.
+func (ib *indexBuilder) moveNodeTasks(nodeID UniqueID, limit int) (int, int, int) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	moved, remaining, paused := 0, 0, 0
+	for _, task := range ib.tasks.tasks {
+		if task.nodeID != nodeID || task.state == indexTaskFailed {
+			continue
+		}
+		if task.state == indexTaskPaused {
+			paused++
+		} else {
+			remaining++
+		}
+		if task.effectiveState() != indexTaskInProgress || (limit > 0 && moved >= limit) {
+			continue
+		}
+		task.failReason = nodeDrainedReason
+		task.preferNodeID = 0
+		ib.updateTaskState(task, indexTaskRetry)
+		moved++
+	}
+	return moved, remaining, paused
+}
@@ -0,0 +1,486 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// cancelRecordingIndexNode records the buildIDs of CancelIndex.
+type cancelRecordingIndexNode struct {
+	indexnode.Mock
+	canceled []UniqueID
+}
+
+func (in *cancelRecordingIndexNode) CancelIndex(ctx context.Context, req *indexpb.CancelIndexRequest) (*commonpb.Status, error) {
+	in.canceled = append(in.canceled, req.GetIndexBuildID())
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestIndexBuilder_CancelTask(t *testing.T) {
+	node := &cancelRecordingIndexNode{}
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		1: node,
+	}))
+
+	t.Run("in progress", func(t *testing.T) {
+		err := ib.CancelTask(4)
+		assert.NoError(t, err)
+		assert.False(t, ib.hasTask(4))
+		// the build on the IndexNode is canceled.
+		assert.Equal(t, []UniqueID{4}, node.canceled)
+
+		meta, ok := mt.GetMeta(4)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+		assert.Equal(t, canceledFailReason, meta.indexMeta.FailReason)
+		assert.Equal(t, int64(0), meta.indexMeta.NodeID)
+	})
+
+	t.Run("init", func(t *testing.T) {
+		err := ib.CancelTask(2)
+		assert.NoError(t, err)
+		assert.False(t, ib.hasTask(2))
+		// the task has not been assigned.
+		assert.Equal(t, []UniqueID{4}, node.canceled)
+	})
+
+	t.Run("being processed", func(t *testing.T) {
+		assert.True(t, ib.startProcess(5))
+		err := ib.CancelTask(5)
+		assert.Error(t, err)
+		ib.finishProcess(5)
+		state, ok := ib.GetTaskState(5)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskRetry, state)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		err := ib.CancelTask(7)
+		assert.Error(t, err)
+	})
+
+	t.Run("done", func(t *testing.T) {
+		err := ib.CancelTask(6)
+		assert.Error(t, err)
+		state, ok := ib.GetTaskState(6)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDone, state)
+	})
+}
+
+func TestIndexBuilder_CancelOlderThan(t *testing.T) {
+	node := &cancelRecordingIndexNode{}
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		1: node,
+	}))
+
+	cutoff := time.Now()
+	for buildID, task := range ib.tasks.tasks {
+		task.enqueueTime = cutoff.Add(-time.Minute)
+		if buildID == 3 {
+			task.enqueueTime = cutoff.Add(time.Minute)
+		}
+	}
+
+	// Task 3 is enqueued after the cutoff, task 1 and 6 have been finished.
+	assert.Equal(t, 3, ib.CancelOlderThan(cutoff))
+	for _, buildID := range []UniqueID{2, 4, 5} {
+		// the locks are released and the tasks are removed.
+		assert.False(t, ib.hasTask(buildID))
+
+		meta, ok := mt.GetMeta(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+		assert.Equal(t, canceledFailReason, meta.indexMeta.FailReason)
+		assert.Equal(t, int64(0), meta.indexMeta.NodeID)
+	}
+	assert.Equal(t, []UniqueID{4}, node.canceled)
+	state, ok := ib.GetTaskState(3)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskRetry, state)
+	state, ok = ib.GetTaskState(6)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskDone, state)
+
+	assert.Equal(t, 0, ib.CancelOlderThan(cutoff))
+}
+
+func TestIndexBuilder_PauseAndResume(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable(), withIndexNodes(map[UniqueID]types.IndexNode{
+		4: &indexnode.Mock{},
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+
+	ib.Pause()
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.IndexCoordIndexBuilderPaused.WithLabelValues()))
+
+	// the finished task is still cleaned up
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+
+	// the task is not assigned
+	ib.process(2)
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, state)
+
+	ib.Resume()
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.IndexCoordIndexBuilderPaused.WithLabelValues()))
+	assert.Equal(t, 1, len(ib.notifyChan))
+	ib.process(2)
+	state, ok = ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInProgress, state)
+}
+
+func TestIndexBuilder_DrainNode(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	mt := createMetaTable()
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+
+	t.Run("move tasks by rate", func(t *testing.T) {
+		// the deleted, retry and in-progress tasks are held by IndexNode 1, only the in-progress one is moved.
+		moved, remaining, paused := ib.moveNodeTasks(1, 1)
+		assert.Equal(t, 1, moved)
+		assert.Equal(t, 3, remaining)
+		assert.Equal(t, 0, paused)
+		task, ok := ib.tasks.Get(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Equal(t, nodeDrainedReason, task.failReason)
+
+		moved, remaining, paused = ib.moveNodeTasks(1, 1)
+		assert.Equal(t, 0, moved)
+		assert.Equal(t, 3, remaining)
+		assert.Equal(t, 0, paused)
+	})
+
+	t.Run("drain", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			ib.DrainNode(1, 0)
+		}()
+		// no task is assigned to the draining IndexNode.
+		assert.Eventually(t, func() bool {
+			_, ok := ib.getBusyNodes()[1]
+			return ok
+		}, time.Second*5, time.Millisecond*10)
+
+		// the locks of the tasks are released, the IndexNode holds no tasks.
+		for _, buildID := range []UniqueID{1, 3, 4} {
+			ib.process(buildID)
+		}
+		assert.Equal(t, 0, len(ib.ListTasksByNode(1)))
+		select {
+		case <-done:
+		case <-time.After(time.Second * 5):
+			t.Fatal("DrainNode does not return after the IndexNode is drained")
+		}
+		meta, ok := mt.GetMeta(4)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+
+		// the draining IndexNode is forgotten once it is down.
+		ib.nodeDown(1)
+		_, ok = ib.getBusyNodes()[1]
+		assert.False(t, ok)
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		err := ib.PauseTask(4)
+		assert.NoError(t, err)
+
+		// the paused in-progress task is moved, but it is not waited for.
+		moved, remaining, paused := ib.moveNodeTasks(1, 0)
+		assert.Equal(t, 1, moved)
+		assert.Equal(t, 2, remaining)
+		assert.Equal(t, 1, paused)
+		task, ok := ib.tasks.Get(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskPaused, task.state)
+		assert.Equal(t, indexTaskRetry, task.pausedState)
+		assert.Equal(t, nodeDrainedReason, task.failReason)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			ib.DrainNode(1, 0)
+		}()
+		for _, buildID := range []UniqueID{1, 3} {
+			ib.process(buildID)
+		}
+		select {
+		case <-done:
+		case <-time.After(time.Second * 5):
+			t.Fatal("DrainNode waits for the paused tasks")
+		}
+
+		// the paused task releases the IndexNode once it is resumed.
+		err = ib.ResumeTask(4)
+		assert.NoError(t, err)
+		ib.process(4)
+		assert.Equal(t, 0, len(ib.ListTasksByNode(1)))
+	})
+}
+
+func TestIndexBuilder_ForceReassign(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+	ib.retryBackoffBase = time.Minute
+
+	t.Run("in progress", func(t *testing.T) {
+		task, ok := ib.tasks.Get(4)
+		assert.True(t, ok)
+		task.preferNodeID = 1
+		task.retryDelay = time.Minute
+
+		err := ib.ForceReassign(4)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(ib.notifyChan))
+		state, ok := ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskRetry, state)
+		assert.Equal(t, UniqueID(0), task.preferNodeID)
+		assert.Equal(t, forceReassignReason, task.failReason)
+
+		// the task is reset without backoff.
+		ib.process(4)
+		state, ok = ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+		meta, ok := mt.GetMeta(4)
+		assert.True(t, ok)
+		assert.Equal(t, int64(0), meta.indexMeta.NodeID)
+		assert.Equal(t, commonpb.IndexState_Unissued, meta.indexMeta.State)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		err := ib.ForceReassign(7)
+		assert.Error(t, err)
+	})
+
+	t.Run("done", func(t *testing.T) {
+		err := ib.ForceReassign(6)
+		assert.Error(t, err)
+		state, ok := ib.GetTaskState(6)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDone, state)
+	})
+}
+
+func TestIndexBuilder_SetPriority(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	setOrderingPolicy(ib, NewOrderingPolicy(PriorityOrderingPolicy))
+
+	t.Run("init", func(t *testing.T) {
+		err := ib.SetPriority(2, userTaskPriority)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(ib.notifyChan))
+		assert.Equal(t, userTaskPriority, ib.ListTaskInfos()[2].priority)
+		// the escalated task is assigned first.
+		assert.Equal(t, UniqueID(2), ib.tasks.BuildIDs()[0])
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		err := ib.SetPriority(3, rebuildTaskPriority)
+		assert.NoError(t, err)
+		assert.Equal(t, rebuildTaskPriority, ib.ListTaskInfos()[3].priority)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		priority := ib.ListTaskInfos()[4].priority
+		err := ib.SetPriority(4, userTaskPriority)
+		assert.Error(t, err)
+		assert.Equal(t, priority, ib.ListTaskInfos()[4].priority)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		err := ib.SetPriority(7, userTaskPriority)
+		assert.Error(t, err)
+	})
+
+	t.Run("priority ignored", func(t *testing.T) {
+		setOrderingPolicy(ib, NewOrderingPolicy(BuildIDOrderingPolicy))
+		priority := ib.ListTaskInfos()[2].priority
+		err := ib.SetPriority(2, rebuildTaskPriority)
+		assert.ErrorIs(t, err, ErrPriorityIgnored)
+		assert.Equal(t, priority, ib.ListTaskInfos()[2].priority)
+	})
+}
+
+func TestIndexBuilder_PauseTask(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+
+	t.Run("pause and resume", func(t *testing.T) {
+		err := ib.PauseTask(4)
+		assert.NoError(t, err)
+		state, ok := ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskPaused, state)
+
+		// the paused task is not processed, and it keeps the lock without grace period.
+		ib.process(4)
+		state, ok = ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskPaused, state)
+		meta, ok := mt.GetMeta(4)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(1), meta.indexMeta.NodeID)
+
+		assert.Error(t, ib.PauseTask(4))
+		assert.Error(t, ib.ForceReassign(4))
+
+		err = ib.ResumeTask(4)
+		assert.NoError(t, err)
+		state, ok = ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInProgress, state)
+		assert.Error(t, ib.ResumeTask(4))
+	})
+
+	t.Run("finished during pause", func(t *testing.T) {
+		err := ib.PauseTask(4)
+		assert.NoError(t, err)
+
+		ib.updateStateByMeta(&indexpb.IndexMeta{
+			IndexBuildID: 4,
+			State:        commonpb.IndexState_Finished,
+			NodeID:       1,
+		})
+		state, ok := ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskPaused, state)
+
+		err = ib.ResumeTask(4)
+		assert.NoError(t, err)
+		state, ok = ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDone, state)
+	})
+
+	t.Run("release lock after grace period", func(t *testing.T) {
+		ib.pausedTaskLockGracePeriod = time.Minute
+		defer func() {
+			ib.pausedTaskLockGracePeriod = 0
+		}()
+		err := ib.PauseTask(3)
+		assert.NoError(t, err)
+		task, ok := ib.tasks.Get(3)
+		assert.True(t, ok)
+
+		ib.process(3)
+		meta, ok := mt.GetMeta(3)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(1), meta.indexMeta.NodeID)
+
+		task.pauseTime = time.Now().Add(-time.Hour)
+		ib.process(3)
+		meta, ok = mt.GetMeta(3)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+		assert.Equal(t, commonpb.IndexState_Unissued, meta.indexMeta.State)
+		assert.Equal(t, UniqueID(0), task.nodeID)
+		assert.Equal(t, indexTaskPaused, task.state)
+
+		// the task is reassigned, and the lock is acquired again.
+		err = ib.ResumeTask(3)
+		assert.NoError(t, err)
+		assert.Equal(t, indexTaskInit, task.state)
+	})
+
+	t.Run("cancel paused task", func(t *testing.T) {
+		err := ib.PauseTask(2)
+		assert.NoError(t, err)
+		err = ib.CancelTask(2)
+		assert.NoError(t, err)
+		assert.False(t, ib.hasTask(2))
+	})
+
+	t.Run("can not pause", func(t *testing.T) {
+		assert.Error(t, ib.PauseTask(6))
+		assert.Error(t, ib.PauseTask(7))
+		assert.Error(t, ib.ResumeTask(6))
+	})
+}
+
+// blockingIndexNode blocks CreateIndex until the context is done.
+type blockingIndexNode struct {
+	indexnode.Mock
+	started chan struct{}
+}
+
+func (in *blockingIndexNode) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	close(in.started)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestIndexBuilder_CancelDuringProcess(t *testing.T) {
+	ctx := context.Background()
+	node := &blockingIndexNode{started: make(chan struct{})}
+	ic := newTestIndexCoord(ctx, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: node,
+	}))
+	ic.reqTimeoutInterval = time.Minute
+	mt := createMetaTable()
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+	ib.maxConcurrentTasksPerNode = 0
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ib.process(2)
+	}()
+	<-node.started
+	ib.cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("process is not interrupted by the cancellation")
+	}
+
+	// the task is left to be recovered from meta.
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, state)
+	meta, ok := mt.GetMeta(2)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Unissued, meta.indexMeta.State)
+	assert.Equal(t, UniqueID(4), meta.indexMeta.NodeID)
+
+	// the cancelled index builder does not process tasks any more.
+	ib.process(6)
+	assert.True(t, ib.hasTask(6))
+}
@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"go.uber.org/zap"
+)
+
+// startCleanupWorkers starts the workers releasing the segment reference locks of the finished tasks.
+func (ib *indexBuilder) startCleanupWorkers() {
+	if !ib.releaseLockAsync || ib.cleanupParallelism <= 0 {
+		return
+	}
+	ib.cleanupChan = make(chan UniqueID, cleanupQueueSize)
+	for i := 0; i < ib.cleanupParallelism; i++ {
+		ib.wg.Add(1)
+		go ib.cleanupLoop()
+	}
+}
+
+func (ib *indexBuilder) cleanupLoop() {
+	defer ib.wg.Done()
+	for {
+		select {
+		case <-ib.ctx.Done():
+			return
+		case buildID := <-ib.cleanupChan:
+			ib.cleanupDoneTask(buildID)
+		}
+	}
+}
+
+// enqueueCleanup queues the finished task to be cleaned up by the cleanup workers.
+func (ib *indexBuilder) enqueueCleanup(buildID UniqueID, logger *zap.Logger) {
+	ib.taskMutex.Lock()
+	if _, ok := ib.cleaning[buildID]; ok {
+		ib.taskMutex.Unlock()
+		return
+	}
+	ib.cleaning[buildID] = struct{}{}
+	ib.taskMutex.Unlock()
+
+	select {
+	case ib.cleanupChan <- buildID:
+	default:
+		logger.Debug("the cleanup queue is full, wait for the next schedule run")
+		ib.finishCleanup(buildID)
+	}
+}
+
+func (ib *indexBuilder) finishCleanup(buildID UniqueID) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	delete(ib.cleaning, buildID)
+}
+
+// cleanupDoneTask completes the finished task queued by enqueueCleanup.
+func (ib *indexBuilder) cleanupDoneTask(buildID UniqueID) {
+	defer ib.finishCleanup(buildID)
+
+	ib.taskMutex.RLock()
+	task, ok := ib.tasks.Get(buildID)
+	done := ok && task.state == indexTaskDone
+	ib.taskMutex.RUnlock()
+	if !done {
+		// the task has been removed or deleted, it is processed by the schedule loop.
+		return
+	}
+	meta, exist := ib.meta.GetMeta(buildID)
+	logger := ib.taskLogger(task, meta)
+	if !exist {
+		logger.Warn("index meta of the finished task is not exist, remove the task")
+		ib.deleteTask(buildID, logger)
+		return
+	}
+	ib.completeDoneTask(task, meta.indexMeta, logger)
+}
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexBuilder_AsyncCleanup(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+	ib.cleanupParallelism = 2
+	ib.startCleanupWorkers()
+	defer func() {
+		ib.cancel()
+		ib.wg.Wait()
+	}()
+
+	// the finished task is tracked until the cleanup worker releases its lock.
+	ib.process(6)
+	assert.Eventually(t, func() bool {
+		return !ib.hasTask(6)
+	}, time.Second*5, time.Millisecond*10)
+	meta, ok := mt.GetMeta(6)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+
+	// the task being cleaned up is not processed by the schedule loop.
+	ib.taskMutex.Lock()
+	ib.cleaning[4] = struct{}{}
+	ib.taskMutex.Unlock()
+	assert.False(t, ib.startProcess(4))
+	ib.finishCleanup(4)
+	assert.True(t, ib.startProcess(4))
+	ib.finishProcess(4)
+
+	// the task which is no longer finished is left to the schedule loop.
+	ib.cleanupDoneTask(4)
+	assert.True(t, ib.hasTask(4))
+}
+
+func TestIndexBuilder_LockReleaseStrategy(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	newBuilder := func(strategy string) (*indexBuilder, *metaTable) {
+		mt := createMetaTable()
+		ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+		ib.releaseLockAsync = isAsyncLockRelease(strategy)
+		ib.cleanupParallelism = 2
+		ib.startCleanupWorkers()
+		return ib, mt
+	}
+
+	t.Run("sync", func(t *testing.T) {
+		ib, mt := newBuilder(syncLockRelease)
+		defer func() {
+			ib.cancel()
+			ib.wg.Wait()
+		}()
+		assert.Nil(t, ib.cleanupChan)
+		// the lock is released and the task is removed by the schedule loop.
+		ib.process(6)
+		assert.False(t, ib.hasTask(6))
+		meta, ok := mt.GetMeta(6)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+	})
+
+	t.Run("async", func(t *testing.T) {
+		ib, mt := newBuilder(asyncLockRelease)
+		defer func() {
+			ib.cancel()
+			ib.wg.Wait()
+		}()
+		assert.NotNil(t, ib.cleanupChan)
+		// the lock is released and the task is removed by the cleanup workers.
+		ib.process(6)
+		assert.Eventually(t, func() bool {
+			return !ib.hasTask(6)
+		}, time.Second*5, time.Millisecond*10)
+		meta, ok := mt.GetMeta(6)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+	})
+
+	assert.True(t, isAsyncLockRelease("unknown"))
+}
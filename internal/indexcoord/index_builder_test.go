@@ -19,18 +19,33 @@ package indexcoord
 import (
 	"context"
 	"errors"
+	"fmt"
+	"path"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/types"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/trace"
 
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 )
 
 func createMetaTable() *metaTable {
@@ -43,7 +58,8 @@ func createMetaTable() *metaTable {
 					NodeID:       1,
 					MarkDeleted:  true,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-1"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -59,7 +75,8 @@ func createMetaTable() *metaTable {
 					State:        commonpb.IndexState_Unissued,
 					NodeID:       0,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-2"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -75,7 +92,8 @@ func createMetaTable() *metaTable {
 					State:        commonpb.IndexState_Unissued,
 					NodeID:       1,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-3"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -91,7 +109,8 @@ func createMetaTable() *metaTable {
 					State:        commonpb.IndexState_InProgress,
 					NodeID:       1,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-4"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -107,7 +126,8 @@ func createMetaTable() *metaTable {
 					State:        commonpb.IndexState_InProgress,
 					NodeID:       3,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-5"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -123,7 +143,8 @@ func createMetaTable() *metaTable {
 					State:        commonpb.IndexState_Finished,
 					NodeID:       2,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-6"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -139,7 +160,8 @@ func createMetaTable() *metaTable {
 					State:        commonpb.IndexState_Failed,
 					NodeID:       0,
 					Req: &indexpb.BuildIndexRequest{
-						NumRows: 100,
+						NumRows:   100,
+						DataPaths: []string{"DataPath-7"},
 						TypeParams: []*commonpb.KeyValuePair{
 							{
 								Key:   "dim",
@@ -158,6 +180,48 @@ func createMetaTable() *metaTable {
 	}
 }
 
+// testIndexCoordOption changes the IndexCoord created by newTestIndexCoord.
+type testIndexCoordOption func(ic *IndexCoord)
+
+// withIndexNodes sets the clients of the IndexNodes which the tasks can be assigned to.
+func withIndexNodes(nodeClients map[UniqueID]types.IndexNode) testIndexCoordOption {
+	return func(ic *IndexCoord) {
+		ic.nodeManager = &NodeManager{
+			nodeClients: nodeClients,
+		}
+	}
+}
+
+// withDataCoord replaces the DataCoord which the segment reference locks are released on.
+func withDataCoord(dataCoord types.DataCoord) testIndexCoordOption {
+	return func(ic *IndexCoord) {
+		ic.dataCoordClient = dataCoord
+	}
+}
+
+// newTestIndexCoord creates the IndexCoord with a DataCoordMock which always succeeds and no IndexNode.
+func newTestIndexCoord(ctx context.Context, opts ...testIndexCoordOption) *IndexCoord {
+	ic := &IndexCoord{
+		loopCtx:            ctx,
+		reqTimeoutInterval: time.Second * 5,
+		dataCoordClient: &DataCoordMock{
+			Fail: false,
+			Err:  false,
+		},
+		nodeManager: &NodeManager{},
+	}
+	for _, opt := range opts {
+		opt(ic)
+	}
+	return ic
+}
+
+// newTestIndexBuilder creates the index builder on the meta table, the IndexNodes 1 and 2 are alive.
+func newTestIndexBuilder(mt *metaTable, opts ...testIndexCoordOption) *indexBuilder {
+	ctx := context.Background()
+	return newIndexBuilder(ctx, newTestIndexCoord(ctx, opts...), mt, []UniqueID{1, 2})
+}
+
 func TestIndexBuilder(t *testing.T) {
 	ctx := context.Background()
 
@@ -180,13 +244,13 @@ func TestIndexBuilder(t *testing.T) {
 
 	ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
 
-	assert.Equal(t, 6, len(ib.tasks))
-	assert.Equal(t, indexTaskDeleted, ib.tasks[1])
-	assert.Equal(t, indexTaskInit, ib.tasks[2])
-	assert.Equal(t, indexTaskRetry, ib.tasks[3])
-	assert.Equal(t, indexTaskInProgress, ib.tasks[4])
-	assert.Equal(t, indexTaskRetry, ib.tasks[5])
-	assert.Equal(t, indexTaskDone, ib.tasks[6])
+	assert.Equal(t, 6, ib.tasks.Len())
+	assert.Equal(t, indexTaskDeleted, ib.tasks.tasks[1].state)
+	assert.Equal(t, indexTaskInit, ib.tasks.tasks[2].state)
+	assert.Equal(t, indexTaskRetry, ib.tasks.tasks[3].state)
+	assert.Equal(t, indexTaskInProgress, ib.tasks.tasks[4].state)
+	assert.Equal(t, indexTaskRetry, ib.tasks.tasks[5].state)
+	assert.Equal(t, indexTaskDone, ib.tasks.tasks[6].state)
 
 	ib.scheduleDuration = time.Millisecond * 500
 	ib.Start()
@@ -198,7 +262,8 @@ func TestIndexBuilder(t *testing.T) {
 				State:        commonpb.IndexState_Unissued,
 				NodeID:       0,
 				Req: &indexpb.BuildIndexRequest{
-					NumRows: 100,
+					NumRows:   100,
+					DataPaths: []string{"DataPath"},
 					TypeParams: []*commonpb.KeyValuePair{
 						{
 							Key:   "dim",
@@ -253,7 +318,8 @@ func TestIndexBuilder(t *testing.T) {
 
 		for {
 			ib.taskMutex.Lock()
-			if len(ib.tasks) == 1 && indexTaskInProgress == ib.tasks[5] {
+			task, ok := ib.tasks.Get(5)
+			if ib.tasks.Len() == 1 && ok && indexTaskInProgress == task.state {
 				ib.taskMutex.Unlock()
 				break
 			}
@@ -277,13 +343,13 @@ func TestIndexBuilder(t *testing.T) {
 		time.Sleep(time.Second)
 		ib2.Stop()
 
-		assert.Equal(t, 6, len(ib2.tasks))
-		assert.Equal(t, indexTaskDeleted, ib2.tasks[1])
-		assert.Equal(t, indexTaskInit, ib2.tasks[2])
-		assert.Equal(t, indexTaskRetry, ib2.tasks[3])
-		assert.Equal(t, indexTaskInProgress, ib2.tasks[4])
-		assert.Equal(t, indexTaskRetry, ib2.tasks[5])
-		assert.Equal(t, indexTaskDone, ib2.tasks[6])
+		assert.Equal(t, 6, ib2.tasks.Len())
+		assert.Equal(t, indexTaskDeleted, ib2.tasks.tasks[1].state)
+		assert.Equal(t, indexTaskInit, ib2.tasks.tasks[2].state)
+		assert.Equal(t, indexTaskRetry, ib2.tasks.tasks[3].state)
+		assert.Equal(t, indexTaskInProgress, ib2.tasks.tasks[4].state)
+		assert.Equal(t, indexTaskRetry, ib2.tasks.tasks[5].state)
+		assert.Equal(t, indexTaskDone, ib2.tasks.tasks[6].state)
 	})
 }
 
@@ -309,7 +375,8 @@ func TestIndexBuilder_Error(t *testing.T) {
 						NodeID:       0,
 						MarkDeleted:  false,
 						Req: &indexpb.BuildIndexRequest{
-							NumRows: 100,
+							NumRows:   100,
+							DataPaths: []string{"DataPath"},
 							TypeParams: []*commonpb.KeyValuePair{
 								{
 									Key:   "dim",
@@ -360,7 +427,8 @@ func TestIndexBuilder_Error(t *testing.T) {
 						NodeID:       0,
 						MarkDeleted:  false,
 						Req: &indexpb.BuildIndexRequest{
-							NumRows: 100,
+							NumRows:   100,
+							DataPaths: []string{"DataPath"},
 							TypeParams: []*commonpb.KeyValuePair{
 								{
 									Key:   "dim",
@@ -411,7 +479,8 @@ func TestIndexBuilder_Error(t *testing.T) {
 						NodeID:       0,
 						MarkDeleted:  false,
 						Req: &indexpb.BuildIndexRequest{
-							NumRows: 100,
+							NumRows:   100,
+							DataPaths: []string{"DataPath"},
 							TypeParams: []*commonpb.KeyValuePair{
 								{
 									Key:   "dim",
@@ -435,3 +504,2239 @@ func TestIndexBuilder_Error(t *testing.T) {
 		ib.Stop()
 	})
 }
+
+func TestIndexBuilder_MaxTaskRetry(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+	ib.maxTaskRetry = 2
+
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskRetry, task.state)
+	task.failReason = "mock fail reason"
+
+	t.Run("retry", func(t *testing.T) {
+		ib.process(3)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, 1, task.retryCount)
+	})
+
+	t.Run("exceed max retry", func(t *testing.T) {
+		task.retryCount = ib.maxTaskRetry
+		task.state = indexTaskRetry
+		ib.process(3)
+		assert.Equal(t, indexTaskFailed, task.state)
+
+		meta, ok := mt.GetMeta(3)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+		assert.Equal(t, "mock fail reason", meta.indexMeta.FailReason)
+		assert.Equal(t, int64(0), meta.indexMeta.NodeID)
+
+		ib.process(3)
+		assert.False(t, ib.hasTask(3))
+	})
+}
+
+func TestIndexBuilder_GetTaskState(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, state)
+
+	_, ok = ib.GetTaskState(7)
+	assert.False(t, ok)
+
+	tasks := ib.ListTasks()
+	assert.Equal(t, 6, len(tasks))
+	assert.Equal(t, indexTaskInProgress, tasks[4])
+
+	// the returned map is a copy
+	tasks[4] = indexTaskDone
+	delete(tasks, 5)
+	state, ok = ib.GetTaskState(4)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInProgress, state)
+	assert.True(t, ib.hasTask(5))
+
+	infos := ib.ListTaskInfos()
+	assert.Equal(t, 6, len(infos))
+	assert.Equal(t, indexTaskInProgress, infos[4].state)
+	assert.Equal(t, UniqueID(1), infos[4].nodeID)
+	assert.False(t, infos[4].enqueueTime.IsZero())
+	assert.Equal(t, indexTaskInit, infos[2].state)
+	assert.Equal(t, UniqueID(0), infos[2].nodeID)
+
+	nodeTasks := ib.ListTasksByNode(1)
+	assert.Equal(t, 3, len(nodeTasks))
+	assert.Equal(t, UniqueID(1), nodeTasks[0].BuildID)
+	assert.Equal(t, indexTaskDeleted, nodeTasks[0].State)
+	assert.Equal(t, UniqueID(3), nodeTasks[1].BuildID)
+	assert.Equal(t, indexTaskRetry, nodeTasks[1].State)
+	assert.Equal(t, UniqueID(4), nodeTasks[2].BuildID)
+	assert.Equal(t, indexTaskInProgress, nodeTasks[2].State)
+	assert.True(t, nodeTasks[2].Age >= 0)
+	assert.Equal(t, 0, len(ib.ListTasksByNode(100)))
+
+	assert.Equal(t, []UniqueID{3, 5}, ib.GetBuildIDsInState(indexTaskRetry))
+	assert.Equal(t, []UniqueID{6}, ib.GetBuildIDsInState(indexTaskDone))
+	assert.Equal(t, 0, len(ib.GetBuildIDsInState(indexTaskFailed)))
+	assert.Equal(t, []UniqueID{2, 3, 5}, ib.GetBuildIDsInState(indexTaskInit, indexTaskRetry))
+	assert.False(t, ib.isDrained())
+
+	// the index of the task is listed without looking up meta.
+	ib.meta.indexBuildID2Meta[8] = &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 8,
+			State:        commonpb.IndexState_Unissued,
+			Req: &indexpb.BuildIndexRequest{
+				IndexID:   10,
+				IndexName: "vec_index",
+				SegmentID: 20,
+				IndexParams: []*commonpb.KeyValuePair{
+					{Key: "index_type", Value: "HNSW"},
+					{Key: "M", Value: "16"},
+					{Key: "metric_type", Value: "L2"},
+				},
+			},
+		},
+	}
+	ib.enqueue(8)
+	delete(ib.meta.indexBuildID2Meta, 8)
+	info := ib.ListTaskInfos()[8]
+	assert.Equal(t, UniqueID(10), info.indexID)
+	assert.Equal(t, "vec_index", info.indexName)
+	assert.Equal(t, "HNSW", info.indexType)
+	assert.Equal(t, "M=16,metric_type=L2", info.indexParams)
+	assert.Equal(t, UniqueID(20), info.segmentID)
+}
+
+func TestIndexBuilder_RetryBackoff(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.maxTaskRetry = 0
+	ib.retryBackoffBase = time.Hour
+	ib.retryBackoffMax = time.Hour * 3
+
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+
+	t.Run("first retry is not delayed", func(t *testing.T) {
+		ib.process(3)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, time.Hour, task.retryDelay)
+	})
+
+	t.Run("retry in backoff window", func(t *testing.T) {
+		ib.setTaskState(task, indexTaskRetry)
+		ib.process(3)
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Equal(t, time.Hour, task.retryDelay)
+	})
+
+	t.Run("retry after backoff window", func(t *testing.T) {
+		task.lastRetryTime = time.Now().Add(-time.Hour)
+		ib.process(3)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, time.Hour*2, task.retryDelay)
+
+		ib.setTaskState(task, indexTaskRetry)
+		task.lastRetryTime = time.Now().Add(-time.Hour * 2)
+		ib.process(3)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, time.Hour*3, task.retryDelay)
+	})
+
+	t.Run("reset backoff", func(t *testing.T) {
+		ib.setTaskState(task, indexTaskInProgress)
+		assert.Equal(t, time.Duration(0), task.retryDelay)
+		assert.True(t, task.lastRetryTime.IsZero())
+	})
+}
+
+func TestIndexBuilder_MaxConcurrentTasksPerNode(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: &indexnode.Mock{
+			Err:     false,
+			Failure: false,
+		},
+	}))
+	mt := createMetaTable()
+	// build 4 is in progress on IndexNode 4
+	mt.indexBuildID2Meta[4].indexMeta.NodeID = 4
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2, 4})
+
+	t.Run("node at capacity", func(t *testing.T) {
+		ib.maxConcurrentTasksPerNode = 1
+		assert.Equal(t, 1, len(ib.getBusyNodes()))
+		ib.process(2)
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+	})
+
+	t.Run("node has capacity", func(t *testing.T) {
+		ib.maxConcurrentTasksPerNode = 2
+		// skip the backoff of busy IndexNodes
+		ib.busyUntil = time.Time{}
+		assert.Equal(t, 0, len(ib.getBusyNodes()))
+		ib.process(2)
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInProgress, state)
+		assert.Equal(t, 1, len(ib.getBusyNodes()))
+	})
+}
+
+func TestIndexBuilder_MaxConcurrentTasksPerCollection(t *testing.T) {
+	mt := createMetaTable()
+	// build 4 of the collection is in progress
+	for _, buildID := range []UniqueID{2, 4} {
+		mt.indexBuildID2Meta[buildID].indexMeta.Req.CollectionID = 100
+	}
+	ib := newTestIndexBuilder(mt)
+	ib.maxConcurrentTasksPerNode = 0
+	ib.SetNodeSelector(&fixedNodeSelector{nodeID: 1, client: &indexnode.Mock{}})
+	task, ok := ib.tasks.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(100), task.collectionID)
+
+	t.Run("collection at capacity", func(t *testing.T) {
+		ib.maxConcurrentTasksPerCollection = 1
+		ib.process(2)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, UniqueID(0), task.nodeID)
+		assert.Contains(t, task.blockedReason, "collection 100")
+	})
+
+	t.Run("collection has capacity", func(t *testing.T) {
+		ib.maxConcurrentTasksPerCollection = 2
+		ib.process(2)
+		assert.Equal(t, indexTaskInProgress, task.state)
+		assert.True(t, ib.collectionIsBusy(task))
+	})
+}
+
+func TestIndexBuilder_PinnedNode(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx, withIndexNodes(map[UniqueID]types.IndexNode{
+		1: &indexnode.Mock{},
+		2: &indexnode.Mock{},
+	}))
+	newBuilder := func(pinnedNodeID UniqueID) (*indexBuilder, *metaTable, *indexTask) {
+		mt := createMetaTable()
+		mt.indexBuildID2Meta[2].indexMeta.Req.PinnedNodeID = pinnedNodeID
+		ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+		ib.allowNodePinning = true
+		ib.maxConcurrentTasksPerNode = 0
+		ib.SetNodeSelector(&fixedNodeSelector{nodeID: 1, client: &indexnode.Mock{}})
+		task, ok := ib.tasks.Get(2)
+		assert.True(t, ok)
+		return ib, mt, task
+	}
+
+	t.Run("pinning not allowed", func(t *testing.T) {
+		ib, _, task := newBuilder(2)
+		ib.allowNodePinning = false
+		ib.process(2)
+		assert.Equal(t, indexTaskInProgress, task.state)
+		assert.Equal(t, UniqueID(1), task.nodeID)
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		ib, mt, task := newBuilder(2)
+		ib.process(2)
+		assert.Equal(t, indexTaskInProgress, task.state)
+		assert.Equal(t, UniqueID(2), task.nodeID)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(2), meta.indexMeta.NodeID)
+	})
+
+	t.Run("pinned node busy", func(t *testing.T) {
+		// build 4 is in progress on IndexNode 1
+		ib, _, task := newBuilder(1)
+		ib.maxConcurrentTasksPerNode = 1
+		ib.process(2)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, UniqueID(0), task.nodeID)
+		assert.Equal(t, "pinned IndexNode 1 is busy", task.blockedReason)
+	})
+
+	t.Run("pinned node not on service", func(t *testing.T) {
+		ib, mt, task := newBuilder(3)
+		ib.process(2)
+		assert.Equal(t, indexTaskFailed, task.state)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+		assert.Equal(t, errIndexNodeIsNotOnService(3).Error(), meta.indexMeta.FailReason)
+	})
+}
+
+func TestIndexBuilder_updateTaskMetrics(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable(), withIndexNodes(map[UniqueID]types.IndexNode{
+		1: &indexnode.Mock{},
+		2: &indexnode.Mock{},
+		4: &indexnode.Mock{},
+	}))
+	ib.starvingTaskThreshold = time.Hour
+	ib.maxConcurrentTasksPerNode = 1
+	task2, ok := ib.tasks.Get(2)
+	assert.True(t, ok)
+	task2.enqueueTime = time.Now().Add(-2 * time.Hour)
+	// the terminal task is not waiting.
+	task6, ok := ib.tasks.Get(6)
+	assert.True(t, ok)
+	task6.enqueueTime = time.Now().Add(-3 * time.Hour)
+
+	ib.taskMutex.RLock()
+	ib.updateTaskMetrics()
+	ib.taskMutex.RUnlock()
+
+	assert.Equal(t, float64(6), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskNum.WithLabelValues()))
+	// the work of the tasks 2, 3, 4 and 5, the deleted and finished tasks are not waiting.
+	assert.Equal(t, float64(400), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskWeight.WithLabelValues()))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskStateNum.WithLabelValues(indexTaskRetry.String())))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskStateNum.WithLabelValues(indexTaskFailed.String())))
+	oldestAge := testutil.ToFloat64(metrics.IndexCoordIndexBuilderOldestTaskAge.WithLabelValues())
+	assert.GreaterOrEqual(t, oldestAge, (2 * time.Hour).Seconds())
+	assert.Less(t, oldestAge, (3 * time.Hour).Seconds())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.IndexCoordIndexBuilderStarvingTaskNum.WithLabelValues()))
+	// node 1 has an in-progress task, node 2 has only a finished task.
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.IndexCoordIndexNodeStateNum.WithLabelValues(metrics.IdleIndexNodeLabel)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.IndexCoordIndexNodeStateNum.WithLabelValues(metrics.BusyIndexNodeLabel)))
+	assert.Equal(t, float64(3), testutil.ToFloat64(metrics.IndexCoordIndexNodeStateNum.WithLabelValues(metrics.TotalLabel)))
+}
+
+func TestIndexBuilder_MarkIndexDeleted(t *testing.T) {
+	mt := createMetaTable()
+	for _, buildID := range []UniqueID{2, 3, 4, 7} {
+		mt.indexBuildID2Meta[buildID].indexMeta.Req.IndexID = 100
+	}
+	for _, buildID := range []UniqueID{5, 6} {
+		mt.indexBuildID2Meta[buildID].indexMeta.Req.IndexID = 200
+	}
+	ib := newTestIndexBuilder(mt)
+	// the failed task is not tracked, but it still holds the segment reference lock.
+	assert.False(t, ib.hasTask(7))
+	mt.indexBuildID2Meta[7].indexMeta.NodeID = 1
+
+	ib.MarkIndexDeleted(100)
+	assert.Equal(t, 1, len(ib.notifyChan))
+	tasks := ib.ListTasks()
+	for _, buildID := range []UniqueID{2, 3, 4, 7} {
+		assert.Equal(t, indexTaskDeleted, tasks[buildID])
+	}
+	// the tasks of the other index are not affected.
+	assert.Equal(t, indexTaskRetry, tasks[5])
+	assert.Equal(t, indexTaskDone, tasks[6])
+	task, ok := ib.tasks.Get(7)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), task.nodeID)
+}
+
+func TestIndexBuilder_MarkCollectionDeleted(t *testing.T) {
+	mt := createMetaTable()
+	for _, buildID := range []UniqueID{2, 3, 4, 7} {
+		mt.indexBuildID2Meta[buildID].indexMeta.Req.CollectionID = 10
+	}
+	for _, buildID := range []UniqueID{5, 6} {
+		mt.indexBuildID2Meta[buildID].indexMeta.Req.CollectionID = 20
+	}
+	ib := newTestIndexBuilder(mt)
+	// the failed task is not tracked, but it still holds the segment reference lock.
+	assert.False(t, ib.hasTask(7))
+	mt.indexBuildID2Meta[7].indexMeta.NodeID = 1
+	_, ok := ib.unreadyCollections[10]
+	assert.True(t, ok)
+
+	ib.MarkCollectionDeleted(10)
+	assert.Equal(t, 1, len(ib.notifyChan))
+	tasks := ib.ListTasks()
+	for _, buildID := range []UniqueID{2, 3, 4, 7} {
+		assert.Equal(t, indexTaskDeleted, tasks[buildID])
+	}
+	// the tasks of the other collection are not affected.
+	assert.Equal(t, indexTaskRetry, tasks[5])
+	assert.Equal(t, indexTaskDone, tasks[6])
+	task, ok := ib.tasks.Get(7)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), task.nodeID)
+	_, ok = ib.unreadyCollections[10]
+	assert.False(t, ok)
+	_, ok = ib.unreadyCollections[20]
+	assert.True(t, ok)
+}
+
+func TestIndexBuilder_Reconcile(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+
+	t.Run("reload failed", func(t *testing.T) {
+		mt.client = &mockETCDKV{
+			loadWithRevisionAndVersions: func(s string) ([]string, []string, []int64, int64, error) {
+				return nil, nil, nil, 0, errors.New("error")
+			},
+		}
+		err := ib.Reconcile()
+		assert.Error(t, err)
+		// neither the meta nor the tasks are changed.
+		_, ok := mt.GetMeta(3)
+		assert.True(t, ok)
+		assert.True(t, ib.hasTask(3))
+	})
+
+	t.Run("meta edited", func(t *testing.T) {
+		// the task 2 is finished by the operator, and the task 8 is added.
+		var values []string
+		for _, indexMeta := range []*indexpb.IndexMeta{
+			{IndexBuildID: 2, State: commonpb.IndexState_Finished},
+			{IndexBuildID: 8, State: commonpb.IndexState_Unissued, Req: &indexpb.BuildIndexRequest{NumRows: 100}},
+		} {
+			value, err := proto.Marshal(indexMeta)
+			assert.NoError(t, err)
+			values = append(values, string(value))
+		}
+		mt.client = &mockETCDKV{
+			loadWithRevisionAndVersions: func(s string) ([]string, []string, []int64, int64, error) {
+				return []string{"2", "8"}, values, []int64{1, 1}, 1, nil
+			},
+		}
+		err := ib.Reconcile()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(ib.notifyChan))
+		assert.Equal(t, map[int64]indexTaskState{8: indexTaskInit}, ib.ListTasks())
+	})
+}
+
+func TestIndexBuilder_GracefulStop(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: &indexnode.Mock{
+			Err:     false,
+			Failure: false,
+		},
+	}))
+
+	t.Run("drained", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		ib.scheduleDuration = time.Millisecond * 100
+		ib.Start()
+		ib.GracefulStop(time.Minute)
+
+		assert.True(t, ib.isDrained())
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInProgress, state)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ic := newTestIndexCoord(ctx)
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		ib.scheduleDuration = time.Millisecond * 100
+		ib.Start()
+		ib.GracefulStop(time.Minute)
+
+		// there is no IndexNode to assign the tasks, it stops once the retry tasks are reset.
+		assert.False(t, ib.isDrained())
+		assert.Equal(t, 0, len(ib.GetBuildIDsInState(indexTaskRetry)))
+	})
+
+	t.Run("not started", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		start := time.Now()
+		ib.GracefulStop(time.Minute)
+		assert.Less(t, time.Since(start), time.Minute)
+		assert.False(t, ib.isDrained())
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		ib.scheduleDuration = time.Millisecond * 100
+		ib.Pause()
+		ib.Start()
+		start := time.Now()
+		ib.GracefulStop(time.Minute)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("reject new task", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		ib.stopping = true
+		ib.enqueue(7)
+		assert.False(t, ib.hasTask(7))
+	})
+}
+
+func TestIndexBuilder_RestoreRetryMetas(t *testing.T) {
+	mt := createMetaTable()
+	mt.retryMetas = map[UniqueID]*taskRetryMeta{
+		3: {BuildID: 3, RetryCount: 9, FailReason: "error", RetryDelay: time.Second},
+		4: {BuildID: 4, RetryCount: 1, FailReason: "error", RetryDelay: time.Second},
+		// the task is finished, the retry meta is useless
+		7: {BuildID: 7, RetryCount: 1},
+	}
+	ib := newTestIndexBuilder(mt)
+
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, 9, task.retryCount)
+	assert.Equal(t, "error", task.failReason)
+	assert.Equal(t, time.Second, task.retryDelay)
+
+	task, ok = ib.tasks.Get(4)
+	assert.True(t, ok)
+	assert.Equal(t, 1, task.retryCount)
+	assert.Equal(t, time.Duration(0), task.retryDelay)
+
+	_, ok = mt.GetTaskRetryMeta(7)
+	assert.False(t, ok)
+
+	t.Run("save retry meta", func(t *testing.T) {
+		ib.maxTaskRetry = 0
+		task, ok := ib.tasks.Get(5)
+		assert.True(t, ok)
+		ib.process(5)
+		assert.Equal(t, indexTaskInit, task.state)
+		retryMeta, ok := mt.GetTaskRetryMeta(5)
+		assert.True(t, ok)
+		assert.Equal(t, 1, retryMeta.RetryCount)
+	})
+
+	t.Run("remove retry meta", func(t *testing.T) {
+		ib.markTaskAsDeleted(5)
+		ib.process(5)
+		assert.False(t, ib.hasTask(5))
+		_, ok := mt.GetTaskRetryMeta(5)
+		assert.False(t, ok)
+	})
+}
+
+func TestIndexBuilder_TaskTrace(t *testing.T) {
+	tracer := mocktracer.New()
+	globalTracer := opentracing.GlobalTracer()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(globalTracer)
+
+	mt := createMetaTable()
+	// the task span joins the trace of the BuildIndex request.
+	reqSpan := tracer.StartSpan("IndexCoord-BuildIndex")
+	mt.indexBuildID2Meta[6].indexMeta.Req.TraceContext = trace.InjectContextToMap(reqSpan.Context())
+	ib := newTestIndexBuilder(mt)
+	assert.Equal(t, 0, len(tracer.FinishedSpans()))
+
+	task, ok := ib.tasks.Get(6)
+	assert.True(t, ok)
+	assert.NotNil(t, task.span)
+	logger := ib.taskLogger(task, nil)
+	assert.NotNil(t, logger)
+
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+	spans := tracer.FinishedSpans()
+	assert.Equal(t, 1, len(spans))
+	assert.Equal(t, "IndexCoord-IndexTask", spans[0].OperationName)
+	assert.Equal(t, UniqueID(6), spans[0].Tag("buildID"))
+	assert.Equal(t, reqSpan.Context().(mocktracer.MockSpanContext).TraceID, spans[0].SpanContext.TraceID)
+
+	// the spans of the old tasks are finished when the tasks are refreshed
+	ib.refreshTasks([]UniqueID{1, 2})
+	assert.Equal(t, 6, len(tracer.FinishedSpans()))
+
+	// the trace context of the task is sent to the IndexNode with the request.
+	node := &recordingIndexNode{}
+	mt = createMetaTable()
+	mt.indexBuildID2Meta[2].indexMeta.Req.TraceContext = trace.InjectContextToMap(reqSpan.Context())
+	ib = newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: node,
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+	ib.process(2)
+	assert.Equal(t, 1, len(node.reqs))
+	sc, ok := trace.ExtractContextFromMap(node.reqs[0].GetTraceContext()).(mocktracer.MockSpanContext)
+	assert.True(t, ok)
+	assert.Equal(t, reqSpan.Context().(mocktracer.MockSpanContext).TraceID, sc.TraceID)
+}
+
+func TestIndexBuilder_BuildDeadline(t *testing.T) {
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[2].indexMeta.Req.BuildDeadline = time.Now().Add(time.Hour).Unix()
+	mt.indexBuildID2Meta[4].indexMeta.Req.BuildDeadline = time.Now().Add(-time.Hour).Unix()
+	ib := newTestIndexBuilder(mt)
+
+	t.Run("before deadline", func(t *testing.T) {
+		ib.process(2)
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ib.process(4)
+		state, ok := ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskFailed, state)
+
+		meta, ok := mt.GetMeta(4)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+		assert.Equal(t, deadlineExceededFailReason, meta.indexMeta.FailReason)
+	})
+}
+
+func TestIndexBuilder_AssignFailures(t *testing.T) {
+	ctx := context.Background()
+	dataCoord := &DataCoordMock{}
+	ic := newTestIndexCoord(ctx, withDataCoord(dataCoord))
+	client := &indexnode.Mock{}
+	newBuilder := func() (*indexBuilder, *metaTable, *indexTask) {
+		mt := createMetaTable()
+		ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+		ib.maxConcurrentTasksPerNode = 0
+		ib.SetNodeSelector(&fixedNodeSelector{nodeID: 1, client: client})
+		task, ok := ib.tasks.Get(2)
+		assert.True(t, ok)
+		return ib, mt, task
+	}
+
+	t.Run("update version failed", func(t *testing.T) {
+		ib, mt, task := newBuilder()
+		mt.client = &mockETCDKV{
+			compareVersionAndSwap: func(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
+				return false, errors.New("error")
+			},
+		}
+		ib.process(2)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, UniqueID(0), task.nodeID)
+		assert.Contains(t, task.lastError, ErrMetaUpdateFailed.Error())
+		assert.False(t, task.lastErrorTime.IsZero())
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(0), meta.indexMeta.IndexVersion)
+	})
+
+	t.Run("acquire lock failed", func(t *testing.T) {
+		ib, mt, task := newBuilder()
+		dataCoord.AcquireLockFail = true
+		defer func() {
+			dataCoord.AcquireLockFail = false
+		}()
+		ib.process(2)
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Contains(t, task.failReason, ErrLockAcquireFailed.Error())
+		assert.Equal(t, int64(0), task.assignedVersion)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(0), meta.indexMeta.IndexVersion)
+		assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+
+		// the task is reset without bumping the version, the last error is kept.
+		ib.process(2)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Contains(t, task.lastError, ErrLockAcquireFailed.Error())
+		meta, ok = mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(0), meta.indexMeta.IndexVersion)
+	})
+
+	t.Run("assign failed", func(t *testing.T) {
+		ib, mt, task := newBuilder()
+		client.Err = true
+		defer func() {
+			client.Err = false
+		}()
+		ib.process(2)
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Contains(t, task.failReason, ErrAssignFailed.Error())
+		info := ib.ListTaskInfos()[2]
+		assert.Contains(t, info.lastError, ErrAssignFailed.Error())
+		assert.Equal(t, task.lastErrorTime, info.lastErrorTime)
+		// the IndexNode may have received the task, the version is kept.
+		assert.Equal(t, int64(1), task.assignedVersion)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), meta.indexMeta.IndexVersion)
+		assert.Equal(t, UniqueID(1), meta.indexMeta.NodeID)
+	})
+
+	t.Run("assigned", func(t *testing.T) {
+		ib, mt, task := newBuilder()
+		ib.process(2)
+		assert.Equal(t, indexTaskInProgress, task.state)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), meta.indexMeta.IndexVersion)
+	})
+}
+
+func TestIndexBuilder_ReleaseLockErrors(t *testing.T) {
+	t.Run("release lock failed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ic := newTestIndexCoord(ctx, withDataCoord(&DataCoordMock{Fail: true}))
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		// the release is not retried after the index builder is stopped.
+		cancel()
+
+		err := ib.releaseLockAndResetTask(4, 1)
+		assert.True(t, errors.Is(err, ErrLockReleaseFailed))
+		assert.False(t, errors.Is(err, ErrMetaUpdateFailed))
+		err = ib.releaseLockAndResetNode(4, 1)
+		assert.True(t, errors.Is(err, ErrLockReleaseFailed))
+		err = ib.releaseLockAndMarkFailed(4, 1, "error")
+		assert.True(t, errors.Is(err, ErrLockReleaseFailed))
+	})
+
+	t.Run("update meta failed", func(t *testing.T) {
+		mt := createMetaTable()
+		ib := newTestIndexBuilder(mt)
+		mt.client = &mockETCDKV{
+			compareVersionAndSwap: func(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
+				return false, errors.New("error")
+			},
+		}
+
+		err := ib.releaseLockAndResetTask(4, 1)
+		assert.True(t, errors.Is(err, ErrMetaUpdateFailed))
+		assert.False(t, errors.Is(err, ErrLockReleaseFailed))
+		err = ib.releaseLockAndResetNode(4, 1)
+		assert.True(t, errors.Is(err, ErrMetaUpdateFailed))
+		err = ib.releaseLockAndMarkFailed(4, 1, "error")
+		assert.True(t, errors.Is(err, ErrMetaUpdateFailed))
+	})
+}
+
+func TestIndexBuilder_UntrackedFinishedTask(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+	// the finished task is lost from the index builder while its lock is still held.
+	ib.taskMutex.Lock()
+	ib.removeTask(6)
+	ib.taskMutex.Unlock()
+	meta, ok := mt.GetMeta(6)
+	assert.True(t, ok)
+
+	ib.updateStateByMeta(meta.indexMeta)
+	task, ok := ib.tasks.Get(6)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskDone, task.state)
+	assert.Equal(t, UniqueID(2), task.nodeID)
+
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+	meta, ok = mt.GetMeta(6)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+
+	// the lock of the task has been released, nothing to track.
+	ib.updateStateByMeta(meta.indexMeta)
+	assert.False(t, ib.hasTask(6))
+
+	// the meta marked as deleted is not tracked as a finished task.
+	ib.taskMutex.Lock()
+	ib.removeTask(1)
+	ib.taskMutex.Unlock()
+	deleted := proto.Clone(mt.indexBuildID2Meta[1].indexMeta).(*indexpb.IndexMeta)
+	deleted.State = commonpb.IndexState_Finished
+	ib.updateStateByMeta(deleted)
+	assert.False(t, ib.hasTask(1))
+}
+
+func Test_aliveNodeSet(t *testing.T) {
+	alive := aliveNodeSet([]UniqueID{3, 1, 3, 0, -1, 2})
+	assert.Equal(t, map[UniqueID]struct{}{1: {}, 2: {}, 3: {}}, alive)
+	assert.Equal(t, 0, len(aliveNodeSet(nil)))
+}
+
+func BenchmarkIndexBuilder_RefreshTasks(b *testing.B) {
+	const nodeNum, metaNum = 1000, 10000
+	aliveNodes := make([]UniqueID, 0, nodeNum)
+	for nodeID := UniqueID(1); nodeID <= nodeNum; nodeID++ {
+		aliveNodes = append(aliveNodes, nodeID)
+	}
+	mt := &metaTable{indexBuildID2Meta: make(map[UniqueID]*Meta, metaNum)}
+	for buildID := UniqueID(1); buildID <= metaNum; buildID++ {
+		mt.indexBuildID2Meta[buildID] = &Meta{
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: buildID,
+				State:        commonpb.IndexState_InProgress,
+				// half of the tasks are on the down IndexNodes.
+				NodeID: buildID % (nodeNum * 2),
+				Req:    &indexpb.BuildIndexRequest{NumRows: 100},
+			},
+		}
+	}
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	ib := newIndexBuilder(ctx, ic, mt, aliveNodes)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ib.refreshTasks(aliveNodes)
+	}
+}
+
+func TestIndexBuilder_FailedTaskRetention(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.failedTaskRetention = time.Hour
+
+	ib.failTask(4, 1, "build failed", log.With())
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskFailed, task.state)
+	assert.False(t, task.failTime.IsZero())
+
+	// the failed task is kept for inspection.
+	ib.process(4)
+	ib.reconcileTasks([]UniqueID{1, 2})
+	info, ok := ib.ListTaskInfos()[4]
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskFailed, info.state)
+	assert.Equal(t, "build failed", info.failReason)
+
+	// the failed task is removed once the retention expires.
+	task.failTime = time.Now().Add(-time.Hour * 2)
+	ib.process(4)
+	assert.False(t, ib.hasTask(4))
+}
+
+func TestIndexBuilder_ReconcileTasks(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+
+	task3, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	task3.retryCount = 2
+	task3.retryDelay = time.Second
+
+	mt.lock.Lock()
+	// the task is finished and cleaned.
+	delete(mt.indexBuildID2Meta, 6)
+	// the task is reset by the IndexNode.
+	mt.indexBuildID2Meta[4].indexMeta.State = commonpb.IndexState_Unissued
+	// a new task.
+	mt.indexBuildID2Meta[8] = &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 8,
+			State:        commonpb.IndexState_Unissued,
+			Req:          &indexpb.BuildIndexRequest{NumRows: 100},
+		},
+	}
+	mt.lock.Unlock()
+
+	ib.reconcileTasks([]UniqueID{1, 2})
+
+	// the surviving task keeps the retry state.
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	assert.Same(t, task3, task)
+	assert.Equal(t, indexTaskRetry, task.state)
+	assert.Equal(t, 2, task.retryCount)
+	assert.Equal(t, time.Second, task.retryDelay)
+
+	assert.False(t, ib.hasTask(6))
+
+	task, ok = ib.tasks.Get(4)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskRetry, task.state)
+	assert.Equal(t, UniqueID(1), task.nodeID)
+	assert.Equal(t, UniqueID(1), task.preferNodeID)
+
+	task, ok = ib.tasks.Get(8)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, task.state)
+
+	task, ok = ib.tasks.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskDeleted, task.state)
+}
+
+func TestIndexBuilder_NodeAffinity(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		1: &indexnode.Mock{},
+		4: &indexnode.Mock{},
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), task.preferNodeID)
+
+	// reset the task, then it is reassigned to the same IndexNode
+	ib.process(3)
+	assert.Equal(t, indexTaskInit, task.state)
+	ib.process(3)
+	assert.Equal(t, indexTaskInProgress, task.state)
+	meta, ok := mt.GetMeta(3)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), meta.indexMeta.NodeID)
+}
+
+func TestIndexBuilder_PeekClientFailure(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx, withIndexNodes(map[UniqueID]types.IndexNode{}))
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[4].indexMeta.NodeID = 4
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2, 4})
+	ib.maxConcurrentTasksPerNode = 1
+
+	t.Run("no IndexNode", func(t *testing.T) {
+		noNodeCount := testutil.ToFloat64(metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.NoIndexNodeLabel))
+		ib.process(2)
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+		assert.False(t, ib.noIndexNodeSince.IsZero())
+		assert.True(t, ib.busyUntil.IsZero())
+		assert.Equal(t, noNodeCount+1, testutil.ToFloat64(metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.NoIndexNodeLabel)))
+	})
+
+	t.Run("IndexNodes are busy", func(t *testing.T) {
+		ic.nodeManager.nodeClients[4] = &indexnode.Mock{}
+		busyCount := testutil.ToFloat64(metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.IndexNodesBusyLabel))
+		ib.process(2)
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+		assert.True(t, ib.noIndexNodeSince.IsZero())
+		assert.False(t, ib.busyUntil.IsZero())
+		assert.Equal(t, float64(0), testutil.ToFloat64(metrics.IndexCoordNoIndexNodeDuration.WithLabelValues()))
+		assert.Equal(t, busyCount+1, testutil.ToFloat64(metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.IndexNodesBusyLabel)))
+
+		// the task is not assigned during the backoff even if the IndexNode has capacity
+		ib.maxConcurrentTasksPerNode = 2
+		ib.process(2)
+		assert.Equal(t, busyCount+1, testutil.ToFloat64(metrics.IndexCoordPeekIndexNodeFailCounter.WithLabelValues(metrics.IndexNodesBusyLabel)))
+		state, ok = ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+	})
+}
+
+func TestIndexBuilder_EnqueueBatch(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	assert.NoError(t, ib.enqueueBatch(nil, rebuildTaskPriority))
+	assert.Equal(t, 0, len(ib.notifyChan))
+
+	assert.NoError(t, ib.enqueueBatch([]UniqueID{7, 8, 9}, rebuildTaskPriority))
+	assert.Equal(t, 1, len(ib.notifyChan))
+	infos := ib.ListTaskInfos()
+	for _, buildID := range []UniqueID{7, 8, 9} {
+		assert.Equal(t, indexTaskInit, infos[buildID].state)
+		assert.Equal(t, rebuildTaskPriority, infos[buildID].priority)
+	}
+
+	ib.stopping = true
+	assert.NoError(t, ib.enqueueBatch([]UniqueID{10}, rebuildTaskPriority))
+	_, ok := ib.GetTaskState(10)
+	assert.False(t, ok)
+}
+
+func TestIndexBuilder_MaxTrackedTasks(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	assert.Equal(t, 6, ib.tasks.Len())
+	ib.maxTrackedTasks = 8
+	rejected := testutil.ToFloat64(metrics.IndexCoordIndexBuilderRejectedTaskCounter.WithLabelValues())
+
+	// the batch is checked as a whole.
+	assert.ErrorIs(t, ib.acceptNewTasks(3), ErrBuilderBusy)
+	assert.Equal(t, rejected+3, testutil.ToFloat64(metrics.IndexCoordIndexBuilderRejectedTaskCounter.WithLabelValues()))
+
+	assert.NoError(t, ib.acceptNewTasks(2))
+	assert.NoError(t, ib.enqueueBatch([]UniqueID{7, 8}, defaultTaskPriority))
+	assert.Equal(t, 8, ib.tasks.Len())
+	assert.ErrorIs(t, ib.enqueue(9), ErrBuilderBusy)
+	assert.ErrorIs(t, ib.acceptNewTasks(1), ErrBuilderBusy)
+	assert.Equal(t, rejected+5, testutil.ToFloat64(metrics.IndexCoordIndexBuilderRejectedTaskCounter.WithLabelValues()))
+	// the tracked tasks do not count as new tasks.
+	assert.NoError(t, ib.enqueue(6))
+
+	assert.ErrorIs(t, ib.enqueueWithPriority(9, userTaskPriority), ErrBuilderBusy)
+
+	// the batch over the limit is rejected as a whole.
+	assert.ErrorIs(t, ib.enqueueBatch([]UniqueID{6, 9, 10}, rebuildTaskPriority), ErrBuilderBusy)
+	assert.Equal(t, rejected+8, testutil.ToFloat64(metrics.IndexCoordIndexBuilderRejectedTaskCounter.WithLabelValues()))
+	assert.Equal(t, 8, ib.tasks.Len())
+	_, ok := ib.GetTaskState(9)
+	assert.False(t, ok)
+
+	ib.maxTrackedTasks = 0
+	assert.NoError(t, ib.acceptNewTasks(100))
+}
+
+func TestIndexBuilder_EnqueueWithPriority(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	setOrderingPolicy(ib, NewOrderingPolicy(PriorityOrderingPolicy))
+
+	assert.NoError(t, ib.enqueue(9))
+	assert.NoError(t, ib.enqueueWithPriority(10, userTaskPriority))
+	assert.Equal(t, 1, len(ib.notifyChan))
+
+	// the rebuild triggered by users goes ahead of the retries and the background builds.
+	buildIDs := ib.tasks.BuildIDs()
+	assert.Equal(t, UniqueID(10), buildIDs[0])
+	infos := ib.ListTaskInfos()
+	assert.Equal(t, userTaskPriority, infos[10].priority)
+	assert.Equal(t, defaultTaskPriority, infos[9].priority)
+
+	// the priority is kept when the task is retried.
+	task, ok := ib.tasks.Get(10)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskRetry)
+	assert.Equal(t, userTaskPriority, task.priority)
+}
+
+func TestIndexBuilder_SetScheduleDuration(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	assert.Equal(t, true, ib.scheduleDuration > 0)
+
+	// only the latest duration is kept before the schedule loop receives it
+	ib.setScheduleDuration(0)
+	assert.Equal(t, 0, len(ib.scheduleChan))
+	ib.setScheduleDuration(time.Second)
+	ib.setScheduleDuration(time.Millisecond * 100)
+	assert.Equal(t, 1, len(ib.scheduleChan))
+
+	ib.Start()
+	defer ib.Stop()
+	assert.Eventually(t, func() bool {
+		return len(ib.scheduleChan) == 0
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestIndexBuilder_NotifyDebounce(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.scheduleDuration = time.Hour
+	ib.notifyDebounce = time.Millisecond * 200
+
+	ib.Start()
+	defer ib.Stop()
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		ib.notify()
+	}
+	assert.True(t, ib.SchedulerHealth().LastRunTime.IsZero())
+
+	// the burst of notifications is served by a run after the debounce delay.
+	assert.Eventually(t, func() bool {
+		return !ib.SchedulerHealth().LastRunTime.IsZero()
+	}, time.Second*5, time.Millisecond*10)
+	assert.True(t, ib.SchedulerHealth().LastRunTime.Sub(start) >= ib.notifyDebounce)
+}
+
+func TestIndexBuilder_TriggerRun(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	newBuilder := func() (*indexBuilder, *indexTask) {
+		ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+		ib.maxConcurrentTasksPerNode = 0
+		ib.SetNodeSelector(&fixedNodeSelector{nodeID: 1, client: &indexnode.Mock{}})
+		// the runs are only triggered by the test.
+		ib.scheduleDuration = time.Hour
+		ib.notifyDebounce = time.Hour
+		task, ok := ib.tasks.Get(3)
+		assert.True(t, ok)
+		return ib, task
+	}
+
+	t.Run("not started", func(t *testing.T) {
+		ib, task := newBuilder()
+		defer ib.Stop()
+		assert.True(t, ib.triggerRun())
+		// the retried task is reset by the first run and assigned by the next one.
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.True(t, ib.triggerRun())
+		assert.Equal(t, indexTaskInProgress, task.state)
+	})
+
+	t.Run("started", func(t *testing.T) {
+		ib, task := newBuilder()
+		ib.Start()
+		defer ib.Stop()
+		assert.True(t, ib.triggerRun())
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.False(t, ib.SchedulerHealth().LastRunTime.IsZero())
+		assert.True(t, ib.triggerRun())
+		assert.Equal(t, indexTaskInProgress, task.state)
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		ib, task := newBuilder()
+		ib.Start()
+		ib.Stop()
+		assert.False(t, ib.triggerRun())
+		assert.Equal(t, indexTaskRetry, task.state)
+	})
+}
+
+func TestIndexBuilder_TaskTimeout(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.addTask(7, indexTaskInProgress)
+	task, ok := ib.tasks.Get(7)
+	assert.True(t, ok)
+	task.preferNodeID = 1
+
+	t.Run("no timeout", func(t *testing.T) {
+		ib.taskTimeout = 0
+		task.lastActiveTime = time.Now().Add(-time.Hour)
+		ib.reclaimTimeoutTasks()
+		assert.Equal(t, indexTaskInProgress, task.state)
+	})
+
+	t.Run("progress reported", func(t *testing.T) {
+		ib.taskTimeout = time.Minute
+		ib.updateStateByMeta(&indexpb.IndexMeta{
+			IndexBuildID: 7,
+			State:        commonpb.IndexState_InProgress,
+			NodeID:       1,
+		})
+		ib.reclaimTimeoutTasks()
+		assert.Equal(t, indexTaskInProgress, task.state)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		task.lastActiveTime = time.Now().Add(-time.Hour)
+		ib.reclaimTimeoutTasks()
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Equal(t, UniqueID(0), task.preferNodeID)
+		assert.NotEmpty(t, task.failReason)
+	})
+}
+
+func TestIndexBuilder_BuildProgress(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	// the IndexNode of older versions doesn't report the progress.
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 4,
+		State:        commonpb.IndexState_InProgress,
+		NodeID:       1,
+	})
+	progress, ok := ib.GetTaskProgress(4)
+	assert.True(t, ok)
+	assert.Nil(t, progress)
+
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 4,
+		State:        commonpb.IndexState_InProgress,
+		NodeID:       1,
+		Progress:     0.5,
+	})
+	progress, ok = ib.GetTaskProgress(4)
+	assert.True(t, ok)
+	assert.Equal(t, float32(0.5), *progress)
+	assert.Equal(t, float32(0.5), *ib.ListTaskInfos()[4].progress)
+
+	// the progress of the last assignment is discarded once the task is reassigned.
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskRetry)
+	progress, ok = ib.GetTaskProgress(4)
+	assert.True(t, ok)
+	assert.Nil(t, progress)
+
+	_, ok = ib.GetTaskProgress(100)
+	assert.False(t, ok)
+}
+
+func TestIndexBuilder_ResumeFromCheckpoint(t *testing.T) {
+	node := &recordingIndexNode{}
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[4].indexMeta.CheckpointPath = "checkpoints/4/1"
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: node,
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+	ib.retryBackoffBase = 0
+
+	// the checkpoint reported by the IndexNode replaces the one recovered from meta.
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID:   4,
+		State:          commonpb.IndexState_InProgress,
+		NodeID:         1,
+		CheckpointPath: "checkpoints/4/2",
+	})
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	assert.Equal(t, "checkpoints/4/2", task.checkpointPath)
+
+	// the task of the down IndexNode is reassigned with the checkpoint.
+	ib.nodeDown(1)
+	assert.Equal(t, indexTaskRetry, task.state)
+	ib.process(4)
+	ib.process(4)
+	assert.Equal(t, 1, len(node.reqs))
+	assert.Equal(t, UniqueID(4), node.reqs[0].GetIndexBuildID())
+	assert.Equal(t, "checkpoints/4/2", node.reqs[0].GetCheckpointPath())
+
+	// the task without checkpoint is built from scratch.
+	ib.process(2)
+	assert.Equal(t, 2, len(node.reqs))
+	assert.Equal(t, "", node.reqs[1].GetCheckpointPath())
+}
+
+func TestIndexBuilder_ReclaimOrphanedTasks(t *testing.T) {
+	nodeClients := map[UniqueID]types.IndexNode{
+		1: &indexnode.Mock{},
+	}
+	ib := newTestIndexBuilder(createMetaTable(), withIndexNodes(nodeClients))
+	clock := newManualClock()
+	ib.clock = clock
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+
+	t.Run("disabled", func(t *testing.T) {
+		ib.livenessCheckInterval = 0
+		delete(nodeClients, 1)
+		defer func() {
+			nodeClients[1] = &indexnode.Mock{}
+		}()
+		ib.reclaimOrphanedTasks()
+		assert.Equal(t, indexTaskInProgress, task.state)
+	})
+
+	t.Run("alive", func(t *testing.T) {
+		ib.livenessCheckInterval = time.Minute
+		ib.reclaimOrphanedTasks()
+		assert.Equal(t, indexTaskInProgress, task.state)
+	})
+
+	t.Run("not alive", func(t *testing.T) {
+		delete(nodeClients, 1)
+		// the IndexNodes are checked once per interval.
+		ib.reclaimOrphanedTasks()
+		assert.Equal(t, indexTaskInProgress, task.state)
+
+		clock.Advance(time.Minute)
+		ib.reclaimOrphanedTasks()
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Equal(t, errIndexNodeIsNotOnService(1).Error(), task.failReason)
+		// the task in init state is not affected.
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+	})
+}
+
+func TestIndexBuilder_TaskHooks(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	completeCh := make(chan *indexpb.IndexMeta, 1)
+	failedCh := make(chan *indexpb.IndexMeta, 1)
+	ib.OnTaskComplete(func(buildID UniqueID, meta *indexpb.IndexMeta) {
+		completeCh <- meta
+	})
+	ib.OnTaskFailed(func(buildID UniqueID, meta *indexpb.IndexMeta) {
+		failedCh <- meta
+	})
+
+	ib.addTask(7, indexTaskInProgress)
+	ib.addTask(8, indexTaskInProgress)
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 7,
+		State:        commonpb.IndexState_Finished,
+		NodeID:       1,
+	})
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 8,
+		State:        commonpb.IndexState_Failed,
+		NodeID:       1,
+	})
+
+	select {
+	case meta := <-completeCh:
+		assert.Equal(t, UniqueID(7), meta.IndexBuildID)
+	case <-time.After(time.Second):
+		t.Fatal("complete hook is not called")
+	}
+	select {
+	case meta := <-failedCh:
+		assert.Equal(t, UniqueID(8), meta.IndexBuildID)
+	case <-time.After(time.Second):
+		t.Fatal("failed hook is not called")
+	}
+}
+
+func TestIndexBuilder_DuplicateEnqueue(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	t.Run("in progress", func(t *testing.T) {
+		ib.addTask(7, indexTaskInProgress)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ib.enqueue(7)
+			}()
+		}
+		wg.Wait()
+		state, ok := ib.GetTaskState(7)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInProgress, state)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		task := ib.addTask(8, indexTaskRetry)
+		task.retryCount = 1
+		ib.enqueue(8)
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Equal(t, 1, task.retryCount)
+	})
+
+	t.Run("terminal", func(t *testing.T) {
+		ib.addTask(9, indexTaskDone)
+		ib.enqueue(9)
+		state, ok := ib.GetTaskState(9)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, state)
+	})
+}
+
+// releaseLockFailDataCoord fails to release the segment reference locks, and counts the attempts.
+type releaseLockFailDataCoord struct {
+	DataCoordMock
+	releaseCount int
+}
+
+func (dc *releaseLockFailDataCoord) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	dc.releaseCount++
+	return nil, errors.New("release segment lock failed")
+}
+
+func TestIndexBuilder_OrphanedLock(t *testing.T) {
+	dataCoord := &releaseLockFailDataCoord{}
+	mt := createMetaTable()
+	savedKeys := make([]string, 0)
+	mt.client.(*mockETCDKV).save = func(key string, value string) error {
+		savedKeys = append(savedKeys, key)
+		return nil
+	}
+	ib := newTestIndexBuilder(mt, withDataCoord(dataCoord))
+	ib.maxReleaseLockRetry = 2
+	ib.retryBackoffBase = time.Minute
+	ib.retryBackoffMax = time.Minute
+
+	task, ok := ib.tasks.Get(6)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskDone, task.state)
+	orphanedCount := testutil.ToFloat64(metrics.IndexCoordOrphanedSegmentLockCounter.WithLabelValues())
+
+	ib.process(6)
+	assert.Equal(t, 1, task.releaseFailCount)
+	assert.Equal(t, time.Minute, task.releaseDelay)
+	// each attempt is a single request without retry.
+	assert.Equal(t, 1, dataCoord.releaseCount)
+
+	// in backoff window
+	ib.process(6)
+	assert.Equal(t, 1, task.releaseFailCount)
+	assert.Equal(t, 1, dataCoord.releaseCount)
+
+	task.lastReleaseFailTime = time.Now().Add(-time.Hour)
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+	assert.Equal(t, []string{path.Join(orphanedLockMetaPrefix, "6")}, savedKeys)
+	assert.Equal(t, orphanedCount+1, testutil.ToFloat64(metrics.IndexCoordOrphanedSegmentLockCounter.WithLabelValues()))
+}
+
+type releaseLockCountDataCoord struct {
+	DataCoordMock
+	releaseCount int
+}
+
+func (dc *releaseLockCountDataCoord) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	dc.releaseCount++
+	return dc.DataCoordMock.ReleaseSegmentLock(ctx, req)
+}
+
+func TestIndexBuilder_DeleteAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	dataCoord := &releaseLockCountDataCoord{}
+	ic := newTestIndexCoord(ctx, withDataCoord(dataCoord))
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[4].indexMeta.MarkDeleted = true
+	mt.indexBuildID2Meta[4].indexMeta.NodeID = 1
+
+	t.Run("restart before the lock is released", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+		state, ok := ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDeleted, state)
+
+		ib.process(4)
+		assert.False(t, ib.hasTask(4))
+		assert.Equal(t, 1, dataCoord.releaseCount)
+		meta, ok := mt.GetMeta(4)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+	})
+
+	t.Run("restart after the lock is released", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+		assert.False(t, ib.hasTask(4))
+	})
+
+	t.Run("delete the untracked task", func(t *testing.T) {
+		ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+		ib.removeTask(3)
+		ib.markTaskAsDeleted(3)
+		state, ok := ib.GetTaskState(3)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDeleted, state)
+	})
+}
+
+func TestIndexBuilder_LockHoldTime(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.lockHoldWarnThreshold = time.Minute
+
+	getSampleCount := func() uint64 {
+		m := &dto.Metric{}
+		err := metrics.IndexCoordSegmentLockHoldDuration.WithLabelValues().(prometheus.Histogram).Write(m)
+		assert.NoError(t, err)
+		return m.GetHistogram().GetSampleCount()
+	}
+
+	// the time when the lock was acquired is unknown
+	count := getSampleCount()
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+	assert.Equal(t, count, getSampleCount())
+
+	task := ib.addTask(6, indexTaskDone)
+	task.lockAcquireTime = time.Now().Add(-time.Hour)
+	ib.process(6)
+	assert.False(t, ib.hasTask(6))
+	assert.Equal(t, count+1, getSampleCount())
+}
+
+func TestIndexBuilder_StaleAssignment(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	task := ib.addTask(7, indexTaskInProgress)
+	task.assignedVersion = 2
+
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 7,
+		State:        commonpb.IndexState_Finished,
+		NodeID:       1,
+		IndexVersion: 1,
+	})
+	assert.Equal(t, indexTaskInProgress, task.state)
+
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 7,
+		State:        commonpb.IndexState_Finished,
+		NodeID:       2,
+		IndexVersion: 2,
+	})
+	assert.Equal(t, indexTaskDone, task.state)
+}
+
+func TestIndexBuilder_WaitForTask(t *testing.T) {
+	ctx := context.Background()
+	ib := newTestIndexBuilder(createMetaTable())
+
+	t.Run("finished", func(t *testing.T) {
+		state, err := ib.WaitForTask(ctx, 6)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.IndexState_Finished, state)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		_, err := ib.WaitForTask(ctx, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		_, err := ib.WaitForTask(ctx, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				state, err := ib.WaitForTask(ctx, 4)
+				assert.NoError(t, err)
+				assert.Equal(t, commonpb.IndexState_Failed, state)
+			}()
+		}
+		assert.Eventually(t, func() bool {
+			ib.waiterLock.Lock()
+			defer ib.waiterLock.Unlock()
+			return len(ib.waiters[4]) == 3
+		}, time.Second, time.Millisecond*10)
+
+		ib.updateStateByMeta(&indexpb.IndexMeta{
+			IndexBuildID: 4,
+			State:        commonpb.IndexState_Failed,
+			NodeID:       1,
+		})
+		wg.Wait()
+		assert.Equal(t, 0, len(ib.waiters))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+		defer cancel()
+		_, err := ib.WaitForTask(cancelCtx, 5)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 0, len(ib.waiters))
+	})
+
+	t.Run("task deleted", func(t *testing.T) {
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := ib.WaitForTask(ctx, 5)
+			errCh <- err
+		}()
+		assert.Eventually(t, func() bool {
+			ib.waiterLock.Lock()
+			defer ib.waiterLock.Unlock()
+			return len(ib.waiters[5]) == 1
+		}, time.Second, time.Millisecond*10)
+		ib.markTaskAsDeleted(5)
+		assert.Error(t, <-errCh)
+	})
+}
+
+func TestIndexBuilder_MaxTasksPerRun(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	mt := &metaTable{indexBuildID2Meta: map[UniqueID]*Meta{}}
+	for buildID := UniqueID(1); buildID <= 3; buildID++ {
+		mt.indexBuildID2Meta[buildID] = &Meta{
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: buildID,
+				State:        commonpb.IndexState_Unissued,
+				Req:          &indexpb.BuildIndexRequest{IndexID: buildID},
+			},
+		}
+	}
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{})
+	ib.Pause()
+	defer ib.Resume()
+	assert.Equal(t, 3, ib.tasks.Len())
+
+	ib.maxTasksPerRun = 1
+	deferred := testutil.ToFloat64(metrics.IndexCoordIndexBuilderDeferredTaskCounter.WithLabelValues())
+	ib.run()
+	assert.Equal(t, deferred+2, testutil.ToFloat64(metrics.IndexCoordIndexBuilderDeferredTaskCounter.WithLabelValues()))
+
+	ib.maxTasksPerRun = 0
+	ib.run()
+	assert.Equal(t, deferred+2, testutil.ToFloat64(metrics.IndexCoordIndexBuilderDeferredTaskCounter.WithLabelValues()))
+}
+
+func TestIndexBuilder_ProcessConcurrently(t *testing.T) {
+	mt := createMetaTable()
+	buildIDs := make([]UniqueID, 0)
+	for buildID := UniqueID(100); buildID < 200; buildID++ {
+		mt.indexBuildID2Meta[buildID] = &Meta{
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: buildID,
+				State:        commonpb.IndexState_Unissued,
+				Req: &indexpb.BuildIndexRequest{
+					NumRows:   100,
+					DataPaths: []string{"DataPath"},
+					TypeParams: []*commonpb.KeyValuePair{
+						{
+							Key:   "dim",
+							Value: "128",
+						},
+					},
+				},
+			},
+		}
+		buildIDs = append(buildIDs, buildID)
+	}
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		11: &indexnode.Mock{},
+		12: &indexnode.Mock{},
+		13: &indexnode.Mock{},
+	}))
+	ib.maxConcurrentTasksPerNode = 10
+	ib.processParallelism = 8
+
+	// the same tasks are processed by the workers and other goroutines at the same time.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ib.processTasks(buildIDs)
+		}()
+	}
+	wg.Wait()
+
+	inProgress := 0
+	taskNums := make(map[UniqueID]int)
+	infos := ib.ListTaskInfos()
+	for _, buildID := range buildIDs {
+		if info, ok := infos[buildID]; ok && info.state == indexTaskInProgress {
+			assert.Contains(t, []UniqueID{11, 12, 13}, info.nodeID)
+			inProgress++
+			taskNums[info.nodeID]++
+		}
+	}
+	assert.Equal(t, 30, inProgress)
+	for nodeID, taskNum := range taskNums {
+		assert.LessOrEqual(t, taskNum, 10, "IndexNode %d", nodeID)
+	}
+	assert.Equal(t, 0, len(ib.processing))
+}
+
+func TestIndexBuilder_NodeDownTwice(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	ib.nodeDown(1)
+	assert.Equal(t, 1, len(ib.notifyChan))
+	<-ib.notifyChan
+	tasks := ib.ListTasks()
+	// the deleted task is not reclaimed.
+	assert.Equal(t, indexTaskDeleted, tasks[1])
+	assert.Equal(t, indexTaskRetry, tasks[3])
+	assert.Equal(t, indexTaskRetry, tasks[4])
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	lastRetryTime, priority := task.lastRetryTime, task.priority
+
+	// the duplicated down event changes nothing.
+	ib.nodeDown(1)
+	assert.Equal(t, 0, len(ib.notifyChan))
+	assert.Equal(t, tasks, ib.ListTasks())
+	assert.Equal(t, lastRetryTime, task.lastRetryTime)
+	assert.Equal(t, priority, task.priority)
+}
+
+func TestIndexBuilder_CollectionIndexReadyHook(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	mt := &metaTable{indexBuildID2Meta: map[UniqueID]*Meta{}}
+	// the tasks 1 and 2 build the indexes of collection 10, the task 3 builds the index of collection 20.
+	collectionIDs := map[UniqueID]UniqueID{1: 10, 2: 10, 3: 20}
+	for buildID, collectionID := range collectionIDs {
+		mt.indexBuildID2Meta[buildID] = &Meta{
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: buildID,
+				State:        commonpb.IndexState_InProgress,
+				NodeID:       1,
+				Req:          &indexpb.BuildIndexRequest{IndexID: 100 + buildID, CollectionID: collectionID},
+			},
+		}
+	}
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1})
+
+	readyCh := make(chan UniqueID, 2)
+	ib.OnCollectionIndexReady(func(collectionID UniqueID) {
+		readyCh <- collectionID
+	})
+	finish := func(buildID UniqueID) {
+		ib.updateStateByMeta(&indexpb.IndexMeta{
+			IndexBuildID: buildID,
+			State:        commonpb.IndexState_Finished,
+			NodeID:       1,
+			Req:          &indexpb.BuildIndexRequest{IndexID: 100 + buildID, CollectionID: collectionIDs[buildID]},
+		})
+	}
+	assertReady := func(collectionID UniqueID) {
+		select {
+		case readyID := <-readyCh:
+			assert.Equal(t, collectionID, readyID)
+		case <-time.After(time.Second):
+			t.Fatal("collection index ready hook is not called")
+		}
+	}
+	assertNotReady := func() {
+		select {
+		case collectionID := <-readyCh:
+			t.Fatalf("the index of collection %d is ready", collectionID)
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+
+	// the unfinished task of collection 20 does not block collection 10.
+	finish(1)
+	assertNotReady()
+	finish(2)
+	assertReady(10)
+
+	// the event is fired once for the enqueued tasks.
+	ib.taskMutex.Lock()
+	ib.runCollectionIndexReadyHooks(10)
+	ib.taskMutex.Unlock()
+	assertNotReady()
+
+	// the collection is ready again after the task of a new segment is finished.
+	collectionIDs[4] = 10
+	mt.indexBuildID2Meta[4] = &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 4,
+			State:        commonpb.IndexState_Unissued,
+			Req:          &indexpb.BuildIndexRequest{IndexID: 104, CollectionID: 10},
+		},
+	}
+	ib.enqueue(4)
+	ib.taskMutex.Lock()
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskInProgress)
+	ib.taskMutex.Unlock()
+	finish(4)
+	assertReady(10)
+
+	finish(3)
+	assertReady(20)
+}
+
+func TestIndexBuilder_ShadowBuild(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	mt := &metaTable{indexBuildID2Meta: map[UniqueID]*Meta{
+		1: {
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: 1,
+				State:        commonpb.IndexState_InProgress,
+				NodeID:       1,
+				Req:          &indexpb.BuildIndexRequest{IndexID: 100, SegmentID: 10, CollectionID: 2},
+			},
+		},
+		2: {
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: 2,
+				State:        commonpb.IndexState_InProgress,
+				NodeID:       1,
+				Req:          &indexpb.BuildIndexRequest{IndexID: 200, SegmentID: 10, CollectionID: 1, ShadowBuild: true},
+			},
+		},
+	}}
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1})
+
+	// the shadow build is tracked independently of the active build of the same segment.
+	infos := ib.ListTaskInfos()
+	assert.False(t, infos[1].shadow)
+	assert.True(t, infos[2].shadow)
+
+	readyCh := make(chan UniqueID, 2)
+	ib.OnCollectionIndexReady(func(collectionID UniqueID) {
+		readyCh <- collectionID
+	})
+	ib.updateStateByMeta(&indexpb.IndexMeta{
+		IndexBuildID: 2,
+		State:        commonpb.IndexState_Finished,
+		NodeID:       1,
+		Req:          &indexpb.BuildIndexRequest{IndexID: 200, SegmentID: 10, CollectionID: 1, ShadowBuild: true},
+	})
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskDone, state)
+	select {
+	case collectionID := <-readyCh:
+		t.Fatalf("the collection %d is ready by the shadow index", collectionID)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+// recordingIndexNode records the requests of CreateIndex.
+type recordingIndexNode struct {
+	indexnode.Mock
+	reqs []*indexpb.CreateIndexRequest
+}
+
+func (in *recordingIndexNode) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	in.reqs = append(in.reqs, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestIndexBuilder_SegmentDropped(t *testing.T) {
+	dataCoord := &DataCoordMock{
+		SegmentStates: map[UniqueID]commonpb.SegmentState{
+			102: commonpb.SegmentState_Dropped,
+		},
+	}
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[2].indexMeta.Req.SegmentID = 102
+	ib := newTestIndexBuilder(mt, withDataCoord(dataCoord), withIndexNodes(map[UniqueID]types.IndexNode{
+		4: &indexnode.Mock{},
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+
+	ib.process(2)
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskFailed, state)
+	meta, ok := mt.GetMeta(2)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+	assert.Equal(t, segmentDroppedFailReason, meta.indexMeta.FailReason)
+	assert.Equal(t, int64(0), meta.indexMeta.NodeID)
+
+	ib.process(2)
+	assert.False(t, ib.hasTask(2))
+}
+
+func TestIndexBuilder_SegmentCompacted(t *testing.T) {
+	compactedSegment := &datapb.SegmentInfo{
+		ID:        103,
+		NumOfRows: 200,
+		Binlogs: []*datapb.FieldBinlog{
+			{
+				Binlogs: []*datapb.Binlog{{LogPath: "DataPath-103"}},
+			},
+		},
+	}
+
+	t.Run("move to the compacted segment", func(t *testing.T) {
+		dataCoord := &DataCoordMock{
+			CompactingSegments: map[UniqueID]struct{}{
+				102: {},
+			},
+		}
+		node := &recordingIndexNode{}
+		mt := createMetaTable()
+		mt.indexBuildID2Meta[2].indexMeta.Req.SegmentID = 102
+		ib := newTestIndexBuilder(mt, withDataCoord(dataCoord), withIndexNodes(map[UniqueID]types.IndexNode{
+			4: node,
+		}))
+		ib.maxConcurrentTasksPerNode = 0
+
+		// the task waits for the compaction in init state.
+		ib.process(2)
+		assert.Equal(t, 0, len(node.reqs))
+		task, ok := ib.tasks.Get(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, UniqueID(0), task.nodeID)
+		assert.Equal(t, "segment 102 is being compacted", task.blockedReason)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, int64(0), meta.indexMeta.IndexVersion)
+
+		dataCoord.CompactingSegments = nil
+		dataCoord.SegmentStates = map[UniqueID]commonpb.SegmentState{
+			102: commonpb.SegmentState_Dropped,
+		}
+		dataCoord.CompactedTo = map[UniqueID]UniqueID{
+			102: 103,
+		}
+		dataCoord.SegmentInfos = map[UniqueID]*datapb.SegmentInfo{
+			103: compactedSegment,
+		}
+		ib.process(2)
+		assert.Equal(t, 0, len(node.reqs))
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Equal(t, UniqueID(103), task.segmentID)
+		meta, ok = mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(103), meta.indexMeta.Req.SegmentID)
+		assert.Equal(t, []string{"DataPath-103"}, meta.indexMeta.Req.DataPaths)
+		assert.Equal(t, int64(200), meta.indexMeta.Req.NumRows)
+
+		// the index of the compacted segment is built.
+		ib.process(2)
+		assert.Equal(t, 1, len(node.reqs))
+		assert.Equal(t, []string{"DataPath-103"}, node.reqs[0].DataPaths)
+	})
+
+	t.Run("compacted segment is indexed", func(t *testing.T) {
+		dataCoord := &DataCoordMock{
+			SegmentStates: map[UniqueID]commonpb.SegmentState{
+				102: commonpb.SegmentState_NotExist,
+			},
+			CompactedTo: map[UniqueID]UniqueID{
+				102: 103,
+			},
+			SegmentInfos: map[UniqueID]*datapb.SegmentInfo{
+				103: compactedSegment,
+			},
+		}
+		mt := createMetaTable()
+		mt.indexBuildID2Meta[2].indexMeta.Req.SegmentID = 102
+		mt.indexBuildID2Meta[3].indexMeta.Req.SegmentID = 103
+		mt.indexBuildID2Meta[3].indexMeta.Req.DataPaths = []string{"DataPath-103"}
+		mt.indexBuildID2Meta[3].indexMeta.Req.NumRows = 200
+		ib := newTestIndexBuilder(mt, withDataCoord(dataCoord), withIndexNodes(map[UniqueID]types.IndexNode{
+			4: &indexnode.Mock{},
+		}))
+		ib.maxConcurrentTasksPerNode = 0
+
+		ib.process(2)
+		state, ok := ib.GetTaskState(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskFailed, state)
+		meta, ok := mt.GetMeta(2)
+		assert.True(t, ok)
+		assert.Equal(t, UniqueID(102), meta.indexMeta.Req.SegmentID)
+		assert.Equal(t, segmentDroppedFailReason, meta.indexMeta.FailReason)
+	})
+}
+
+func TestIndexBuilder_EmptyDataPaths(t *testing.T) {
+	node := &recordingIndexNode{}
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[2].indexMeta.Req.DataPaths = nil
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: node,
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+
+	ib.process(2)
+	assert.Equal(t, 0, len(node.reqs))
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskFailed, state)
+	meta, ok := mt.GetMeta(2)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Failed, meta.indexMeta.State)
+	assert.Equal(t, "no data paths to build the index", meta.indexMeta.FailReason)
+}
+
+func TestIndexBuilder_SegmentOrigin(t *testing.T) {
+	dataPaths := []string{"binlog/10", "binlog/2", "binlog/10"}
+	for origin, expected := range map[string][]string{
+		common.SegmentOriginFlushed:  dataPaths,
+		common.SegmentOriginImported: {"binlog/2", "binlog/10"},
+	} {
+		t.Run(origin, func(t *testing.T) {
+			node := &recordingIndexNode{}
+			mt := createMetaTable()
+			req := mt.indexBuildID2Meta[2].indexMeta.Req
+			req.DataPaths = dataPaths
+			req.SegmentOrigin = origin
+			ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+				4: node,
+			}))
+			ib.maxConcurrentTasksPerNode = 0
+			assert.Equal(t, origin, ib.ListTaskInfos()[2].segmentOrigin)
+
+			ib.process(2)
+			assert.Equal(t, 1, len(node.reqs))
+			assert.Equal(t, expected, node.reqs[0].GetDataPaths())
+			// the meta path is the key of the index meta, it is the same for both origins.
+			assert.Equal(t, "indexes/2", node.reqs[0].GetMetaPath())
+		})
+	}
+}
+
+func TestIndexBuilder_LockAcquireRate(t *testing.T) {
+	assert.Nil(t, newLockAcquireLimiter(0))
+	assert.Equal(t, 1, newLockAcquireLimiter(0.5).Burst())
+	assert.Equal(t, 10, newLockAcquireLimiter(10).Burst())
+
+	node := &recordingIndexNode{}
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		4: node,
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+	ib.retryBackoffBase = 0
+	ib.lockAcquireLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+	throttled := testutil.ToFloat64(metrics.IndexCoordSegmentLockThrottledCounter.WithLabelValues())
+
+	ib.process(2)
+	state, ok := ib.GetTaskState(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInProgress, state)
+
+	// the task stays in init state without the token.
+	ib.process(3)
+	ib.process(3)
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, task.state)
+	assert.Equal(t, UniqueID(0), task.nodeID)
+	assert.Equal(t, "segment reference lock acquisitions are throttled", task.blockedReason)
+	assert.Equal(t, 1, len(node.reqs))
+	assert.Equal(t, throttled+1, testutil.ToFloat64(metrics.IndexCoordSegmentLockThrottledCounter.WithLabelValues()))
+}
+
+func TestIndexBuilder_RetryJitter(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.maxTaskRetry = 0
+	ib.retryBackoffBase = 0
+	ib.retryJitter = time.Hour
+
+	// the jitter is chosen when the task goes into retry.
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	for i := 0; i < 10; i++ {
+		ib.setTaskState(task, indexTaskInProgress)
+		ib.setTaskState(task, indexTaskRetry)
+		assert.GreaterOrEqual(t, task.retryJitter, time.Duration(0))
+		assert.Less(t, task.retryJitter, time.Hour)
+	}
+
+	// the task is not reassigned in the jitter window.
+	task.retryJitter = time.Hour
+	ib.process(4)
+	assert.Equal(t, indexTaskRetry, task.state)
+	task.lastRetryTime = time.Now().Add(-time.Hour)
+	ib.process(4)
+	assert.Equal(t, indexTaskInit, task.state)
+
+	ib.retryJitter = 0
+	assert.Equal(t, time.Duration(0), ib.nextRetryJitter())
+}
+
+// parseTaskState returns the state of the name returned by String.
+func parseTaskState(name string) (indexTaskState, bool) {
+	for state, stateName := range TaskStateNames {
+		if stateName == name {
+			return state, true
+		}
+	}
+	return indexTaskInit, false
+}
+
+// restoreIndexBuilder replaces the tasks of the index builder with the tasks in the dump.
+func restoreIndexBuilder(ib *indexBuilder, dump *indexpb.IndexBuilderDump) error {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+
+	for _, buildID := range ib.tasks.BuildIDs() {
+		ib.removeTask(buildID)
+	}
+	ib.paused = dump.GetPaused()
+	for _, taskDump := range dump.GetTasks() {
+		state, ok := parseTaskState(taskDump.GetState())
+		if !ok {
+			return fmt.Errorf("unknown state %s of index task %d", taskDump.GetState(), taskDump.GetIndexBuildID())
+		}
+		task := ib.addTask(taskDump.GetIndexBuildID(), state)
+		task.nodeID = taskDump.GetNodeID()
+		task.preferNodeID = taskDump.GetPreferNodeID()
+		task.retryCount = int(taskDump.GetRetryCount())
+		task.failReason = taskDump.GetFailReason()
+		task.blockedReason = taskDump.GetBlockedReason()
+		task.enqueueTime = time.Unix(0, taskDump.GetEnqueueTime()*int64(time.Millisecond))
+		task.lastRetryTime = time.Unix(0, taskDump.GetRetryDeadline()*int64(time.Millisecond))
+		task.retryDelay = 0
+		task.retryJitter = 0
+		ib.tasks.Update(taskDump.GetIndexBuildID(), int(taskDump.GetPriority()))
+	}
+	return nil
+}
+
+func TestIndexBuilder_Dump(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	ib := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskInProgress)
+	ib.setTaskState(task, indexTaskRetry)
+	task.failReason = "node down"
+	task.retryDelay = time.Minute
+
+	dump := ib.Dump()
+	assert.False(t, dump.Paused)
+	assert.Equal(t, ib.tasks.Len(), len(dump.Tasks))
+	for i, buildID := range ib.tasks.BuildIDs() {
+		assert.Equal(t, buildID, dump.Tasks[i].IndexBuildID)
+	}
+	for _, taskDump := range dump.Tasks {
+		if taskDump.IndexBuildID != 4 {
+			assert.Equal(t, int64(0), taskDump.RetryDeadline)
+			continue
+		}
+		assert.Equal(t, indexTaskRetry.String(), taskDump.State)
+		assert.Equal(t, "node down", taskDump.FailReason)
+		assert.Equal(t, task.lastRetryTime.Add(time.Minute).UnixNano()/int64(time.Millisecond), taskDump.RetryDeadline)
+	}
+
+	// the restored index builder has the same state.
+	ib2 := newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{})
+	err := restoreIndexBuilder(ib2, dump)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(dump, ib2.Dump()))
+
+	dump.Tasks[0].State = "Unknown"
+	err = restoreIndexBuilder(ib2, dump)
+	assert.Error(t, err)
+}
+
+func TestIndexBuilder_MetaRemovedDuringProcess(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+
+	for _, buildID := range []UniqueID{3, 4} {
+		task, ok := ib.tasks.Get(buildID)
+		assert.True(t, ok)
+		if buildID == 4 {
+			ib.setTaskState(task, indexTaskRetry)
+		}
+		// the meta is removed between enqueue and process.
+		mt.lock.Lock()
+		delete(mt.indexBuildID2Meta, buildID)
+		mt.lock.Unlock()
+
+		ib.process(buildID)
+		_, ok = ib.tasks.Get(buildID)
+		assert.False(t, ok)
+	}
+}
+
+func TestIndexBuilder_VerifyIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	chunkManager := &ChunkManagerMock{Fail: true}
+	ic := newTestIndexCoord(ctx)
+	ic.chunkManager = chunkManager
+	mt := createMetaTable()
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+	ib.verifyIndexFiles = true
+
+	finishedMeta := &indexpb.IndexMeta{
+		IndexBuildID:   4,
+		State:          commonpb.IndexState_Finished,
+		NodeID:         1,
+		IndexFilePaths: []string{"file1", "file2"},
+	}
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+
+	t.Run("missing file", func(t *testing.T) {
+		ib.updateStateByMeta(finishedMeta)
+		assert.Equal(t, indexTaskRetry, task.state)
+		assert.Contains(t, task.failReason, "file1")
+	})
+
+	t.Run("check failed", func(t *testing.T) {
+		ib.setTaskState(task, indexTaskRetry)
+		ib.setTaskState(task, indexTaskInProgress)
+		chunkManager.Err = true
+		ib.updateStateByMeta(finishedMeta)
+		assert.Equal(t, indexTaskDone, task.state)
+	})
+
+	t.Run("files exist", func(t *testing.T) {
+		ib.setTaskState(task, indexTaskRetry)
+		ib.setTaskState(task, indexTaskInProgress)
+		chunkManager.Err = false
+		chunkManager.Fail = false
+		ib.updateStateByMeta(finishedMeta)
+		assert.Equal(t, indexTaskDone, task.state)
+	})
+
+	t.Run("not verified", func(t *testing.T) {
+		ib.setTaskState(task, indexTaskRetry)
+		ib.setTaskState(task, indexTaskInProgress)
+		chunkManager.Fail = true
+		ib.verifyIndexFiles = false
+		ib.updateStateByMeta(finishedMeta)
+		assert.Equal(t, indexTaskDone, task.state)
+	})
+}
+
+func TestIndexBuilder_FailedNodes(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		1: &indexnode.Mock{},
+		4: &indexnode.Mock{},
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+	ib.retryBackoffBase = time.Hour
+
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	ib.process(3)
+	assert.Equal(t, indexTaskInit, task.state)
+
+	// both IndexNodes failed the task, it is deferred instead of being assigned back and forth.
+	task.failedNodes = map[UniqueID]struct{}{1: {}, 4: {}}
+	ib.process(3)
+	assert.Equal(t, indexTaskInit, task.state)
+	assert.Equal(t, UniqueID(0), task.nodeID)
+	assert.True(t, task.deferUntil.After(time.Now()))
+	assert.Nil(t, task.failedNodes)
+	ib.process(3)
+	assert.Equal(t, indexTaskInit, task.state)
+
+	// the IndexNode which has not failed the task is preferred.
+	task.deferUntil = time.Time{}
+	task.failedNodes = map[UniqueID]struct{}{1: {}}
+	ib.process(3)
+	assert.Equal(t, indexTaskInProgress, task.state)
+	assert.Equal(t, UniqueID(4), task.nodeID)
+	assert.Nil(t, task.failedNodes)
+
+	// the IndexNode is recorded when the task goes into retry.
+	ib.setTaskState(task, indexTaskRetry)
+	_, ok = task.failedNodes[4]
+	assert.True(t, ok)
+}
+
+func TestIndexBuilder_NotifyAfterStop(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.Start()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				ib.notify()
+			}
+		}()
+	}
+	ib.Stop()
+	wg.Wait()
+
+	// the notifications and the stops after Stop are dropped.
+	ib.notify()
+	ib.markTaskAsDeleted(1)
+	ib.Stop()
+}
+
+func TestIndexBuilder_BlockedReason(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	task, ok := ib.tasks.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, task.state)
+
+	ib.paused = true
+	ib.process(2)
+	assert.Equal(t, "index builder is paused", ib.ListTaskInfos()[2].blockedReason)
+
+	// there is no IndexNode online.
+	ib.paused = false
+	ib.process(2)
+	assert.Equal(t, indexTaskInit, task.state)
+	assert.Equal(t, ErrNoIndexNode.Error(), ib.ListTaskInfos()[2].blockedReason)
+	for _, taskDump := range ib.Dump().Tasks {
+		if taskDump.IndexBuildID == 2 {
+			assert.Equal(t, ErrNoIndexNode.Error(), taskDump.BlockedReason)
+		}
+	}
+
+	// the reason is cleared once the task leaves init state.
+	ib.setTaskState(task, indexTaskInProgress)
+	assert.Equal(t, "", ib.ListTaskInfos()[2].blockedReason)
+}
+
+func TestIndexBuilder_MarkTasksAsDeleted(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+
+	ib.markTasksAsDeleted(nil)
+	assert.Equal(t, 0, len(ib.notifyChan))
+
+	// the failed task 7 is not tracked and its lock has been released.
+	ib.markTasksAsDeleted([]UniqueID{2, 3, 4, 7})
+	assert.Equal(t, 1, len(ib.notifyChan))
+	for _, buildID := range []UniqueID{2, 3, 4} {
+		state, ok := ib.GetTaskState(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDeleted, state)
+	}
+	_, ok := ib.GetTaskState(7)
+	assert.False(t, ok)
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"math/rand"
 	"os"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -48,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -88,6 +90,7 @@ type IndexCoord struct {
 	nodeManager      *NodeManager
 	indexBuilder     *indexBuilder
 	garbageCollector *garbageCollector
+	rebuildTrigger   *rebuildTrigger
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
@@ -207,6 +210,11 @@ func (i *IndexCoord) Init() error {
 		}
 		log.Debug("IndexCoord", zap.Int("IndexNode number", len(i.nodeManager.nodeClients)))
 		i.indexBuilder = newIndexBuilder(i.loopCtx, i, i.metaTable, aliveNodeID)
+		i.indexBuilder.OnCollectionIndexReady(i.collectionIndexReady)
+		// the handlers are registered on the global params, they are removed when IndexCoord is stopped.
+		i.closeCallbacks = append(i.closeCallbacks,
+			Params.IndexCoordCfg.WatchScheduleInterval(i.indexBuilder.setScheduleDuration),
+			Params.IndexCoordCfg.WatchPeakWindows(i.indexBuilder.setPeakWindows))
 
 		// TODO silverxia add Rewatch logic
 		i.eventChan = i.session.WatchServices(typeutil.IndexNodeRole, revision+1, nil)
@@ -232,6 +240,7 @@ func (i *IndexCoord) Init() error {
 		i.chunkManager = chunkManager
 
 		i.garbageCollector = newGarbageCollector(i.loopCtx, i.metaTable, i.chunkManager)
+		i.rebuildTrigger = newRebuildTrigger(i.loopCtx, i, i.metaTable, i.indexBuilder)
 		i.sched, err = NewTaskScheduler(i.loopCtx, i.idAllocator, i.chunkManager, i.metaTable)
 		if err != nil {
 			log.Error("IndexCoord new task scheduler failed", zap.Error(err))
@@ -265,6 +274,7 @@ func (i *IndexCoord) Start() error {
 
 		i.indexBuilder.Start()
 		i.garbageCollector.Start()
+		i.rebuildTrigger.Start()
 
 		i.UpdateStateCode(internalpb.StateCode_Healthy)
 	})
@@ -287,6 +297,11 @@ func (i *IndexCoord) Stop() error {
 	// https://github.com/milvus-io/milvus/issues/12282
 	i.UpdateStateCode(internalpb.StateCode_Abnormal)
 
+	if i.indexBuilder != nil {
+		i.indexBuilder.GracefulStop(Params.IndexCoordCfg.GracefulStopTimeout)
+		log.Info("stop the index builder of IndexCoord")
+	}
+
 	if i.loopCancel != nil {
 		i.loopCancel()
 		log.Info("cancel the loop of IndexCoord")
@@ -298,15 +313,16 @@ func (i *IndexCoord) Stop() error {
 	}
 	i.loopWg.Wait()
 
-	if i.indexBuilder != nil {
-		i.indexBuilder.Stop()
-		log.Info("stop the index builder of IndexCoord")
-	}
 	if i.garbageCollector != nil {
 		i.garbageCollector.Stop()
 		log.Info("stop the garbage collector of IndexCoord")
 	}
 
+	if i.rebuildTrigger != nil {
+		i.rebuildTrigger.Stop()
+		log.Info("stop the rebuild trigger of IndexCoord")
+	}
+
 	for _, cb := range i.closeCallbacks {
 		cb()
 	}
@@ -355,12 +371,15 @@ func (i *IndexCoord) GetComponentStates(ctx context.Context) (*internalpb.Compon
 	}
 
 	ret := &internalpb.ComponentStates{
-		State:              stateInfo,
-		SubcomponentStates: nil, // todo add subcomponents states
+		State: stateInfo,
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 		},
 	}
+	if i.indexBuilder != nil {
+		// the health of the index scheduler is reported separately, it does not affect the state of IndexCoord.
+		ret.SubcomponentStates = []*internalpb.ComponentInfo{i.indexBuilder.componentInfo(nodeID)}
+	}
 	log.Debug("IndexCoord GetComponentStates", zap.Any("IndexCoord component state", stateInfo))
 	return ret, nil
 }
@@ -422,6 +441,20 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
 	defer sp.Finish()
+	if err := checkBuildIndexRequest(req); err != nil {
+		log.Warn("IndexCoord reject the invalid index request", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		ret.Status.Reason = err.Error()
+		metrics.IndexCoordIndexRequestCounter.WithLabelValues(metrics.FailLabel).Inc()
+		return ret, nil
+	}
+	// the defaults are filled before the request is recorded in meta, so the IndexNodes build the index with the
+	// same params and the same requests are still found.
+	indexParams, filled := fillDefaultIndexParams(req.GetIndexParams())
+	if len(filled) > 0 {
+		log.Info("IndexCoord fill the default index params", zap.Int64("segmentID", req.GetSegmentID()),
+			zap.String("index type", getIndexType(indexParams)), zap.Strings("params", filled))
+		req.IndexParams = indexParams
+	}
 	hasIndex, indexBuildID := i.metaTable.HasSameReq(req)
 	if hasIndex {
 		log.Debug("IndexCoord has same index", zap.Int64("buildID", indexBuildID), zap.Int64("segmentID", req.SegmentID))
@@ -433,6 +466,14 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 			IndexBuildID: indexBuildID,
 		}, nil
 	}
+	// the client is asked to retry later if the index builder tracks too many tasks, before the meta is recorded.
+	if err := i.indexBuilder.acceptNewTasks(1); err != nil {
+		ret.Status.Reason = err.Error()
+		metrics.IndexCoordIndexRequestCounter.WithLabelValues(metrics.FailLabel).Inc()
+		return ret, nil
+	}
+	// the trace context is recorded in meta with the request.
+	req.TraceContext = trace.InjectContextToMap(sp.Context())
 
 	t := &IndexAddTask{
 		BaseTask: BaseTask{
@@ -473,7 +514,14 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 		metrics.IndexCoordIndexRequestCounter.WithLabelValues(metrics.FailLabel).Inc()
 		return ret, nil
 	}
-	i.indexBuilder.enqueue(t.indexBuildID)
+	if err := i.indexBuilder.enqueueWithPriority(t.indexBuildID, int(req.GetBuildPriority())); err != nil {
+		// the meta is recorded, the task is recovered when the tasks are reconciled with meta.
+		log.Warn("IndexCoord enqueue the index task failed", zap.Int64("IndexBuildID", t.indexBuildID), zap.Error(err))
+		ret.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ret.Status.Reason = err.Error()
+		metrics.IndexCoordIndexRequestCounter.WithLabelValues(metrics.FailLabel).Inc()
+		return ret, nil
+	}
 	sp.SetTag("IndexCoord-IndexBuildID", strconv.FormatInt(t.indexBuildID, 10))
 	ret.Status.ErrorCode = commonpb.ErrorCode_Success
 	ret.IndexBuildID = t.indexBuildID
@@ -557,9 +605,7 @@ func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReques
 		return ret, nil
 	}
 	log.Info("these buildIDs has been deleted", zap.Int64("indexID", req.IndexID), zap.Int64s("buildIDs", buildIDs))
-	for _, buildID := range buildIDs {
-		i.indexBuilder.markTaskAsDeleted(buildID)
-	}
+	i.indexBuilder.MarkIndexDeleted(req.IndexID)
 
 	defer func() {
 		go func() {
@@ -607,16 +653,499 @@ func (i *IndexCoord) RemoveIndex(ctx context.Context, req *indexpb.RemoveIndexRe
 		ret.Reason = err.Error()
 		return ret, nil
 	}
-	for _, buildID := range req.BuildIDs {
-		i.indexBuilder.markTaskAsDeleted(buildID)
-	}
+	i.indexBuilder.markTasksAsDeleted(req.GetBuildIDs())
 
 	return ret, nil
 }
 
-// GetIndexFilePaths gets the index file paths from IndexCoord.
+// ListIndexTasks lists the states of the index tasks tracked by IndexCoord ordered by build id, so that the in-flight
+// builds can be inspected without scraping the logs. The tasks can be filtered by the build ids and the IndexNode.
+func (i *IndexCoord) ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	log.Debug("IndexCoord receive ListIndexTasks", zap.Int("number of IndexBuildIds", len(req.GetIndexBuildIDs())),
+		zap.Int64("nodeID", req.GetNodeID()))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &indexpb.ListIndexTasksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    errMsg,
+			},
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ListIndexTasks")
+	defer sp.Finish()
+
+	var states map[int64]indexTaskState
+	if req.GetNodeID() != 0 {
+		tasks := i.indexBuilder.ListTasksByNode(req.GetNodeID())
+		states = make(map[int64]indexTaskState, len(tasks))
+		for _, task := range tasks {
+			states[task.BuildID] = task.State
+		}
+	} else {
+		states = i.indexBuilder.ListTasks()
+	}
+
+	buildIDs := make([]UniqueID, 0, len(states))
+	if len(req.GetIndexBuildIDs()) > 0 {
+		for _, buildID := range req.GetIndexBuildIDs() {
+			if _, ok := states[buildID]; ok {
+				buildIDs = append(buildIDs, buildID)
+			}
+		}
+	} else {
+		for buildID := range states {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	sort.Slice(buildIDs, func(x, y int) bool {
+		return buildIDs[x] < buildIDs[y]
+	})
+
+	tasks := make([]*indexpb.IndexTaskInfo, 0, len(buildIDs))
+	for _, buildID := range buildIDs {
+		tasks = append(tasks, &indexpb.IndexTaskInfo{
+			IndexBuildID: buildID,
+			State:        TaskStateNames[states[buildID]],
+		})
+	}
+	return &indexpb.ListIndexTasksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Tasks: tasks,
+	}, nil
+}
+
+// CancelIndexTask cancels the build of the specified segment index without dropping the whole index.
+func (i *IndexCoord) CancelIndexTask(ctx context.Context, req *indexpb.CancelIndexTaskRequest) (*commonpb.Status, error) {
+	buildID := req.GetIndexBuildID()
+	log.Info("IndexCoord receive CancelIndexTask", zap.Int64("buildID", buildID))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-CancelIndexTask")
+	defer sp.Finish()
+
+	if err := i.indexBuilder.CancelTask(buildID); err != nil {
+		log.Error("IndexCoord CancelIndexTask failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// CancelIndexTasksOlderThan cancels the builds of all the segment indexes enqueued before the cutoff, and returns the
+// number of the canceled builds. It is for the administrator to clear the backlog which will never be useful, every
+// canceled build is logged. It is only allowed for the root user when the authorization is enabled.
+func (i *IndexCoord) CancelIndexTasksOlderThan(ctx context.Context, req *indexpb.CancelIndexTasksOlderThanRequest) (*indexpb.CancelIndexTasksOlderThanResponse, error) {
+	cutoff := time.Unix(0, req.GetCutoffTime()*int64(time.Millisecond))
+	log.Info("IndexCoord receive CancelIndexTasksOlderThan", zap.Time("cutoff", cutoff))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &indexpb.CancelIndexTasksOlderThanResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    errMsg,
+			},
+		}, nil
+	}
+
+	if err := i.authorizeRoot(ctx); err != nil {
+		log.Warn("IndexCoord CancelIndexTasksOlderThan is not authorized", zap.Error(err))
+		return &indexpb.CancelIndexTasksOlderThanResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_PermissionDenied,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-CancelIndexTasksOlderThan")
+	defer sp.Finish()
+
+	canceled := i.indexBuilder.CancelOlderThan(cutoff)
+	return &indexpb.CancelIndexTasksOlderThanResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CanceledCount: int64(canceled),
+	}, nil
+}
+
+// ForceReassignIndexTask reassigns the unfinished build of the segment index, such as the build stuck on a
+// misbehaving IndexNode which is still alive.
+func (i *IndexCoord) ForceReassignIndexTask(ctx context.Context, req *indexpb.ForceReassignIndexTaskRequest) (*commonpb.Status, error) {
+	buildID := req.GetIndexBuildID()
+	log.Info("IndexCoord receive ForceReassignIndexTask", zap.Int64("buildID", buildID))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ForceReassignIndexTask")
+	defer sp.Finish()
+
+	if err := i.indexBuilder.ForceReassign(buildID); err != nil {
+		log.Error("IndexCoord ForceReassignIndexTask failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// SetIndexTaskPriority changes the priority of the build of the segment index which has not been assigned, such as
+// escalating a background build to be urgent. It fails unless the priority ordering policy is used.
+func (i *IndexCoord) SetIndexTaskPriority(ctx context.Context, req *indexpb.SetIndexTaskPriorityRequest) (*commonpb.Status, error) {
+	buildID, priority := req.GetIndexBuildID(), int(req.GetPriority())
+	log.Info("IndexCoord receive SetIndexTaskPriority", zap.Int64("buildID", buildID), zap.Int("priority", priority))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-SetIndexTaskPriority")
+	defer sp.Finish()
+
+	if err := i.indexBuilder.SetPriority(buildID, priority); err != nil {
+		log.Error("IndexCoord SetIndexTaskPriority failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// PauseIndexTask holds the unfinished build of the segment index without dropping it, such as during the
+// investigation of the build. The build is not reassigned until it is resumed by ResumeIndexTask.
+func (i *IndexCoord) PauseIndexTask(ctx context.Context, req *indexpb.PauseIndexTaskRequest) (*commonpb.Status, error) {
+	buildID := req.GetIndexBuildID()
+	log.Info("IndexCoord receive PauseIndexTask", zap.Int64("buildID", buildID))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-PauseIndexTask")
+	defer sp.Finish()
+
+	if err := i.indexBuilder.PauseTask(buildID); err != nil {
+		log.Error("IndexCoord PauseIndexTask failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ResumeIndexTask continues the build of the segment index paused by PauseIndexTask.
+func (i *IndexCoord) ResumeIndexTask(ctx context.Context, req *indexpb.ResumeIndexTaskRequest) (*commonpb.Status, error) {
+	buildID := req.GetIndexBuildID()
+	log.Info("IndexCoord receive ResumeIndexTask", zap.Int64("buildID", buildID))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ResumeIndexTask")
+	defer sp.Finish()
+
+	if err := i.indexBuilder.ResumeTask(buildID); err != nil {
+		log.Error("IndexCoord ResumeIndexTask failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ReconcileIndexTasks reloads the index meta and reconciles the index tasks with it, such as after the meta is edited
+// manually, without restarting IndexCoord.
+func (i *IndexCoord) ReconcileIndexTasks(ctx context.Context, req *indexpb.ReconcileIndexTasksRequest) (*commonpb.Status, error) {
+	log.Info("IndexCoord receive ReconcileIndexTasks")
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ReconcileIndexTasks")
+	defer sp.Finish()
+
+	if err := i.indexBuilder.Reconcile(); err != nil {
+		log.Error("IndexCoord ReconcileIndexTasks failed", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// PauseIndexBuilder stops assigning index tasks to IndexNodes, such as during the rolling upgrade of IndexNodes.
+// The assigned index tasks are still tracked.
+func (i *IndexCoord) PauseIndexBuilder(ctx context.Context, req *indexpb.PauseIndexBuilderRequest) (*commonpb.Status, error) {
+	log.Info("IndexCoord receive PauseIndexBuilder")
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-PauseIndexBuilder")
+	defer sp.Finish()
+
+	i.indexBuilder.Pause()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ResumeIndexBuilder continues assigning index tasks to IndexNodes.
+func (i *IndexCoord) ResumeIndexBuilder(ctx context.Context, req *indexpb.ResumeIndexBuilderRequest) (*commonpb.Status, error) {
+	log.Info("IndexCoord receive ResumeIndexBuilder")
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ResumeIndexBuilder")
+	defer sp.Finish()
+
+	i.indexBuilder.Resume()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// SetIndexNodeResourceGroup puts the IndexNode into the resource group, the index tasks of the group are only assigned
+// to the IndexNodes in the group. The IndexNode is removed from its group if the group is empty.
+func (i *IndexCoord) SetIndexNodeResourceGroup(ctx context.Context, req *indexpb.SetIndexNodeResourceGroupRequest) (*commonpb.Status, error) {
+	nodeID, group := req.GetNodeID(), req.GetResourceGroup()
+	log.Info("IndexCoord receive SetIndexNodeResourceGroup", zap.Int64("nodeID", nodeID), zap.String("group", group))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-SetIndexNodeResourceGroup")
+	defer sp.Finish()
+
+	if err := i.nodeManager.SetResourceGroup(nodeID, group); err != nil {
+		log.Error("IndexCoord SetIndexNodeResourceGroup failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// DrainIndexNode decommissions the IndexNode in a planned way, no more index tasks are assigned to it and its builds
+// are moved to other IndexNodes at most rate builds per second. Unlike the crash of the IndexNode, the builds are moved
+// gradually. It returns once the draining is started, the IndexNode holds no tasks when ListIndexTasks returns none
+// for it. The paused tasks are moved as well, but they hold the IndexNode until they are resumed.
+func (i *IndexCoord) DrainIndexNode(ctx context.Context, req *indexpb.DrainIndexNodeRequest) (*commonpb.Status, error) {
+	nodeID, rate := req.GetNodeID(), int(req.GetRate())
+	log.Info("IndexCoord receive DrainIndexNode", zap.Int64("nodeID", nodeID), zap.Int("rate", rate))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-DrainIndexNode")
+	defer sp.Finish()
+
+	if !funcutil.SliceContain(i.nodeManager.ListAllNodes(), nodeID) {
+		err := errIndexNodeIsNotOnService(nodeID)
+		log.Error("IndexCoord DrainIndexNode failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	i.loopWg.Add(1)
+	go func() {
+		defer i.loopWg.Done()
+		i.indexBuilder.DrainNode(nodeID, rate)
+	}()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ValidateIndexParams checks whether the index can be built with the params of the request, without creating the
+// index task, so that the invalid params are found before a long build starts.
+func (i *IndexCoord) ValidateIndexParams(ctx context.Context, req *indexpb.BuildIndexRequest) (*commonpb.Status, error) {
+	log.Info("IndexCoord receive ValidateIndexParams", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Any("IndexParams", req.GetIndexParams()))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ValidateIndexParams")
+	defer sp.Finish()
+
+	if err := validateIndexParams(req); err != nil {
+		log.Warn("IndexCoord ValidateIndexParams failed", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// EstimateBuildTime predicts the time to build the index of the request before it is submitted, by the durations of
+// the finished builds of the same index type and similar segment size. The estimate carries the number of builds it
+// is based on.
+func (i *IndexCoord) EstimateBuildTime(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.EstimateBuildTimeResponse, error) {
+	log.Info("IndexCoord receive EstimateBuildTime", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Int64("numRows", req.GetNumRows()), zap.Any("IndexParams", req.GetIndexParams()))
+
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &indexpb.EstimateBuildTimeResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    errMsg,
+			},
+		}, nil
+	}
+
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-EstimateBuildTime")
+	defer sp.Finish()
+
+	estimate, err := i.indexBuilder.EstimateBuildTime(req)
+	if err != nil {
+		log.Warn("IndexCoord EstimateBuildTime failed", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		return &indexpb.EstimateBuildTimeResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &indexpb.EstimateBuildTimeResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		DurationMs:  estimate.Duration.Milliseconds(),
+		SampleCount: int64(estimate.SampleCount),
+		SizeMatched: estimate.SizeMatched,
+	}, nil
+}
+
+// GetIndexFilePaths gets the index file paths from IndexCoord. The files of the shadow builds are not returned, so
+// the queries never load them.
 func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
-	log.Debug("IndexCoord GetIndexFilePaths", zap.Int("number of IndexBuildIds", len(req.IndexBuildIDs)))
+	return i.getIndexFilePaths(ctx, req, false)
+}
+
+// GetShadowIndexFilePaths gets the index file paths of the shadow builds, which are used to evaluate the new index
+// params against the active index.
+func (i *IndexCoord) GetShadowIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	return i.getIndexFilePaths(ctx, req, true)
+}
+
+// getIndexFilePaths gets the index file paths of either the shadow builds or the active builds.
+func (i *IndexCoord) getIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest, shadow bool) (*indexpb.GetIndexFilePathsResponse, error) {
+	log.Debug("IndexCoord GetIndexFilePaths", zap.Int("number of IndexBuildIds", len(req.IndexBuildIDs)),
+		zap.Bool("shadow", shadow))
 	if !i.isHealthy() {
 		errMsg := "IndexCoord is not healthy"
 		log.Warn(errMsg)
@@ -633,6 +1162,19 @@ func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetInde
 	var indexPaths []*indexpb.IndexFilePathInfo
 
 	for _, buildID := range req.IndexBuildIDs {
+		if i.metaTable.IsShadowBuild(buildID) != shadow {
+			errMsg := "index is a shadow build"
+			if shadow {
+				errMsg = "index is not a shadow build"
+			}
+			log.Warn("IndexCoord GetIndexFilePaths failed", zap.Int64("indexBuildID", buildID), zap.String("reason", errMsg))
+			return &indexpb.GetIndexFilePathsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    errMsg,
+				},
+			}, nil
+		}
 		indexPathInfo, err := i.metaTable.GetIndexFilePathInfo(buildID)
 		if err != nil {
 			log.Warn("IndexCoord GetIndexFilePaths failed", zap.Int64("indexBuildID", buildID), zap.Error(err))
@@ -719,6 +1261,42 @@ func (i *IndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReq
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.IndexTaskMetrics {
+		metrics, err := getIndexTaskMetrics(ctx, req, i)
+
+		log.Debug("IndexCoord.GetMetrics",
+			zap.Int64("node id", i.session.ServerID),
+			zap.String("req", req.Request),
+			zap.String("metric type", metricType),
+			zap.Error(err))
+
+		return metrics, nil
+	}
+
+	if metricType == metricsinfo.CompletedIndexTaskMetrics {
+		metrics, err := getCompletedIndexTaskMetrics(ctx, req, i)
+
+		log.Debug("IndexCoord.GetMetrics",
+			zap.Int64("node id", i.session.ServerID),
+			zap.String("req", req.Request),
+			zap.String("metric type", metricType),
+			zap.Error(err))
+
+		return metrics, nil
+	}
+
+	if metricType == metricsinfo.IndexBuilderDumpMetrics {
+		metrics, err := getIndexBuilderDumpMetrics(ctx, req, i)
+
+		log.Debug("IndexCoord.GetMetrics",
+			zap.Int64("node id", i.session.ServerID),
+			zap.String("req", req.Request),
+			zap.String("metric type", metricType),
+			zap.Error(err))
+
+		return metrics, nil
+	}
+
 	log.Debug("IndexCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node id", i.session.ServerID),
 		zap.String("req", req.Request),
@@ -834,7 +1412,7 @@ func (i *IndexCoord) watchMetaLoop() {
 							zap.String("path", indexFilePrefix), zap.String("etcd error", err.Error()), zap.Error(internalErr))
 						panic("failed to handle etcd request, exit..")
 					}
-					i.indexBuilder.refreshTasks(i.nodeManager.ListAllNodes())
+					i.indexBuilder.reconcileTasks(i.nodeManager.ListAllNodes())
 					i.loopWg.Add(1)
 					go i.watchMetaLoop()
 					return
@@ -872,21 +1450,27 @@ func (i *IndexCoord) watchMetaLoop() {
 	}
 }
 
+// tryAcquireSegmentReferLock acquires the reference lock of the segments for the index task. The lock is held per
+// buildID and shared between tasks, so the indexes of different fields on the same segment are built concurrently.
 func (i *IndexCoord) tryAcquireSegmentReferLock(ctx context.Context, buildID UniqueID, nodeID UniqueID, segIDs []UniqueID) error {
 	// IndexCoord use buildID instead of taskID.
 	log.Info("try to acquire segment reference lock", zap.Int64("buildID", buildID),
 		zap.Int64("ndoeID", nodeID), zap.Int64s("segIDs", segIDs))
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
 	status, err := i.dataCoordClient.AcquireSegmentLock(ctx, &datapb.AcquireSegmentLockRequest{
 		TaskID:     buildID,
 		NodeID:     nodeID,
 		SegmentIDs: segIDs,
 	})
 	if err != nil {
+		metrics.IndexCoordSegmentLockAcquireFailureCounter.WithLabelValues(lockFailureCategory(nil, err)).Inc()
 		log.Error("IndexCoord try to acquire segment reference lock failed", zap.Int64("buildID", buildID),
 			zap.Int64("nodeID", nodeID), zap.Int64s("segIDs", segIDs), zap.Error(err))
 		return err
 	}
 	if status.ErrorCode != commonpb.ErrorCode_Success {
+		metrics.IndexCoordSegmentLockAcquireFailureCounter.WithLabelValues(lockFailureCategory(status, nil)).Inc()
 		log.Error("IndexCoord try to acquire segment reference lock failed", zap.Int64("buildID", buildID),
 			zap.Int64("nodeID", nodeID), zap.Int64s("segIDs", segIDs), zap.Error(errors.New(status.Reason)))
 		return errors.New(status.Reason)
@@ -896,22 +1480,19 @@ func (i *IndexCoord) tryAcquireSegmentReferLock(ctx context.Context, buildID Uni
 	return nil
 }
 
+// collectionIndexReady is called by the index builder when all the index tasks of the collection are finished.
+func (i *IndexCoord) collectionIndexReady(collectionID UniqueID) {
+	log.Info("IndexCoord the index of the collection is ready", zap.Int64("collectionID", collectionID))
+	metrics.IndexCoordCollectionIndexReadyCounter.WithLabelValues().Inc()
+}
+
 func (i *IndexCoord) tryReleaseSegmentReferLock(ctx context.Context, buildID UniqueID, nodeID UniqueID) error {
 	releaseLock := func() error {
-		status, err := i.dataCoordClient.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{
-			TaskID: buildID,
-			NodeID: nodeID,
-		})
-		if err != nil {
-			return err
-		}
-		if status.ErrorCode != commonpb.ErrorCode_Success {
-			return errors.New(status.Reason)
-		}
-		return nil
+		return i.releaseSegmentReferLock(ctx, buildID, nodeID)
 	}
 	err := retry.Do(ctx, releaseLock, retry.Attempts(100))
 	if err != nil {
+		metrics.IndexCoordSegmentLockReleaseFailureCounter.WithLabelValues(lockFailureCategory(nil, err)).Inc()
 		log.Error("IndexCoord try to release segment reference lock failed", zap.Int64("buildID", buildID),
 			zap.Int64("nodeID", nodeID), zap.Error(err))
 		return err
@@ -919,10 +1500,114 @@ func (i *IndexCoord) tryReleaseSegmentReferLock(ctx context.Context, buildID Uni
 	return nil
 }
 
+// releaseSegmentReferLock makes a single attempt to release the segment reference lock, the attempt has its own
+// timeout. The callers retrying with their own backoff use it instead of tryReleaseSegmentReferLock.
+func (i *IndexCoord) releaseSegmentReferLock(ctx context.Context, buildID UniqueID, nodeID UniqueID) error {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
+	status, err := i.dataCoordClient.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{
+		TaskID: buildID,
+		NodeID: nodeID,
+	})
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return nil
+}
+
+// getSegmentState returns the state of the segment in DataCoord, including whether the segment is being compacted and
+// the segment which it is compacted into.
+func (i *IndexCoord) getSegmentState(ctx context.Context, segmentID UniqueID) (*datapb.SegmentStateInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
+	resp, err := i.dataCoordClient.GetSegmentStates(ctx, &datapb.GetSegmentStatesRequest{
+		SegmentIDs: []UniqueID{segmentID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	for _, state := range resp.GetStates() {
+		if state.GetSegmentID() == segmentID {
+			return state, nil
+		}
+	}
+	return nil, errors.New("segment state is not returned by DataCoord")
+}
+
+// isSegmentDropped returns whether the segment has been dropped or removed by DataCoord, the segment compacted into
+// a new segment is dropped.
+func isSegmentDropped(state *datapb.SegmentStateInfo) bool {
+	return state.GetState() == commonpb.SegmentState_Dropped || state.GetState() == commonpb.SegmentState_NotExist
+}
+
+// getSegmentDataPaths returns the binlog paths of the field and the number of rows of the segment.
+func (i *IndexCoord) getSegmentDataPaths(ctx context.Context, segmentID UniqueID, fieldID UniqueID) ([]string, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
+	resp, err := i.dataCoordClient.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		SegmentIDs: []UniqueID{segmentID},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, 0, errors.New(resp.GetStatus().GetReason())
+	}
+	for _, info := range resp.GetInfos() {
+		if info.GetID() != segmentID {
+			continue
+		}
+		dataPaths := make([]string, 0)
+		for _, fieldBinlog := range info.GetBinlogs() {
+			if fieldBinlog.GetFieldID() == fieldID {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					dataPaths = append(dataPaths, binlog.GetLogPath())
+				}
+				break
+			}
+		}
+		return dataPaths, info.GetNumOfRows(), nil
+	}
+	return nil, 0, errors.New("segment info is not returned by DataCoord")
+}
+
+// getDeletedRows returns the number of rows deleted from each segment, the segments not returned by DataCoord are
+// omitted.
+func (i *IndexCoord) getDeletedRows(ctx context.Context, segmentIDs []UniqueID) (map[UniqueID]int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
+	resp, err := i.dataCoordClient.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		SegmentIDs: segmentIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	deletedRows := make(map[UniqueID]int64, len(resp.GetInfos()))
+	for _, info := range resp.GetInfos() {
+		var rows int64
+		for _, fieldBinlog := range info.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				rows += binlog.GetEntriesNum()
+			}
+		}
+		deletedRows[info.GetID()] = rows
+	}
+	return deletedRows, nil
+}
+
 // assignTask sends the index task to the IndexNode, it has a timeout interval, if the IndexNode doesn't respond within
-// the interval, it is considered that the task sending failed.
-func (i *IndexCoord) assignTask(builderClient types.IndexNode, req *indexpb.CreateIndexRequest) error {
-	ctx, cancel := context.WithTimeout(i.loopCtx, i.reqTimeoutInterval)
+// the interval or ctx is done, it is considered that the task sending failed.
+func (i *IndexCoord) assignTask(ctx context.Context, builderClient types.IndexNode, req *indexpb.CreateIndexRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
 	defer cancel()
 	resp, err := builderClient.CreateIndex(ctx, req)
 	if err != nil {
@@ -936,3 +1621,21 @@ func (i *IndexCoord) assignTask(builderClient types.IndexNode, req *indexpb.Crea
 	}
 	return nil
 }
+
+// cancelBuild asks the IndexNode to stop the build of the index task, it has a timeout interval like assignTask.
+func (i *IndexCoord) cancelBuild(ctx context.Context, builderClient types.IndexNode, buildID UniqueID) error {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
+	resp, err := builderClient.CancelIndex(ctx, &indexpb.CancelIndexRequest{IndexBuildID: buildID})
+	if err != nil {
+		log.Error("IndexCoord builderClient.CancelIndex failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+
+	if resp.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("IndexCoord builderClient.CancelIndex failed", zap.Int64("buildID", buildID),
+			zap.String("Reason", resp.Reason))
+		return errors.New(resp.Reason)
+	}
+	return nil
+}
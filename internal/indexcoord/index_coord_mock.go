@@ -266,11 +266,203 @@ func (icm *Mock) RemoveIndex(ctx context.Context, request *indexpb.RemoveIndexRe
 	}, nil
 }
 
+func (icm *Mock) GetShadowIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	if icm.Failure {
+		return &indexpb.GetIndexFilePathsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinator GetShadowIndexFilePaths failed")
+	}
+	return &indexpb.GetIndexFilePathsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (icm *Mock) ValidateIndexParams(ctx context.Context, req *indexpb.BuildIndexRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator ValidateIndexParams failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) EstimateBuildTime(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.EstimateBuildTimeResponse, error) {
+	if icm.Failure {
+		return &indexpb.EstimateBuildTimeResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinator EstimateBuildTime failed")
+	}
+	return &indexpb.EstimateBuildTimeResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (icm *Mock) ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	if icm.Failure {
+		return &indexpb.ListIndexTasksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinator ListIndexTasks failed")
+	}
+	return &indexpb.ListIndexTasksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (icm *Mock) CancelIndexTask(ctx context.Context, req *indexpb.CancelIndexTaskRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator CancelIndexTask failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) CancelIndexTasksOlderThan(ctx context.Context, req *indexpb.CancelIndexTasksOlderThanRequest) (*indexpb.CancelIndexTasksOlderThanResponse, error) {
+	if icm.Failure {
+		return &indexpb.CancelIndexTasksOlderThanResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinator CancelIndexTasksOlderThan failed")
+	}
+	return &indexpb.CancelIndexTasksOlderThanResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (icm *Mock) ForceReassignIndexTask(ctx context.Context, req *indexpb.ForceReassignIndexTaskRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator ForceReassignIndexTask failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) SetIndexTaskPriority(ctx context.Context, req *indexpb.SetIndexTaskPriorityRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator SetIndexTaskPriority failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) PauseIndexTask(ctx context.Context, req *indexpb.PauseIndexTaskRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator PauseIndexTask failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) ResumeIndexTask(ctx context.Context, req *indexpb.ResumeIndexTaskRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator ResumeIndexTask failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) ReconcileIndexTasks(ctx context.Context, req *indexpb.ReconcileIndexTasksRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator ReconcileIndexTasks failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) PauseIndexBuilder(ctx context.Context, req *indexpb.PauseIndexBuilderRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator PauseIndexBuilder failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) ResumeIndexBuilder(ctx context.Context, req *indexpb.ResumeIndexBuilderRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator ResumeIndexBuilder failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) SetIndexNodeResourceGroup(ctx context.Context, req *indexpb.SetIndexNodeResourceGroupRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator SetIndexNodeResourceGroup failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (icm *Mock) DrainIndexNode(ctx context.Context, req *indexpb.DrainIndexNodeRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinator DrainIndexNode failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 type DataCoordMock struct {
 	types.DataCoord
 
 	Fail bool
 	Err  bool
+
+	// SegmentStates are the states returned by GetSegmentStates, the segments not in it are flushed.
+	SegmentStates map[UniqueID]commonpb.SegmentState
+	// CompactingSegments are the segments being compacted returned by GetSegmentStates.
+	CompactingSegments map[UniqueID]struct{}
+	// CompactedTo are the segments which the dropped segments are compacted into returned by GetSegmentStates.
+	CompactedTo map[UniqueID]UniqueID
+	// AcquireLockFail fails AcquireSegmentLock only.
+	AcquireLockFail bool
+	// SegmentInfos are the infos returned by GetSegmentInfo, the segments not in it are not returned.
+	SegmentInfos map[UniqueID]*datapb.SegmentInfo
 }
 
 func (dcm *DataCoordMock) Init() error {
@@ -321,6 +513,45 @@ func (dcm *DataCoordMock) GetComponentStates(ctx context.Context) (*internalpb.C
 	}, nil
 }
 
+func (dcm *DataCoordMock) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+	resp := &datapb.GetSegmentStatesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}
+	for _, segmentID := range req.GetSegmentIDs() {
+		state, ok := dcm.SegmentStates[segmentID]
+		if !ok {
+			state = commonpb.SegmentState_Flushed
+		}
+		_, compacting := dcm.CompactingSegments[segmentID]
+		resp.States = append(resp.States, &datapb.SegmentStateInfo{
+			SegmentID:   segmentID,
+			State:       state,
+			Compacting:  compacting,
+			CompactedTo: dcm.CompactedTo[segmentID],
+		})
+	}
+	return resp, nil
+}
+
+func (dcm *DataCoordMock) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	if dcm.Err {
+		return nil, errors.New("an error occurred")
+	}
+	resp := &datapb.GetSegmentInfoResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}
+	for _, segmentID := range req.GetSegmentIDs() {
+		if info, ok := dcm.SegmentInfos[segmentID]; ok {
+			resp.Infos = append(resp.Infos, info)
+		}
+	}
+	return resp, nil
+}
+
 func (dcm *DataCoordMock) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
 	if dcm.Err {
 		return &commonpb.Status{
@@ -328,7 +559,7 @@ func (dcm *DataCoordMock) AcquireSegmentLock(ctx context.Context, req *datapb.Ac
 			Reason:    "",
 		}, errors.New("an error occurred")
 	}
-	if dcm.Fail {
+	if dcm.Fail || dcm.AcquireLockFail {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
 			Reason:    "failure reason",
@@ -391,17 +622,44 @@ func (cmm *ChunkManagerMock) RemoveWithPrefix(prefix string) error {
 type mockETCDKV struct {
 	kv.MetaKv
 
+	load                        func(string) (string, error)
+	save                        func(string, string) error
 	remove                      func(string) error
+	loadWithPrefix              func(string) ([]string, []string, error)
 	watchWithRevision           func(string, int64) clientv3.WatchChan
 	loadWithRevisionAndVersions func(string) ([]string, []string, []int64, int64, error)
 	compareVersionAndSwap       func(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error)
 	loadWithPrefix2             func(key string) ([]string, []string, []int64, error)
 }
 
+func (mk *mockETCDKV) Load(key string) (string, error) {
+	if mk.load == nil {
+		return "", errors.New("key not found")
+	}
+	return mk.load(key)
+}
+
+func (mk *mockETCDKV) Save(key string, value string) error {
+	if mk.save == nil {
+		return nil
+	}
+	return mk.save(key, value)
+}
+
 func (mk *mockETCDKV) Remove(key string) error {
+	if mk.remove == nil {
+		return nil
+	}
 	return mk.remove(key)
 }
 
+func (mk *mockETCDKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	if mk.loadWithPrefix == nil {
+		return []string{}, []string{}, nil
+	}
+	return mk.loadWithPrefix(key)
+}
+
 func (mk *mockETCDKV) LoadWithRevisionAndVersions(prefix string) ([]string, []string, []int64, int64, error) {
 	return mk.loadWithRevisionAndVersions(prefix)
 }
@@ -126,6 +126,96 @@ func TestIndexCoordMock(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
 	})
 
+	t.Run("GetShadowIndexFilePaths", func(t *testing.T) {
+		status, err := icm.GetShadowIndexFilePaths(ctx, &indexpb.GetIndexFilePathsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ValidateIndexParams", func(t *testing.T) {
+		status, err := icm.ValidateIndexParams(ctx, &indexpb.BuildIndexRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("EstimateBuildTime", func(t *testing.T) {
+		status, err := icm.EstimateBuildTime(ctx, &indexpb.BuildIndexRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ListIndexTasks", func(t *testing.T) {
+		status, err := icm.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("CancelIndexTask", func(t *testing.T) {
+		status, err := icm.CancelIndexTask(ctx, &indexpb.CancelIndexTaskRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("CancelIndexTasksOlderThan", func(t *testing.T) {
+		status, err := icm.CancelIndexTasksOlderThan(ctx, &indexpb.CancelIndexTasksOlderThanRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ForceReassignIndexTask", func(t *testing.T) {
+		status, err := icm.ForceReassignIndexTask(ctx, &indexpb.ForceReassignIndexTaskRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("SetIndexTaskPriority", func(t *testing.T) {
+		status, err := icm.SetIndexTaskPriority(ctx, &indexpb.SetIndexTaskPriorityRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("PauseIndexTask", func(t *testing.T) {
+		status, err := icm.PauseIndexTask(ctx, &indexpb.PauseIndexTaskRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("ResumeIndexTask", func(t *testing.T) {
+		status, err := icm.ResumeIndexTask(ctx, &indexpb.ResumeIndexTaskRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("ReconcileIndexTasks", func(t *testing.T) {
+		status, err := icm.ReconcileIndexTasks(ctx, &indexpb.ReconcileIndexTasksRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("PauseIndexBuilder", func(t *testing.T) {
+		status, err := icm.PauseIndexBuilder(ctx, &indexpb.PauseIndexBuilderRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("ResumeIndexBuilder", func(t *testing.T) {
+		status, err := icm.ResumeIndexBuilder(ctx, &indexpb.ResumeIndexBuilderRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("SetIndexNodeResourceGroup", func(t *testing.T) {
+		status, err := icm.SetIndexNodeResourceGroup(ctx, &indexpb.SetIndexNodeResourceGroupRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("DrainIndexNode", func(t *testing.T) {
+		status, err := icm.DrainIndexNode(ctx, &indexpb.DrainIndexNodeRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
 	err = icm.Stop()
 	assert.Nil(t, err)
 }
@@ -218,6 +308,96 @@ func TestIndexCoordMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
 	})
 
+	t.Run("GetShadowIndexFilePaths", func(t *testing.T) {
+		status, err := icm.GetShadowIndexFilePaths(ctx, &indexpb.GetIndexFilePathsRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ValidateIndexParams", func(t *testing.T) {
+		status, err := icm.ValidateIndexParams(ctx, &indexpb.BuildIndexRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("EstimateBuildTime", func(t *testing.T) {
+		status, err := icm.EstimateBuildTime(ctx, &indexpb.BuildIndexRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ListIndexTasks", func(t *testing.T) {
+		status, err := icm.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("CancelIndexTask", func(t *testing.T) {
+		status, err := icm.CancelIndexTask(ctx, &indexpb.CancelIndexTaskRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("CancelIndexTasksOlderThan", func(t *testing.T) {
+		status, err := icm.CancelIndexTasksOlderThan(ctx, &indexpb.CancelIndexTasksOlderThanRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ForceReassignIndexTask", func(t *testing.T) {
+		status, err := icm.ForceReassignIndexTask(ctx, &indexpb.ForceReassignIndexTaskRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("SetIndexTaskPriority", func(t *testing.T) {
+		status, err := icm.SetIndexTaskPriority(ctx, &indexpb.SetIndexTaskPriorityRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("PauseIndexTask", func(t *testing.T) {
+		status, err := icm.PauseIndexTask(ctx, &indexpb.PauseIndexTaskRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("ResumeIndexTask", func(t *testing.T) {
+		status, err := icm.ResumeIndexTask(ctx, &indexpb.ResumeIndexTaskRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("ReconcileIndexTasks", func(t *testing.T) {
+		status, err := icm.ReconcileIndexTasks(ctx, &indexpb.ReconcileIndexTasksRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("PauseIndexBuilder", func(t *testing.T) {
+		status, err := icm.PauseIndexBuilder(ctx, &indexpb.PauseIndexBuilderRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("ResumeIndexBuilder", func(t *testing.T) {
+		status, err := icm.ResumeIndexBuilder(ctx, &indexpb.ResumeIndexBuilderRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("SetIndexNodeResourceGroup", func(t *testing.T) {
+		status, err := icm.SetIndexNodeResourceGroup(ctx, &indexpb.SetIndexNodeResourceGroupRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("DrainIndexNode", func(t *testing.T) {
+		status, err := icm.DrainIndexNode(ctx, &indexpb.DrainIndexNodeRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
 	err = icm.Stop()
 	assert.NotNil(t, err)
 }
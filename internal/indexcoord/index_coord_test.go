@@ -18,31 +18,41 @@ package indexcoord
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
 	"os/signal"
+	"path"
 	"sync"
 	"syscall"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus/internal/types"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus/internal/common"
 	grpcindexnode "github.com/milvus-io/milvus/internal/distributed/indexnode"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
@@ -185,6 +195,13 @@ func TestIndexCoord(t *testing.T) {
 		resp3, err := ic.BuildIndex(ctx, req2)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp3.Status.ErrorCode)
+
+		// the scheduling options are carried by the fields of the request only.
+		req3 := proto.Clone(req2).(*indexpb.BuildIndexRequest)
+		req3.IndexParams = append(req3.IndexParams, &commonpb.KeyValuePair{Key: "shadow_build", Value: "true"})
+		resp4, err := ic.BuildIndex(ctx, req3)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp4.Status.ErrorCode)
 	})
 
 	t.Run("Get Index State", func(t *testing.T) {
@@ -429,6 +446,14 @@ func TestIndexCoord_GetComponentStates(t *testing.T) {
 	resp, err = n.GetComponentStates(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	// the index scheduler is not running.
+	n.indexBuilder = &indexBuilder{}
+	resp, err = n.GetComponentStates(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.StateCode_Healthy, resp.State.StateCode)
+	assert.Equal(t, 1, len(resp.SubcomponentStates))
+	assert.Equal(t, internalpb.StateCode_Abnormal, resp.SubcomponentStates[0].StateCode)
 }
 
 func TestIndexCoord_NotHealthy(t *testing.T) {
@@ -460,6 +485,163 @@ func TestIndexCoord_NotHealthy(t *testing.T) {
 	resp5, err := ic.RemoveIndex(context.Background(), req5)
 	assert.Nil(t, err)
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp5.GetErrorCode())
+
+	resp6, err := ic.ValidateIndexParams(context.Background(), &indexpb.BuildIndexRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp6.GetErrorCode())
+
+	resp7, err := ic.PauseIndexBuilder(context.Background(), &indexpb.PauseIndexBuilderRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp7.GetErrorCode())
+
+	resp8, err := ic.ResumeIndexBuilder(context.Background(), &indexpb.ResumeIndexBuilderRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp8.GetErrorCode())
+
+	resp9, err := ic.SetIndexNodeResourceGroup(context.Background(), &indexpb.SetIndexNodeResourceGroupRequest{
+		NodeID:        1,
+		ResourceGroup: "group",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp9.GetErrorCode())
+
+	resp10, err := ic.ForceReassignIndexTask(context.Background(), &indexpb.ForceReassignIndexTaskRequest{IndexBuildID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp10.GetErrorCode())
+
+	resp11, err := ic.PauseIndexTask(context.Background(), &indexpb.PauseIndexTaskRequest{IndexBuildID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp11.GetErrorCode())
+
+	resp12, err := ic.ResumeIndexTask(context.Background(), &indexpb.ResumeIndexTaskRequest{IndexBuildID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp12.GetErrorCode())
+
+	resp13, err := ic.DrainIndexNode(context.Background(), &indexpb.DrainIndexNodeRequest{NodeID: 1, Rate: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp13.GetErrorCode())
+
+	resp14, err := ic.SetIndexTaskPriority(context.Background(), &indexpb.SetIndexTaskPriorityRequest{
+		IndexBuildID: 1,
+		Priority:     userTaskPriority,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp14.GetErrorCode())
+
+	resp15, err := ic.ReconcileIndexTasks(context.Background(), &indexpb.ReconcileIndexTasksRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp15.GetErrorCode())
+
+	resp16, err := ic.EstimateBuildTime(context.Background(), &indexpb.BuildIndexRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp16.GetStatus().GetErrorCode())
+
+	resp17, err := ic.CancelIndexTasksOlderThan(context.Background(), &indexpb.CancelIndexTasksOlderThanRequest{
+		CutoffTime: time.Now().UnixNano() / int64(time.Millisecond),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp17.GetStatus().GetErrorCode())
+
+	resp18, err := ic.CancelIndexTask(context.Background(), &indexpb.CancelIndexTaskRequest{IndexBuildID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp18.GetErrorCode())
+
+	resp19, err := ic.ListIndexTasks(context.Background(), &indexpb.ListIndexTasksRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp19.GetStatus().GetErrorCode())
+}
+
+func TestIndexCoord_CancelIndexTasksOlderThan(t *testing.T) {
+	Params.Init()
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = false
+	}()
+
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	mt := createMetaTable()
+	encryptedPassword, err := crypto.PasswordEncrypt("password")
+	assert.NoError(t, err)
+	mt.client.(*mockETCDKV).load = func(key string) (string, error) {
+		if key != path.Join(credentialPrefix, util.UserRoot) {
+			return "", errors.New("key not found")
+		}
+		credInfo, err := json.Marshal(&internalpb.CredentialInfo{EncryptedPassword: encryptedPassword})
+		return string(credInfo), err
+	}
+	ic.metaTable = mt
+	ic.indexBuilder = newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+	ic.stateCode.Store(internalpb.StateCode_Healthy)
+
+	req := &indexpb.CancelIndexTasksOlderThanRequest{
+		CutoffTime: time.Now().Add(time.Hour).UnixNano() / int64(time.Millisecond),
+	}
+	withToken := func(username, password string) context.Context {
+		token := crypto.Base64Encode(username + util.CredentialSeperator + password)
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, token))
+	}
+
+	t.Run("no credential", func(t *testing.T) {
+		resp, err := ic.CancelIndexTasksOlderThan(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("not root", func(t *testing.T) {
+		resp, err := ic.CancelIndexTasksOlderThan(withToken("user", "password"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		resp, err := ic.CancelIndexTasksOlderThan(withToken(util.UserRoot, "wrong"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.GetStatus().GetErrorCode())
+		assert.NotEqual(t, 0, len(ic.indexBuilder.GetBuildIDsInState(indexTaskInit)))
+	})
+
+	t.Run("root", func(t *testing.T) {
+		resp, err := ic.CancelIndexTasksOlderThan(withToken(util.UserRoot, "password"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		// task 2, 3, 4 and 5 are canceled.
+		assert.Equal(t, int64(4), resp.GetCanceledCount())
+		assert.Equal(t, 0, len(ic.indexBuilder.GetBuildIDsInState(indexTaskInit)))
+	})
+}
+
+func TestIndexCoord_ListIndexTasks(t *testing.T) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx)
+	ic.indexBuilder = newIndexBuilder(ctx, ic, createMetaTable(), []UniqueID{1, 2})
+	ic.stateCode.Store(internalpb.StateCode_Healthy)
+
+	t.Run("filter by build ids", func(t *testing.T) {
+		resp, err := ic.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{IndexBuildIDs: []UniqueID{4, 2, 100}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []*indexpb.IndexTaskInfo{
+			{IndexBuildID: 2, State: "Init"},
+			{IndexBuildID: 4, State: "InProgress"},
+		}, resp.GetTasks())
+	})
+
+	t.Run("filter by node", func(t *testing.T) {
+		resp, err := ic.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{IndexBuildIDs: []UniqueID{2, 4}, NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []*indexpb.IndexTaskInfo{
+			{IndexBuildID: 4, State: "InProgress"},
+		}, resp.GetTasks())
+	})
+
+	t.Run("all", func(t *testing.T) {
+		resp, err := ic.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, len(ic.indexBuilder.ListTasks()), len(resp.GetTasks()))
+	})
 }
 
 func TestIndexCoord_GetIndexFilePaths(t *testing.T) {
@@ -479,6 +661,16 @@ func TestIndexCoord_GetIndexFilePaths(t *testing.T) {
 						State:        commonpb.IndexState_Failed,
 					},
 				},
+				3: {
+					indexMeta: &indexpb.IndexMeta{
+						IndexBuildID:   3,
+						State:          commonpb.IndexState_Finished,
+						IndexFilePaths: []string{"indexFiles-3"},
+						Req: &indexpb.BuildIndexRequest{
+							ShadowBuild: true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -500,6 +692,22 @@ func TestIndexCoord_GetIndexFilePaths(t *testing.T) {
 		assert.Equal(t, 0, len(resp.FilePaths[0].IndexFilePaths))
 	})
 
+	t.Run("shadow build", func(t *testing.T) {
+		// the queries never get the files of the shadow build.
+		resp, err := ic.GetIndexFilePaths(context.Background(), &indexpb.GetIndexFilePathsRequest{IndexBuildIDs: []UniqueID{3}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+
+		resp, err = ic.GetShadowIndexFilePaths(context.Background(), &indexpb.GetIndexFilePathsRequest{IndexBuildIDs: []UniqueID{3}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, []string{"indexFiles-3"}, resp.FilePaths[0].IndexFilePaths)
+
+		resp, err = ic.GetShadowIndexFilePaths(context.Background(), &indexpb.GetIndexFilePathsRequest{IndexBuildIDs: []UniqueID{1}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	t.Run("set DataCoord with nil", func(t *testing.T) {
 		err := ic.SetDataCoord(nil)
 		assert.Error(t, err)
@@ -545,8 +753,11 @@ func Test_tryAcquireSegmentReferLock(t *testing.T) {
 			Fail: true,
 		}
 		ic.dataCoordClient = dcmF
+		counter := metrics.IndexCoordSegmentLockAcquireFailureCounter.WithLabelValues(metrics.UnavailableErrorLabel)
+		failures := testutil.ToFloat64(counter)
 		err := ic.tryAcquireSegmentReferLock(context.Background(), 1, 1, []UniqueID{1})
 		assert.Error(t, err)
+		assert.Equal(t, failures+1, testutil.ToFloat64(counter))
 	})
 }
 
@@ -567,13 +778,31 @@ func Test_tryReleaseSegmentReferLock(t *testing.T) {
 		err := ic.tryReleaseSegmentReferLock(context.Background(), 1, 1)
 		assert.NoError(t, err)
 	})
+
+	t.Run("Fail", func(t *testing.T) {
+		ic.dataCoordClient = &DataCoordMock{
+			Err:  false,
+			Fail: true,
+		}
+		counter := metrics.IndexCoordSegmentLockReleaseFailureCounter.WithLabelValues(metrics.UnavailableErrorLabel)
+		failures := testutil.ToFloat64(counter)
+		// the release is not retried with the canceled context.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := ic.tryReleaseSegmentReferLock(ctx, 1, 1)
+		assert.Error(t, err)
+		assert.Equal(t, failures+1, testutil.ToFloat64(counter))
+	})
 }
 
 func TestIndexCoord_RemoveIndex(t *testing.T) {
+	mt := &metaTable{}
 	ic := &IndexCoord{
-		metaTable: &metaTable{},
+		metaTable: mt,
 		indexBuilder: &indexBuilder{
+			tasks:      newTaskQueue(NewOrderingPolicy(PriorityOrderingPolicy)),
 			notifyChan: make(chan struct{}, 10),
+			meta:       mt,
 		},
 	}
 	ic.stateCode.Store(internalpb.StateCode_Healthy)
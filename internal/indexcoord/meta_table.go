@@ -18,11 +18,13 @@ package indexcoord
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/kv"
 
@@ -45,10 +47,30 @@ type Meta struct {
 	etcdVersion int64
 }
 
+// taskRetryMeta records the retry history of an index task, so that the retry limit and the backoff still work
+// after IndexCoord restarts.
+type taskRetryMeta struct {
+	BuildID    UniqueID      `json:"build_id"`
+	RetryCount int           `json:"retry_count"`
+	FailReason string        `json:"fail_reason"`
+	RetryDelay time.Duration `json:"retry_delay"`
+}
+
+// orphanedLockMeta records the segment reference lock of a finished index task which IndexCoord failed to release,
+// the lock needs to be released manually.
+type orphanedLockMeta struct {
+	BuildID    UniqueID `json:"build_id"`
+	NodeID     UniqueID `json:"node_id"`
+	SegmentID  UniqueID `json:"segment_id"`
+	FailReason string   `json:"fail_reason"`
+	Timestamp  int64    `json:"timestamp"` // unix time in seconds when the lock is orphaned
+}
+
 // metaTable records the mapping of IndexBuildID to Meta.
 type metaTable struct {
-	client            kv.MetaKv          // client of a reliable kv service, i.e. etcd client
-	indexBuildID2Meta map[UniqueID]*Meta // index build id to index meta
+	client            kv.MetaKv                   // client of a reliable kv service, i.e. etcd client
+	indexBuildID2Meta map[UniqueID]*Meta          // index build id to index meta
+	retryMetas        map[UniqueID]*taskRetryMeta // index build id to retry meta of the index task
 
 	etcdRevision int64
 
@@ -69,11 +91,12 @@ func NewMetaTable(kv kv.MetaKv) (*metaTable, error) {
 	return mt, nil
 }
 
-// reloadFromKV reloads the index meta from ETCD.
+// reloadFromKV reloads the index meta from ETCD. The metas are replaced only if all of them are loaded, so the meta
+// table is kept as it is if the reload fails.
 func (mt *metaTable) reloadFromKV() error {
 	mt.lock.Lock()
 	defer mt.lock.Unlock()
-	mt.indexBuildID2Meta = make(map[UniqueID]*Meta)
+	indexBuildID2Meta := make(map[UniqueID]*Meta)
 	key := indexFilePrefix
 	log.Debug("IndexCoord metaTable LoadWithPrefix ", zap.String("prefix", key))
 
@@ -82,8 +105,6 @@ func (mt *metaTable) reloadFromKV() error {
 		return err
 	}
 
-	mt.etcdRevision = revision
-
 	for i := 0; i < len(values); i++ {
 		indexMeta := indexpb.IndexMeta{}
 		err = proto.Unmarshal([]byte(values[i]), &indexMeta)
@@ -95,8 +116,24 @@ func (mt *metaTable) reloadFromKV() error {
 			indexMeta:   &indexMeta,
 			etcdVersion: versions[i],
 		}
-		mt.indexBuildID2Meta[indexMeta.IndexBuildID] = meta
+		indexBuildID2Meta[indexMeta.IndexBuildID] = meta
+	}
+
+	retryMetas := make(map[UniqueID]*taskRetryMeta)
+	_, values, err = mt.client.LoadWithPrefix(taskRetryMetaPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		retryMeta := &taskRetryMeta{}
+		if err = json.Unmarshal([]byte(value), retryMeta); err != nil {
+			return fmt.Errorf("IndexCoord metaTable reloadFromKV unmarshal task retry meta err:%w", err)
+		}
+		retryMetas[retryMeta.BuildID] = retryMeta
 	}
+	mt.indexBuildID2Meta = indexBuildID2Meta
+	mt.retryMetas = retryMetas
+	mt.etcdRevision = revision
 	return nil
 }
 
@@ -259,6 +296,151 @@ func (mt *metaTable) ResetMeta(buildID UniqueID) error {
 	return nil
 }
 
+// MarkIndexAsFailed marks the index as failed with the fail reason, the index will not be built any more.
+func (mt *metaTable) MarkIndexAsFailed(buildID UniqueID, failReason string) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	log.Info("IndexCoord metaTable MarkIndexAsFailed", zap.Int64("buildID", buildID), zap.String("failReason", failReason))
+	updateFunc := func(m *Meta) error {
+		state := m.indexMeta.State
+		m.indexMeta.NodeID = 0
+		m.indexMeta.State = commonpb.IndexState_Failed
+		m.indexMeta.FailReason = failReason
+		if err := mt.saveIndexMeta(m); err != nil {
+			return err
+		}
+		switch state {
+		case commonpb.IndexState_Unissued:
+			metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.UnissuedIndexTaskLabel).Dec()
+		case commonpb.IndexState_InProgress:
+			metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.InProgressIndexTaskLabel).Dec()
+		}
+		metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.FailedIndexTaskLabel).Inc()
+		return nil
+	}
+
+	if err := mt.updateMeta(buildID, updateFunc); err != nil {
+		log.Error("IndexCoord metaTable MarkIndexAsFailed fail", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+	log.Info("mark index meta as failed success", zap.Int64("buildID", buildID))
+	return nil
+}
+
+// MarkIndexAsCanceled marks the index as failed because it is canceled. The index version is updated, so the IndexNode
+// which is building the index will abandon the task. The nodeID is kept, the caller should release the segment reference
+// lock and reset the nodeID.
+func (mt *metaTable) MarkIndexAsCanceled(buildID UniqueID) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	log.Info("IndexCoord metaTable MarkIndexAsCanceled", zap.Int64("buildID", buildID))
+	updateFunc := func(m *Meta) error {
+		state := m.indexMeta.State
+		if state == commonpb.IndexState_Finished || state == commonpb.IndexState_Failed {
+			return fmt.Errorf("index %d has been %s, can not be canceled", buildID, state.String())
+		}
+		m.indexMeta.IndexVersion++
+		m.indexMeta.State = commonpb.IndexState_Failed
+		m.indexMeta.FailReason = canceledFailReason
+		if err := mt.saveIndexMeta(m); err != nil {
+			return err
+		}
+		switch state {
+		case commonpb.IndexState_Unissued:
+			metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.UnissuedIndexTaskLabel).Dec()
+		case commonpb.IndexState_InProgress:
+			metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.InProgressIndexTaskLabel).Dec()
+		}
+		metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.FailedIndexTaskLabel).Inc()
+		return nil
+	}
+
+	if err := mt.updateMeta(buildID, updateFunc); err != nil {
+		log.Error("IndexCoord metaTable MarkIndexAsCanceled fail", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+	log.Info("mark index meta as canceled success", zap.Int64("buildID", buildID))
+	return nil
+}
+
+// SaveTaskRetryMeta saves the retry meta of the index task.
+func (mt *metaTable) SaveTaskRetryMeta(retryMeta *taskRetryMeta) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	value, err := json.Marshal(retryMeta)
+	if err != nil {
+		return err
+	}
+	key := path.Join(taskRetryMetaPrefix, strconv.FormatInt(retryMeta.BuildID, 10))
+	if err := mt.client.Save(key, string(value)); err != nil {
+		log.Warn("failed to save task retry meta in etcd", zap.Int64("buildID", retryMeta.BuildID), zap.Error(err))
+		return err
+	}
+	if mt.retryMetas == nil {
+		mt.retryMetas = make(map[UniqueID]*taskRetryMeta)
+	}
+	clonedMeta := *retryMeta
+	mt.retryMetas[retryMeta.BuildID] = &clonedMeta
+	return nil
+}
+
+// GetTaskRetryMeta gets the retry meta of the index task.
+func (mt *metaTable) GetTaskRetryMeta(buildID UniqueID) (*taskRetryMeta, bool) {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	retryMeta, ok := mt.retryMetas[buildID]
+	if !ok {
+		return nil, false
+	}
+	clonedMeta := *retryMeta
+	return &clonedMeta, true
+}
+
+// GetAllTaskRetryMetaBuildIDs returns the build ids of all the tasks which have retry meta.
+func (mt *metaTable) GetAllTaskRetryMetaBuildIDs() []UniqueID {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	buildIDs := make([]UniqueID, 0, len(mt.retryMetas))
+	for buildID := range mt.retryMetas {
+		buildIDs = append(buildIDs, buildID)
+	}
+	return buildIDs
+}
+
+// RemoveTaskRetryMeta removes the retry meta of the index task if it exists.
+func (mt *metaTable) RemoveTaskRetryMeta(buildID UniqueID) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	if _, ok := mt.retryMetas[buildID]; !ok {
+		return nil
+	}
+	key := path.Join(taskRetryMetaPrefix, strconv.FormatInt(buildID, 10))
+	if err := mt.client.Remove(key); err != nil {
+		log.Warn("failed to remove task retry meta in etcd", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+	delete(mt.retryMetas, buildID)
+	return nil
+}
+
+// SaveOrphanedLockMeta records the segment reference lock which IndexCoord failed to release.
+func (mt *metaTable) SaveOrphanedLockMeta(lockMeta *orphanedLockMeta) error {
+	value, err := json.Marshal(lockMeta)
+	if err != nil {
+		return err
+	}
+	key := path.Join(orphanedLockMetaPrefix, strconv.FormatInt(lockMeta.BuildID, 10))
+	if err := mt.client.Save(key, string(value)); err != nil {
+		log.Warn("failed to save orphaned lock meta in etcd", zap.Int64("buildID", lockMeta.BuildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (mt *metaTable) GetMeta(buildID UniqueID) (*Meta, bool) {
 	mt.lock.RLock()
 	defer mt.lock.RUnlock()
@@ -271,6 +453,15 @@ func (mt *metaTable) GetMeta(buildID UniqueID) (*Meta, bool) {
 	return nil, ok
 }
 
+// IsShadowBuild returns whether the index task is a shadow build, false if the task does not exist.
+func (mt *metaTable) IsShadowBuild(buildID UniqueID) bool {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	meta, ok := mt.indexBuildID2Meta[buildID]
+	return ok && meta.indexMeta.GetReq().GetShadowBuild()
+}
+
 func (mt *metaTable) canIndex(buildID int64) bool {
 	meta := mt.indexBuildID2Meta[buildID]
 	if meta.indexMeta.MarkDeleted {
@@ -302,6 +493,8 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID, nodeID UniqueID) error
 		}
 		m.indexMeta.NodeID = nodeID
 		m.indexMeta.IndexVersion++
+		// the progress is reported again by the IndexNode of the new version.
+		m.indexMeta.Progress = 0
 		return mt.saveIndexMeta(m)
 	}
 	if err := mt.updateMeta(indexBuildID, updateFunc); err != nil {
@@ -313,6 +506,84 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID, nodeID UniqueID) error
 	return nil
 }
 
+// ResetForRebuild resets the finished index to be unissued, so that it is built again. The index files are kept in
+// meta until the new files are saved.
+func (mt *metaTable) ResetForRebuild(indexBuildID UniqueID) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	log.Info("IndexCoord metaTable ResetForRebuild", zap.Int64("IndexBuildId", indexBuildID))
+	updateFunc := func(m *Meta) error {
+		if m.indexMeta.MarkDeleted || m.indexMeta.State != commonpb.IndexState_Finished || m.indexMeta.NodeID != 0 {
+			return fmt.Errorf("index is not finished or its lock is not released, state = %s, nodeID = %d",
+				m.indexMeta.State.String(), m.indexMeta.NodeID)
+		}
+		m.indexMeta.State = commonpb.IndexState_Unissued
+		m.indexMeta.FailReason = ""
+		m.indexMeta.Progress = 0
+		// the index is rebuilt from scratch.
+		m.indexMeta.CheckpointPath = ""
+		if err := mt.saveIndexMeta(m); err != nil {
+			return err
+		}
+		metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.UnissuedIndexTaskLabel).Inc()
+		return nil
+	}
+	if err := mt.updateMeta(indexBuildID, updateFunc); err != nil {
+		log.Error("IndexCoord metaTable ResetForRebuild fail", zap.Int64("buildID", indexBuildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// MoveToSegment points the unissued index to the segment, such as the segment which the segment of the index is
+// compacted into. The checkpoint of the old segment is discarded.
+func (mt *metaTable) MoveToSegment(indexBuildID UniqueID, segmentID UniqueID, dataPaths []string, numRows int64) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	log.Info("IndexCoord metaTable MoveToSegment", zap.Int64("IndexBuildId", indexBuildID),
+		zap.Int64("segmentID", segmentID))
+	updateFunc := func(m *Meta) error {
+		if m.indexMeta.MarkDeleted || m.indexMeta.State != commonpb.IndexState_Unissued || m.indexMeta.NodeID != 0 {
+			return fmt.Errorf("index is not unissued or it has been assigned, state = %s, nodeID = %d",
+				m.indexMeta.State.String(), m.indexMeta.NodeID)
+		}
+		m.indexMeta.Req.SegmentID = segmentID
+		m.indexMeta.Req.DataPaths = dataPaths
+		m.indexMeta.Req.NumRows = numRows
+		m.indexMeta.CheckpointPath = ""
+		return mt.saveIndexMeta(m)
+	}
+	if err := mt.updateMeta(indexBuildID, updateFunc); err != nil {
+		log.Error("IndexCoord metaTable MoveToSegment fail", zap.Int64("buildID", indexBuildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// RevertVersion reverts the version bumped by UpdateVersion for the IndexNode, it is used when the task is not sent to
+// the IndexNode, so that the version does not grow on the failures before the assignment. It fails if the meta has
+// been updated since.
+func (mt *metaTable) RevertVersion(indexBuildID UniqueID, nodeID UniqueID, version int64) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	log.Info("IndexCoord metaTable RevertVersion", zap.Int64("IndexBuildId", indexBuildID),
+		zap.Int64("nodeID", nodeID), zap.Int64("version", version))
+	updateFunc := func(m *Meta) error {
+		if m.indexMeta.NodeID != nodeID || m.indexMeta.IndexVersion != version {
+			return fmt.Errorf("index meta has been updated, nodeID = %d, version = %d", m.indexMeta.NodeID,
+				m.indexMeta.IndexVersion)
+		}
+		m.indexMeta.NodeID = 0
+		m.indexMeta.IndexVersion--
+		return mt.saveIndexMeta(m)
+	}
+	if err := mt.updateMeta(indexBuildID, updateFunc); err != nil {
+		log.Error("IndexCoord metaTable RevertVersion fail", zap.Int64("buildID", indexBuildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // BuildIndex set the index state to be InProgress. It means IndexNode is building the index.
 func (mt *metaTable) BuildIndex(indexBuildID UniqueID) error {
 	mt.lock.Lock()
@@ -361,6 +632,73 @@ func (mt *metaTable) GetMetasByNodeID(nodeID UniqueID) []Meta {
 	return metas
 }
 
+// RangeMetasByNodeID calls fn with the meta of each index task on the IndexNode until fn returns false. Unlike
+// GetMetasByNodeID, the metas are cloned one by one without holding the lock while fn is called, so an IndexNode
+// with many tasks does not cause a large allocation or block the other operations on the meta table.
+func (mt *metaTable) RangeMetasByNodeID(nodeID UniqueID, fn func(meta *Meta) bool) {
+	mt.lock.RLock()
+	buildIDs := make([]UniqueID, 0)
+	for buildID, meta := range mt.indexBuildID2Meta {
+		if !meta.indexMeta.MarkDeleted && nodeID == meta.indexMeta.NodeID {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	mt.lock.RUnlock()
+
+	for _, buildID := range buildIDs {
+		meta, ok := mt.GetMeta(buildID)
+		// the meta may have been changed after the build ids are collected.
+		if !ok || meta.indexMeta.MarkDeleted || meta.indexMeta.NodeID != nodeID {
+			continue
+		}
+		if !fn(meta) {
+			return
+		}
+	}
+}
+
+// GetCollectionIDs returns the collection ids of the index tasks, the tasks without meta are ignored.
+func (mt *metaTable) GetCollectionIDs(buildIDs []UniqueID) map[UniqueID]UniqueID {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	collectionIDs := make(map[UniqueID]UniqueID, len(buildIDs))
+	for _, buildID := range buildIDs {
+		if meta, ok := mt.indexBuildID2Meta[buildID]; ok {
+			collectionIDs[buildID] = meta.indexMeta.GetReq().GetCollectionID()
+		}
+	}
+	return collectionIDs
+}
+
+// GetBuildIDsByIndexID returns the buildIDs of the index tasks which build the index.
+func (mt *metaTable) GetBuildIDsByIndexID(indexID UniqueID) []UniqueID {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	buildIDs := make([]UniqueID, 0)
+	for buildID, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.GetReq().GetIndexID() == indexID {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	return buildIDs
+}
+
+// GetBuildIDsByCollectionID returns the buildIDs of the index tasks which build the indexes of the collection.
+func (mt *metaTable) GetBuildIDsByCollectionID(collectionID UniqueID) []UniqueID {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	buildIDs := make([]UniqueID, 0)
+	for buildID, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.GetReq().GetCollectionID() == collectionID {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	return buildIDs
+}
+
 // MarkIndexAsDeleted will mark the corresponding index as deleted, and recycleUnusedIndexFiles will recycle these tasks.
 func (mt *metaTable) MarkIndexAsDeleted(indexID UniqueID) ([]UniqueID, error) {
 	mt.lock.Lock()
@@ -543,41 +881,20 @@ func (mt *metaTable) HasSameReq(req *indexpb.BuildIndexRequest) (bool, UniqueID)
 		if notEq {
 			continue
 		}
-		if len(meta.indexMeta.Req.TypeParams) != len(req.TypeParams) {
-			continue
-		}
-		notEq = false
-		for i := range meta.indexMeta.Req.TypeParams {
-			if meta.indexMeta.Req.TypeParams[i].Key != req.TypeParams[i].Key {
-				notEq = true
-				break
-			}
-			if meta.indexMeta.Req.TypeParams[i].Value != req.TypeParams[i].Value {
-				notEq = true
-				break
-			}
-		}
-		if notEq {
+		// the order of the params makes no difference to the index, and the scheduling fields of the request are not
+		// used to build the index, so the rebuild for them is skipped. The defaults are filled on both sides, since the
+		// metas recorded before the defaults are filled omit them.
+		if !equalParams(meta.indexMeta.Req.TypeParams, req.TypeParams) {
 			continue
 		}
-		if len(meta.indexMeta.Req.IndexParams) != len(req.IndexParams) {
+		if !equalParams(normalizeIndexParams(meta.indexMeta.Req.IndexParams), normalizeIndexParams(req.IndexParams)) {
 			continue
 		}
-		notEq = false
-		for i := range meta.indexMeta.Req.IndexParams {
-			if meta.indexMeta.Req.IndexParams[i].Key != req.IndexParams[i].Key {
-				notEq = true
-				break
-			}
-			if meta.indexMeta.Req.IndexParams[i].Value != req.IndexParams[i].Value {
-				notEq = true
-				break
-			}
-		}
-		if notEq {
+		if meta.indexMeta.MarkDeleted {
 			continue
 		}
-		if meta.indexMeta.MarkDeleted {
+		// the shadow build never takes the place of the active build, and vice versa.
+		if meta.indexMeta.Req.GetShadowBuild() != req.GetShadowBuild() {
 			continue
 		}
 		return true, meta.indexMeta.IndexBuildID
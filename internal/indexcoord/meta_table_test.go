@@ -66,6 +66,105 @@ func TestMetaTable_NewMetaTable(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, mt)
 	})
+
+	t.Run("load task retry meta", func(t *testing.T) {
+		kv := &mockETCDKV{
+			loadWithRevisionAndVersions: func(s string) ([]string, []string, []int64, int64, error) {
+				return []string{}, []string{}, []int64{}, 1, nil
+			},
+			loadWithPrefix: func(s string) ([]string, []string, error) {
+				return []string{"index-task-retry/1"}, []string{`{"build_id":1,"retry_count":3,"fail_reason":"error"}`}, nil
+			},
+		}
+		mt, err := NewMetaTable(kv)
+		assert.NoError(t, err)
+		retryMeta, ok := mt.GetTaskRetryMeta(1)
+		assert.True(t, ok)
+		assert.Equal(t, 3, retryMeta.RetryCount)
+		assert.Equal(t, "error", retryMeta.FailReason)
+	})
+
+	t.Run("LoadWithPrefix error", func(t *testing.T) {
+		kv := &mockETCDKV{
+			loadWithRevisionAndVersions: func(s string) ([]string, []string, []int64, int64, error) {
+				return []string{}, []string{}, []int64{}, 1, nil
+			},
+			loadWithPrefix: func(s string) ([]string, []string, error) {
+				return nil, nil, errors.New("error")
+			},
+		}
+		mt, err := NewMetaTable(kv)
+		assert.Error(t, err)
+		assert.Nil(t, mt)
+	})
+
+	t.Run("unmarshal task retry meta error", func(t *testing.T) {
+		kv := &mockETCDKV{
+			loadWithRevisionAndVersions: func(s string) ([]string, []string, []int64, int64, error) {
+				return []string{}, []string{}, []int64{}, 1, nil
+			},
+			loadWithPrefix: func(s string) ([]string, []string, error) {
+				return []string{"index-task-retry/1"}, []string{"invalid_string"}, nil
+			},
+		}
+		mt, err := NewMetaTable(kv)
+		assert.Error(t, err)
+		assert.Nil(t, mt)
+	})
+}
+
+func TestMetaTable_TaskRetryMeta(t *testing.T) {
+	saved := make(map[string]string)
+	mt := metaTable{
+		client: &mockETCDKV{
+			save: func(key string, value string) error {
+				saved[key] = value
+				return nil
+			},
+			remove: func(key string) error {
+				delete(saved, key)
+				return nil
+			},
+		},
+	}
+
+	retryMeta := &taskRetryMeta{
+		BuildID:    1,
+		RetryCount: 2,
+		FailReason: "error",
+	}
+	err := mt.SaveTaskRetryMeta(retryMeta)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(saved))
+	assert.ElementsMatch(t, []UniqueID{1}, mt.GetAllTaskRetryMetaBuildIDs())
+
+	// the saved meta is a copy
+	retryMeta.RetryCount = 3
+	got, ok := mt.GetTaskRetryMeta(1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, got.RetryCount)
+
+	err = mt.RemoveTaskRetryMeta(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+	_, ok = mt.GetTaskRetryMeta(1)
+	assert.False(t, ok)
+
+	// remove nonexistent retry meta
+	err = mt.RemoveTaskRetryMeta(2)
+	assert.NoError(t, err)
+
+	t.Run("save error", func(t *testing.T) {
+		mt.client = &mockETCDKV{
+			save: func(key string, value string) error {
+				return errors.New("error")
+			},
+		}
+		err := mt.SaveTaskRetryMeta(retryMeta)
+		assert.Error(t, err)
+		_, ok := mt.GetTaskRetryMeta(1)
+		assert.False(t, ok)
+	})
 }
 
 func TestMetaTable_GetAllIndexMeta(t *testing.T) {
@@ -234,6 +333,7 @@ func TestMetaTable_UpdateVersion(t *testing.T) {
 						IndexBuildID: 1,
 						NodeID:       0,
 						State:        commonpb.IndexState_Unissued,
+						Progress:     0.5,
 					},
 				},
 			},
@@ -245,6 +345,8 @@ func TestMetaTable_UpdateVersion(t *testing.T) {
 		}
 		err := mt.UpdateVersion(1, 1)
 		assert.NoError(t, err)
+		// the progress of the last assignment is reset.
+		assert.Equal(t, float32(0), mt.indexBuildID2Meta[1].indexMeta.GetProgress())
 	})
 
 	t.Run("index meta not exist", func(t *testing.T) {
@@ -407,6 +509,46 @@ func TestMetaTable_UpdateVersion(t *testing.T) {
 	})
 }
 
+func TestMetaTable_RevertVersion(t *testing.T) {
+	mt := createMetaTable()
+	err := mt.UpdateVersion(2, 1)
+	assert.NoError(t, err)
+
+	// the meta has been assigned to another IndexNode.
+	err = mt.RevertVersion(2, 4, 1)
+	assert.Error(t, err)
+
+	err = mt.RevertVersion(2, 1, 1)
+	assert.NoError(t, err)
+	meta, ok := mt.GetMeta(2)
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), meta.indexMeta.IndexVersion)
+	assert.Equal(t, UniqueID(0), meta.indexMeta.NodeID)
+
+	err = mt.RevertVersion(10, 1, 1)
+	assert.Error(t, err)
+}
+
+func TestMetaTable_MoveToSegment(t *testing.T) {
+	mt := createMetaTable()
+	mt.indexBuildID2Meta[2].indexMeta.CheckpointPath = "checkpoint"
+	err := mt.MoveToSegment(2, 103, []string{"DataPath-103"}, 200)
+	assert.NoError(t, err)
+	meta, ok := mt.GetMeta(2)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(103), meta.indexMeta.Req.SegmentID)
+	assert.Equal(t, []string{"DataPath-103"}, meta.indexMeta.Req.DataPaths)
+	assert.Equal(t, int64(200), meta.indexMeta.Req.NumRows)
+	assert.Equal(t, "", meta.indexMeta.CheckpointPath)
+
+	// the index which has been assigned is not moved.
+	err = mt.MoveToSegment(3, 103, []string{"DataPath-103"}, 200)
+	assert.Error(t, err)
+
+	err = mt.MoveToSegment(10, 103, []string{"DataPath-103"}, 200)
+	assert.Error(t, err)
+}
+
 func TestMetaTable_BuildIndex(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mt := metaTable{
@@ -567,6 +709,27 @@ func TestMetaTable_GetMetasByNodeID(t *testing.T) {
 	}
 	metas := mt.GetMetasByNodeID(1)
 	assert.Equal(t, 1, len(metas))
+
+	mt.indexBuildID2Meta[3] = &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 3,
+			NodeID:       1,
+			State:        commonpb.IndexState_InProgress,
+		},
+	}
+	buildIDs := make([]UniqueID, 0)
+	mt.RangeMetasByNodeID(1, func(meta *Meta) bool {
+		buildIDs = append(buildIDs, meta.indexMeta.IndexBuildID)
+		return true
+	})
+	assert.ElementsMatch(t, []UniqueID{2, 3}, buildIDs)
+
+	count := 0
+	mt.RangeMetasByNodeID(1, func(meta *Meta) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
 }
 
 func TestMetaTable_MarkIndexAsDeleted(t *testing.T) {
@@ -1097,6 +1260,25 @@ func TestMetaTable_GetDeletedMetas(t *testing.T) {
 	assert.Equal(t, 2, len(deletedMeta))
 }
 
+func TestMetaTable_GetCollectionIDs(t *testing.T) {
+	mt := metaTable{
+		indexBuildID2Meta: map[UniqueID]*Meta{
+			1: {
+				indexMeta: &indexpb.IndexMeta{
+					Req: &indexpb.BuildIndexRequest{IndexID: 10, CollectionID: 100},
+				},
+			},
+			2: {
+				indexMeta: &indexpb.IndexMeta{
+					Req: &indexpb.BuildIndexRequest{IndexID: 11, CollectionID: 100},
+				},
+			},
+		},
+	}
+	collectionIDs := mt.GetCollectionIDs([]UniqueID{1, 2, 3})
+	assert.Equal(t, map[UniqueID]UniqueID{1: 100, 2: 100}, collectionIDs)
+}
+
 func TestMetaTable_HasSameReq(t *testing.T) {
 	mt := metaTable{
 		indexBuildID2Meta: map[UniqueID]*Meta{
@@ -1315,6 +1497,43 @@ func TestMetaTable_HasSameReq(t *testing.T) {
 	exist, buildID = mt.HasSameReq(req)
 	assert.True(t, exist)
 	assert.Equal(t, int64(1), buildID)
+
+	// the same params in different order and the scheduling fields make no difference.
+	mt.indexBuildID2Meta[1].indexMeta.Req.IndexParams = append(mt.indexBuildID2Meta[1].indexMeta.Req.IndexParams,
+		&commonpb.KeyValuePair{Key: "index_type", Value: "HNSW"})
+	req.IndexParams = []*commonpb.KeyValuePair{
+		{
+			Key:   "index_type",
+			Value: "HNSW",
+		},
+		{
+			Key:   "metric_type",
+			Value: "L2",
+		},
+	}
+	req.BuildPriority = 2
+	exist, buildID = mt.HasSameReq(req)
+	assert.True(t, exist)
+	assert.Equal(t, int64(1), buildID)
+
+	// the meta recorded before the defaults are filled is the same as the request with the defaults.
+	req.IndexParams, _ = fillDefaultIndexParams(req.IndexParams)
+	exist, buildID = mt.HasSameReq(req)
+	assert.True(t, exist)
+	assert.Equal(t, int64(1), buildID)
+
+	// the params different from the defaults are not the same.
+	indexParams := mt.indexBuildID2Meta[1].indexMeta.Req.IndexParams
+	req.IndexParams = append(indexParams[:len(indexParams):len(indexParams)], &commonpb.KeyValuePair{Key: "M", Value: "32"})
+	exist, _ = mt.HasSameReq(req)
+	assert.False(t, exist)
+	req.IndexParams = indexParams
+
+	// the shadow build is not the same as the active build.
+	req.ShadowBuild = true
+	exist, _ = mt.HasSameReq(req)
+	assert.False(t, exist)
+	assert.False(t, mt.IsShadowBuild(1))
 }
 
 func TestMetaTable_NeedUpdateMeta(t *testing.T) {
@@ -18,6 +18,8 @@ package indexcoord
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -144,3 +146,142 @@ func getSystemInfoMetrics(
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
 	}, nil
 }
+
+// getIndexBuilderDumpMetrics returns the scheduling state of all the index tasks in the index builder.
+func getIndexBuilderDumpMetrics(
+	ctx context.Context,
+	req *milvuspb.GetMetricsRequest,
+	coord *IndexCoord,
+) (*milvuspb.GetMetricsResponse, error) {
+	dump := coord.indexBuilder.Dump()
+	dump.Name = metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID)
+
+	resp, err := metricsinfo.MarshalComponentInfos(dump)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+	}, nil
+}
+
+// getIndexTaskMetrics returns the index tasks which are scheduled by index builder.
+func getIndexTaskMetrics(
+	ctx context.Context,
+	req *milvuspb.GetMetricsRequest,
+	coord *IndexCoord,
+) (*milvuspb.GetMetricsResponse, error) {
+	tasks := coord.indexBuilder.ListTaskInfos()
+	taskInfos := metricsinfo.IndexTaskInfos{
+		Name:  metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+		Tasks: make([]metricsinfo.IndexTaskInfo, 0, len(tasks)),
+	}
+	for buildID, task := range tasks {
+		taskInfo := metricsinfo.IndexTaskInfo{
+			BuildID:     buildID,
+			State:       task.state.String(),
+			NodeID:      task.nodeID,
+			EnqueueTime: task.enqueueTime.Format(time.RFC3339),
+			RetryCount:  task.retryCount,
+			Priority:    task.priority,
+			Progress:    task.progress,
+
+			BlockedReason: task.blockedReason,
+
+			IndexID:       task.indexID,
+			IndexName:     task.indexName,
+			IndexType:     task.indexType,
+			IndexParams:   task.indexParams,
+			SegmentID:     task.segmentID,
+			Shadow:        task.shadow,
+			SegmentOrigin: task.segmentOrigin,
+
+			FailReason: task.failReason,
+		}
+		if task.lastError != "" {
+			taskInfo.LastError = task.lastError
+			taskInfo.LastErrorTime = task.lastErrorTime.Format(time.RFC3339)
+		}
+		taskInfos.Tasks = append(taskInfos.Tasks, taskInfo)
+	}
+	sort.Slice(taskInfos.Tasks, func(i, j int) bool {
+		return taskInfos.Tasks[i].BuildID < taskInfos.Tasks[j].BuildID
+	})
+
+	resp, err := metricsinfo.MarshalComponentInfos(taskInfos)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+	}, nil
+}
+
+// getCompletedIndexTaskMetrics returns the index tasks which are completed recently, from the most recent one.
+func getCompletedIndexTaskMetrics(
+	ctx context.Context,
+	req *milvuspb.GetMetricsRequest,
+	coord *IndexCoord,
+) (*milvuspb.GetMetricsResponse, error) {
+	tasks := coord.indexBuilder.ListCompletedTasks()
+	taskInfos := metricsinfo.CompletedIndexTaskInfos{
+		Name:  metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+		Tasks: make([]metricsinfo.CompletedIndexTaskInfo, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		taskInfos.Tasks = append(taskInfos.Tasks, metricsinfo.CompletedIndexTaskInfo{
+			BuildID:      task.buildID,
+			NodeID:       task.nodeID,
+			Duration:     task.duration.String(),
+			Result:       task.result.String(),
+			CompleteTime: task.completeTime.Format(time.RFC3339),
+		})
+	}
+
+	resp, err := metricsinfo.MarshalComponentInfos(taskInfos)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, coord.session.ServerID),
+	}, nil
+}
@@ -19,6 +19,7 @@ package indexcoord
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -35,21 +36,38 @@ import (
 
 // NodeManager is used by IndexCoord to manage the client of IndexNode.
 type NodeManager struct {
-	nodeClients map[UniqueID]types.IndexNode
-	pq          *PriorityQueue
-	lock        sync.RWMutex
-	ctx         context.Context
+	nodeClients    map[UniqueID]types.IndexNode
+	resourceGroups map[UniqueID]string // nodeID -> resource group, the IndexNodes not in it belong to no group
+	pq             *PriorityQueue
+	policy         NodeSelectPolicy
+	lock           sync.RWMutex
+	ctx            context.Context
+
+	assignFailures   map[UniqueID]*assignFailure // nodeID -> the consecutive failures to assign tasks to the IndexNode
+	failureThreshold int64                       // zero means the IndexNode is never skipped for assignment failures
+	failureCooldown  time.Duration
+}
+
+// assignFailure records the consecutive failures to assign tasks to an IndexNode.
+type assignFailure struct {
+	count         int64
+	cooldownUntil time.Time // No task is assigned to the IndexNode before it.
 }
 
 // NewNodeManager is used to create a new NodeManager.
 func NewNodeManager(ctx context.Context) *NodeManager {
 	return &NodeManager{
-		nodeClients: make(map[UniqueID]types.IndexNode),
+		nodeClients:    make(map[UniqueID]types.IndexNode),
+		resourceGroups: make(map[UniqueID]string),
 		pq: &PriorityQueue{
 			policy: PeekClientV1,
 		},
-		lock: sync.RWMutex{},
-		ctx:  ctx,
+		policy:           NewNodeSelectPolicy(Params.IndexCoordCfg.NodeSelectPolicy),
+		lock:             sync.RWMutex{},
+		ctx:              ctx,
+		assignFailures:   make(map[UniqueID]*assignFailure),
+		failureThreshold: Params.IndexCoordCfg.NodeAssignFailureThreshold,
+		failureCooldown:  Params.IndexCoordCfg.NodeAssignFailureCooldown,
 	}
 }
 
@@ -65,6 +83,8 @@ func (nm *NodeManager) setClient(nodeID UniqueID, client types.IndexNode) error
 	}
 	nm.lock.Lock()
 	nm.nodeClients[nodeID] = client
+	// the re-registered IndexNode starts with a clean record.
+	delete(nm.assignFailures, nodeID)
 	nm.lock.Unlock()
 	nm.pq.Push(item)
 	return nil
@@ -75,6 +95,8 @@ func (nm *NodeManager) RemoveNode(nodeID UniqueID) {
 	log.Debug("IndexCoord", zap.Any("Remove node with ID", nodeID))
 	nm.lock.Lock()
 	delete(nm.nodeClients, nodeID)
+	delete(nm.resourceGroups, nodeID)
+	delete(nm.assignFailures, nodeID)
 	nm.lock.Unlock()
 	nm.pq.Remove(nodeID)
 	metrics.IndexCoordIndexNodeNum.WithLabelValues().Dec()
@@ -103,32 +125,189 @@ func (nm *NodeManager) AddNode(nodeID UniqueID, address string) error {
 	return nm.setClient(nodeID, nodeClient)
 }
 
-// PeekClient peeks the client with the least load.
-func (nm *NodeManager) PeekClient(meta *Meta) (UniqueID, types.IndexNode) {
+// SetResourceGroup puts the IndexNode into the resource group, the IndexNode is removed from its resource group if
+// the group is empty.
+func (nm *NodeManager) SetResourceGroup(nodeID UniqueID, group string) error {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	if _, ok := nm.nodeClients[nodeID]; !ok {
+		return errIndexNodeIsNotOnService(nodeID)
+	}
+	if group == "" {
+		delete(nm.resourceGroups, nodeID)
+		return nil
+	}
+	if nm.resourceGroups == nil {
+		nm.resourceGroups = make(map[UniqueID]string)
+	}
+	nm.resourceGroups[nodeID] = group
+	log.Info("IndexCoord NodeManager set resource group", zap.Int64("nodeID", nodeID), zap.String("group", group))
+	return nil
+}
+
+// PeekClient peeks the client by the NodeSelectPolicy among the IndexNodes which have free task slots, the IndexNodes
+// in busyNodes are skipped. ErrNoIndexNode is returned if there is no IndexNode online, and ErrIndexNodesBusy is
+// returned if all the IndexNodes are busy.
+func (nm *NodeManager) PeekClient(meta *Meta, busyNodes map[UniqueID]struct{}) (UniqueID, types.IndexNode, error) {
+	return nm.PeekClientInGroup(meta, "", busyNodes)
+}
+
+// PeekClientInGroup is the same as PeekClient, but only the IndexNodes in the resource group are candidates if the
+// group is not empty.
+func (nm *NodeManager) PeekClientInGroup(meta *Meta, group string, busyNodes map[UniqueID]struct{}) (UniqueID, types.IndexNode, error) {
 	nm.lock.RLock()
 	defer nm.lock.RUnlock()
 
-	if len(nm.nodeClients) == 0 {
-		log.Error("there is no IndexNode online")
-		return -1, nil
+	candidates, err := nm.getCandidates(group, busyNodes)
+	if err != nil {
+		return 0, nil, err
 	}
+
+	var nodeID UniqueID
+	if nm.policy != nil {
+		nodeID = nm.policy.Select(candidates)
+	} else {
+		nodeID = sortedNodeIDs(candidates)[0]
+	}
+	return nodeID, nm.nodeClients[nodeID], nil
+}
+
+// PeekClientByLoad peeks the IndexNode with the least estimated work among the IndexNodes in the resource group which
+// have free task slots, the preferred IndexNode is chosen if it has the least work too.
+func (nm *NodeManager) PeekClientByLoad(meta *Meta, group string, preferNodeID UniqueID, busyNodes map[UniqueID]struct{}, loads map[UniqueID]int64) (UniqueID, types.IndexNode, error) {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+
+	candidates, err := nm.getCandidates(group, busyNodes)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	selected := UniqueID(-1)
+	for _, nodeID := range sortedNodeIDs(candidates) {
+		if selected == -1 || loads[nodeID] < loads[selected] || (loads[nodeID] == loads[selected] && nodeID == preferNodeID) {
+			selected = nodeID
+		}
+	}
+	return selected, nm.nodeClients[selected], nil
+}
+
+// getCandidates returns the capacity of the IndexNodes in the resource group which are not busy and have free task
+// slots, the caller should hold the lock. The capacity is the weight of the IndexNode, the free task slots only decide
+// whether the IndexNode is a candidate, otherwise the IndexNode with larger capacity gets fewer tasks once it is
+// loaded. The free task slots are the weight of the IndexNode which does not report the capacity.
+func (nm *NodeManager) getCandidates(group string, busyNodes map[UniqueID]struct{}) (map[UniqueID]int64, error) {
+	nodeNum := 0
+	candidates := make(map[UniqueID]int64)
 	for nodeID, client := range nm.nodeClients {
-		resp, err := client.GetTaskSlots(nm.ctx, &indexpb.GetTaskSlotsRequest{})
-		if err != nil {
-			log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		if group != "" && nm.resourceGroups[nodeID] != group {
 			continue
 		}
-		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
-			log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID),
-				zap.String("reason", resp.Status.Reason))
+		nodeNum++
+		if _, ok := busyNodes[nodeID]; ok {
 			continue
 		}
-		if resp.Slots > 0 {
-			return nodeID, client
+		if nm.isCoolingDown(nodeID) {
+			continue
+		}
+		if slots, capacity := nm.getTaskSlots(nodeID, client); slots > 0 {
+			if capacity <= 0 {
+				capacity = slots
+			}
+			candidates[nodeID] = capacity
+		}
+	}
+	if nodeNum == 0 {
+		log.Error("there is no IndexNode online", zap.String("group", group))
+		return nil, ErrNoIndexNode
+	}
+	if len(candidates) == 0 {
+		return nil, ErrIndexNodesBusy
+	}
+	return candidates, nil
+}
+
+// PeekClientWithAffinity peeks the preferred IndexNode if it is alive, in the resource group and has free task slots,
+// so that the same IndexNode rebuilds the same segment. Otherwise, it falls back to PeekClientInGroup.
+func (nm *NodeManager) PeekClientWithAffinity(meta *Meta, group string, preferNodeID UniqueID, busyNodes map[UniqueID]struct{}) (UniqueID, types.IndexNode, error) {
+	if _, busy := busyNodes[preferNodeID]; preferNodeID != 0 && !busy {
+		nm.lock.RLock()
+		client, ok := nm.nodeClients[preferNodeID]
+		inGroup := group == "" || nm.resourceGroups[preferNodeID] == group
+		coolingDown := nm.isCoolingDown(preferNodeID)
+		nm.lock.RUnlock()
+		if ok && inGroup && !coolingDown {
+			if slots, _ := nm.getTaskSlots(preferNodeID, client); slots > 0 {
+				return preferNodeID, client, nil
+			}
 		}
+		log.Debug("the preferred IndexNode is not available", zap.Int64("nodeID", preferNodeID))
+	}
+	return nm.PeekClientInGroup(meta, group, busyNodes)
+}
+
+// ReportAssignResult records the result of assigning a task to the IndexNode. After failureThreshold consecutive
+// failures, the IndexNode is not peeked during failureCooldown, the first success resets the failures.
+func (nm *NodeManager) ReportAssignResult(nodeID UniqueID, err error) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	if err == nil {
+		delete(nm.assignFailures, nodeID)
+		return
+	}
+	if nm.failureThreshold <= 0 {
+		return
 	}
+	if nm.assignFailures == nil {
+		nm.assignFailures = make(map[UniqueID]*assignFailure)
+	}
+	failure, ok := nm.assignFailures[nodeID]
+	if !ok {
+		failure = &assignFailure{}
+		nm.assignFailures[nodeID] = failure
+	}
+	failure.count++
+	if failure.count >= nm.failureThreshold {
+		// the IndexNode is tried again after the cooldown, and skipped again if the assignment still fails.
+		failure.cooldownUntil = time.Now().Add(nm.failureCooldown)
+		log.Warn("IndexCoord NodeManager skip the IndexNode for consecutive assignment failures",
+			zap.Int64("nodeID", nodeID), zap.Int64("failures", failure.count),
+			zap.Duration("cooldown", nm.failureCooldown), zap.Error(err))
+	}
+}
+
+// isCoolingDown returns whether the IndexNode is skipped for consecutive assignment failures, the caller should hold
+// the lock.
+func (nm *NodeManager) isCoolingDown(nodeID UniqueID) bool {
+	failure, ok := nm.assignFailures[nodeID]
+	return ok && time.Now().Before(failure.cooldownUntil)
+}
+
+// getTaskSlots returns the number of free task slots and the total task slots reported by the IndexNode, zeros if
+// they are failed to get.
+func (nm *NodeManager) getTaskSlots(nodeID UniqueID, client types.IndexNode) (int64, int64) {
+	resp, err := client.GetTaskSlots(nm.ctx, &indexpb.GetTaskSlotsRequest{})
+	if err != nil {
+		log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return 0, 0
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID),
+			zap.String("reason", resp.Status.Reason))
+		return 0, 0
+	}
+	return resp.Slots, resp.GetCapacity()
+}
+
+// GetClient returns the client of the IndexNode, it returns false if the IndexNode is not on service.
+func (nm *NodeManager) GetClient(nodeID UniqueID) (types.IndexNode, bool) {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
 
-	return 0, nil
+	client, ok := nm.nodeClients[nodeID]
+	return client, ok
 }
 
 func (nm *NodeManager) ListAllNodes() []UniqueID {
@@ -18,11 +18,15 @@ package indexcoord
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,16 +49,283 @@ func TestNodeManager_PeekClient(t *testing.T) {
 			},
 		},
 	}
-	nodeID, client := nm.PeekClient(meta)
-	assert.Equal(t, int64(-1), nodeID)
+	nodeID, client, err := nm.PeekClient(meta, nil)
+	assert.ErrorIs(t, err, ErrNoIndexNode)
+	assert.Equal(t, int64(0), nodeID)
 	assert.Nil(t, client)
-	err := nm.AddNode(1, "indexnode-1")
+	err = nm.AddNode(1, "indexnode-1")
 	assert.Nil(t, err)
 	nm.pq.SetMemory(1, 100)
-	nodeID2, client2 := nm.PeekClient(meta)
+	nodeID2, client2, err := nm.PeekClient(meta, nil)
+	assert.ErrorIs(t, err, ErrIndexNodesBusy)
 	assert.Equal(t, int64(0), nodeID2)
 	assert.Nil(t, client2)
 
 	nodeIDs := nm.ListAllNodes()
 	assert.Equal(t, 1, len(nodeIDs))
 }
+
+func TestNodeManager_PeekClientWithAffinity(t *testing.T) {
+	nm := &NodeManager{
+		nodeClients: map[UniqueID]types.IndexNode{
+			1: &indexnode.Mock{},
+			2: &indexnode.Mock{},
+			3: &indexnode.Mock{Failure: true},
+		},
+		ctx: context.Background(),
+	}
+	meta := &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 1,
+		},
+	}
+
+	t.Run("preferred node is available", func(t *testing.T) {
+		nodeID, client, err := nm.PeekClientWithAffinity(meta, "", 2, nil)
+		assert.Equal(t, int64(2), nodeID)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("preferred node is busy", func(t *testing.T) {
+		nodeID, client, err := nm.PeekClientWithAffinity(meta, "", 2, map[UniqueID]struct{}{2: {}})
+		assert.Equal(t, int64(1), nodeID)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("preferred node has no slots", func(t *testing.T) {
+		nodeID, client, err := nm.PeekClientWithAffinity(meta, "", 3, nil)
+		assert.NotEqual(t, int64(3), nodeID)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("preferred node is down", func(t *testing.T) {
+		nodeID, client, err := nm.PeekClientWithAffinity(meta, "", 4, nil)
+		assert.NotEqual(t, int64(4), nodeID)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("no preference", func(t *testing.T) {
+		nodeID, client, err := nm.PeekClientWithAffinity(meta, "", 0, nil)
+		assert.NotEqual(t, int64(0), nodeID)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestNodeManager_PeekClientWithPolicy(t *testing.T) {
+	nm := &NodeManager{
+		nodeClients: map[UniqueID]types.IndexNode{
+			1: &indexnode.Mock{},
+			2: &indexnode.Mock{},
+			3: &indexnode.Mock{Failure: true},
+		},
+		policy: newRoundRobinPolicy(),
+		ctx:    context.Background(),
+	}
+	meta := &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 1,
+		},
+	}
+
+	selected := make([]UniqueID, 0)
+	for i := 0; i < 3; i++ {
+		nodeID, client, err := nm.PeekClient(meta, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+		selected = append(selected, nodeID)
+	}
+	// node 3 has no free task slots
+	assert.Equal(t, []UniqueID{1, 2, 1}, selected)
+}
+
+// slotsIndexNode reports the task slots and the capacity.
+type slotsIndexNode struct {
+	indexnode.Mock
+	slots    int64
+	capacity int64
+}
+
+func (in *slotsIndexNode) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error) {
+	return &indexpb.GetTaskSlotsResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Slots:    in.slots,
+		Capacity: in.capacity,
+	}, nil
+}
+
+func TestNodeManager_CandidateWeights(t *testing.T) {
+	nm := &NodeManager{
+		nodeClients: map[UniqueID]types.IndexNode{
+			// the large IndexNode which is loaded is still weighted by its capacity.
+			1: &slotsIndexNode{slots: 1, capacity: 8},
+			2: &slotsIndexNode{slots: 4, capacity: 4},
+			// the IndexNode without free task slots is not a candidate.
+			3: &slotsIndexNode{slots: 0, capacity: 16},
+			// the IndexNode of older versions does not report the capacity.
+			4: &slotsIndexNode{slots: 2},
+		},
+		policy: newWeightedPolicy(),
+		ctx:    context.Background(),
+	}
+	candidates, err := nm.getCandidates("", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID]int64{1: 8, 2: 4, 4: 2}, candidates)
+
+	meta := &Meta{indexMeta: &indexpb.IndexMeta{IndexBuildID: 1}}
+	counts := make(map[UniqueID]int)
+	for i := 0; i < 14; i++ {
+		nodeID, _, err := nm.PeekClient(meta, nil)
+		assert.NoError(t, err)
+		counts[nodeID]++
+	}
+	assert.Equal(t, map[UniqueID]int{1: 8, 2: 4, 4: 2}, counts)
+}
+
+func TestNodeManager_PeekClientInGroup(t *testing.T) {
+	nm := &NodeManager{
+		nodeClients: map[UniqueID]types.IndexNode{
+			1: &indexnode.Mock{},
+			2: &indexnode.Mock{},
+			3: &indexnode.Mock{Failure: true},
+		},
+		ctx: context.Background(),
+	}
+	meta := &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 1,
+		},
+	}
+	assert.NoError(t, nm.SetResourceGroup(2, "g1"))
+	assert.NoError(t, nm.SetResourceGroup(3, "g2"))
+	assert.Error(t, nm.SetResourceGroup(4, "g1"))
+
+	nodeID, client, err := nm.PeekClientInGroup(meta, "g1", nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	_, _, err = nm.PeekClientInGroup(meta, "g1", map[UniqueID]struct{}{2: {}})
+	assert.ErrorIs(t, err, ErrIndexNodesBusy)
+
+	// node 3 has no free task slots
+	_, _, err = nm.PeekClientInGroup(meta, "g2", nil)
+	assert.ErrorIs(t, err, ErrIndexNodesBusy)
+
+	_, _, err = nm.PeekClientInGroup(meta, "g3", nil)
+	assert.ErrorIs(t, err, ErrNoIndexNode)
+
+	// the preferred node is not in the group
+	nodeID, _, err = nm.PeekClientWithAffinity(meta, "g1", 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	assert.NoError(t, nm.SetResourceGroup(2, ""))
+	_, _, err = nm.PeekClientInGroup(meta, "g1", nil)
+	assert.ErrorIs(t, err, ErrNoIndexNode)
+}
+
+func TestNodeManager_AssignFailureCooldown(t *testing.T) {
+	nm := &NodeManager{
+		nodeClients: map[UniqueID]types.IndexNode{
+			1: &indexnode.Mock{},
+			2: &indexnode.Mock{},
+		},
+		pq: &PriorityQueue{
+			policy: PeekClientV1,
+		},
+		ctx:              context.Background(),
+		failureThreshold: 2,
+		failureCooldown:  time.Hour,
+	}
+	meta := &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 1,
+		},
+	}
+	assignErr := errors.New("assign failed")
+
+	// the node is skipped after consecutive failures only.
+	nm.ReportAssignResult(1, assignErr)
+	nodeID, _, err := nm.PeekClientWithAffinity(meta, "", 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), nodeID)
+	nm.ReportAssignResult(1, assignErr)
+	nodeID, _, err = nm.PeekClientWithAffinity(meta, "", 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	nm.ReportAssignResult(2, assignErr)
+	nm.ReportAssignResult(2, assignErr)
+	_, _, err = nm.PeekClient(meta, nil)
+	assert.ErrorIs(t, err, ErrIndexNodesBusy)
+
+	// the node is tried again after the cooldown.
+	nm.assignFailures[2].cooldownUntil = time.Now()
+	nodeID, _, err = nm.PeekClient(meta, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	// the first success resets the failures.
+	nm.ReportAssignResult(2, nil)
+	nm.ReportAssignResult(2, assignErr)
+	nodeID, _, err = nm.PeekClient(meta, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	// the re-registered node starts with a clean record.
+	assert.NoError(t, nm.setClient(1, &indexnode.Mock{}))
+	nodeID, _, err = nm.PeekClientWithAffinity(meta, "", 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), nodeID)
+
+	nm.failureThreshold = 0
+	nm.ReportAssignResult(1, assignErr)
+	_, ok := nm.assignFailures[1]
+	assert.False(t, ok)
+}
+
+func TestNodeManager_PeekClientByLoad(t *testing.T) {
+	nm := &NodeManager{
+		nodeClients: map[UniqueID]types.IndexNode{
+			1: &indexnode.Mock{},
+			2: &indexnode.Mock{},
+			3: &indexnode.Mock{Failure: true},
+		},
+		ctx: context.Background(),
+	}
+	meta := &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: 1,
+		},
+	}
+
+	// node 3 has the least work but no free task slots.
+	loads := map[UniqueID]int64{1: 100, 2: 10}
+	nodeID, client, err := nm.PeekClientByLoad(meta, "", 0, nil, loads)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	nodeID, _, err = nm.PeekClientByLoad(meta, "", 0, map[UniqueID]struct{}{2: {}}, loads)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), nodeID)
+
+	// the preferred node is chosen when the work is the same.
+	loads[1] = 10
+	nodeID, _, err = nm.PeekClientByLoad(meta, "", 2, nil, loads)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), nodeID)
+	nodeID, _, err = nm.PeekClientByLoad(meta, "", 0, nil, loads)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), nodeID)
+
+	_, _, err = nm.PeekClientByLoad(meta, "", 0, map[UniqueID]struct{}{1: {}, 2: {}}, loads)
+	assert.ErrorIs(t, err, ErrIndexNodesBusy)
+	_, _, err = nm.PeekClientByLoad(meta, "g1", 0, nil, loads)
+	assert.ErrorIs(t, err, ErrNoIndexNode)
+}
@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// RoundRobinNodeSelectPolicy chooses the IndexNodes in turn.
+	RoundRobinNodeSelectPolicy = "roundRobin"
+	// WeightedNodeSelectPolicy chooses the IndexNodes in proportion to their capacity.
+	WeightedNodeSelectPolicy = "weighted"
+)
+
+// NodeSelectPolicy defines how to choose an IndexNode to build the index.
+type NodeSelectPolicy interface {
+	// Select chooses one of the candidates, the weight of each candidate is the capacity reported by the IndexNode,
+	// which is the total task slots rather than the free ones. candidates must not be empty.
+	Select(candidates map[UniqueID]int64) UniqueID
+}
+
+// NewNodeSelectPolicy creates the NodeSelectPolicy by name, the round-robin policy is used if the name is unknown.
+func NewNodeSelectPolicy(name string) NodeSelectPolicy {
+	switch name {
+	case WeightedNodeSelectPolicy:
+		return newWeightedPolicy()
+	case RoundRobinNodeSelectPolicy:
+		return newRoundRobinPolicy()
+	default:
+		log.Warn("unknown node select policy, use round-robin policy", zap.String("policy", name))
+		return newRoundRobinPolicy()
+	}
+}
+
+// sortedNodeIDs returns the node ids of the candidates in ascending order.
+func sortedNodeIDs(candidates map[UniqueID]int64) []UniqueID {
+	nodeIDs := make([]UniqueID, 0, len(candidates))
+	for nodeID := range candidates {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool {
+		return nodeIDs[i] < nodeIDs[j]
+	})
+	return nodeIDs
+}
+
+// roundRobinPolicy chooses the candidate after the last chosen IndexNode, the weights are ignored.
+type roundRobinPolicy struct {
+	lock     sync.Mutex
+	lastNode UniqueID
+}
+
+func newRoundRobinPolicy() *roundRobinPolicy {
+	return &roundRobinPolicy{}
+}
+
+func (p *roundRobinPolicy) Select(candidates map[UniqueID]int64) UniqueID {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	nodeIDs := sortedNodeIDs(candidates)
+	selected := nodeIDs[0]
+	for _, nodeID := range nodeIDs {
+		if nodeID > p.lastNode {
+			selected = nodeID
+			break
+		}
+	}
+	p.lastNode = selected
+	return selected
+}
+
+// weightedPolicy is a smooth weighted round-robin policy, the IndexNode with larger capacity is chosen more often,
+// and the choices of the same IndexNode are spread out.
+type weightedPolicy struct {
+	lock          sync.Mutex
+	currentWeight map[UniqueID]int64
+}
+
+func newWeightedPolicy() *weightedPolicy {
+	return &weightedPolicy{
+		currentWeight: make(map[UniqueID]int64),
+	}
+}
+
+func (p *weightedPolicy) Select(candidates map[UniqueID]int64) UniqueID {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// forget the IndexNodes which are not candidates any more.
+	for nodeID := range p.currentWeight {
+		if _, ok := candidates[nodeID]; !ok {
+			delete(p.currentWeight, nodeID)
+		}
+	}
+
+	var totalWeight int64
+	selected := UniqueID(-1)
+	for _, nodeID := range sortedNodeIDs(candidates) {
+		weight := candidates[nodeID]
+		if weight <= 0 {
+			weight = 1
+		}
+		totalWeight += weight
+		p.currentWeight[nodeID] += weight
+		if selected == -1 || p.currentWeight[nodeID] > p.currentWeight[selected] {
+			selected = nodeID
+		}
+	}
+	p.currentWeight[selected] -= totalWeight
+	return selected
+}
@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNodeSelectPolicy(t *testing.T) {
+	assert.IsType(t, &roundRobinPolicy{}, NewNodeSelectPolicy(RoundRobinNodeSelectPolicy))
+	assert.IsType(t, &weightedPolicy{}, NewNodeSelectPolicy(WeightedNodeSelectPolicy))
+	assert.IsType(t, &roundRobinPolicy{}, NewNodeSelectPolicy("unknown"))
+}
+
+func TestRoundRobinPolicy_Select(t *testing.T) {
+	p := newRoundRobinPolicy()
+	candidates := map[UniqueID]int64{1: 1, 2: 10, 3: 1}
+
+	selected := make([]UniqueID, 0)
+	for i := 0; i < 4; i++ {
+		selected = append(selected, p.Select(candidates))
+	}
+	assert.Equal(t, []UniqueID{1, 2, 3, 1}, selected)
+
+	// node 2 is not a candidate any more
+	delete(candidates, 2)
+	assert.Equal(t, UniqueID(3), p.Select(candidates))
+}
+
+func TestWeightedPolicy_Select(t *testing.T) {
+	p := newWeightedPolicy()
+	candidates := map[UniqueID]int64{1: 1, 2: 3}
+
+	counts := make(map[UniqueID]int)
+	for i := 0; i < 8; i++ {
+		counts[p.Select(candidates)]++
+	}
+	assert.Equal(t, 2, counts[1])
+	assert.Equal(t, 6, counts[2])
+
+	// the node without reported capacity still has a chance
+	candidates = map[UniqueID]int64{3: 0}
+	assert.Equal(t, UniqueID(3), p.Select(candidates))
+	assert.Equal(t, 1, len(p.currentWeight))
+}
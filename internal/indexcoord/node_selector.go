@@ -0,0 +1,43 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// NodeSelector chooses the IndexNode to assign the index task to.
+type NodeSelector interface {
+	// SelectNode chooses an IndexNode in the resource group which is not busy, the preferred IndexNode is chosen if
+	// it is available. loads is the estimated work of the IndexNodes, it is nil if the tasks are not balanced by
+	// cost. ErrIndexNodesBusy is returned if all IndexNodes are busy.
+	SelectNode(meta *Meta, group string, preferNodeID UniqueID, busyNodes map[UniqueID]struct{},
+		loads map[UniqueID]int64) (UniqueID, types.IndexNode, error)
+}
+
+// nodeManagerSelector chooses the IndexNode by the NodeManager of IndexCoord.
+type nodeManagerSelector struct {
+	ic *IndexCoord
+}
+
+func (s *nodeManagerSelector) SelectNode(meta *Meta, group string, preferNodeID UniqueID, busyNodes map[UniqueID]struct{},
+	loads map[UniqueID]int64) (UniqueID, types.IndexNode, error) {
+	if loads == nil {
+		return s.ic.nodeManager.PeekClientWithAffinity(meta, group, preferNodeID, busyNodes)
+	}
+	return s.ic.nodeManager.PeekClientByLoad(meta, group, preferNodeID, busyNodes, loads)
+}
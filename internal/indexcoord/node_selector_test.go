@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type fixedNodeSelector struct {
+	nodeID UniqueID
+	client types.IndexNode
+	err    error
+
+	preferNodeID UniqueID
+	loads        map[UniqueID]int64
+}
+
+func (s *fixedNodeSelector) SelectNode(meta *Meta, group string, preferNodeID UniqueID, busyNodes map[UniqueID]struct{},
+	loads map[UniqueID]int64) (UniqueID, types.IndexNode, error) {
+	s.preferNodeID = preferNodeID
+	s.loads = loads
+	return s.nodeID, s.client, s.err
+}
+
+func TestIndexBuilder_NodeSelector(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt)
+	ib.maxConcurrentTasksPerNode = 0
+
+	t.Run("all busy", func(t *testing.T) {
+		selector := &fixedNodeSelector{err: ErrIndexNodesBusy}
+		ib.SetNodeSelector(selector)
+		ib.process(2)
+		task, ok := ib.tasks.Get(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInit, task.state)
+		assert.Nil(t, selector.loads)
+	})
+
+	t.Run("assign", func(t *testing.T) {
+		ib.busyUntil = time.Time{}
+		ib.balanceByCost = true
+		selector := &fixedNodeSelector{nodeID: 4, client: &indexnode.Mock{}}
+		ib.SetNodeSelector(selector)
+		ib.process(2)
+		task, ok := ib.tasks.Get(2)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskInProgress, task.state)
+		assert.Equal(t, UniqueID(4), task.nodeID)
+		assert.NotNil(t, selector.loads)
+	})
+
+	ib.SetNodeSelector(nil)
+	_, ok := ib.nodeSelector.(*nodeManagerSelector)
+	assert.True(t, ok)
+}
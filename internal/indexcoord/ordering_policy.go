@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// PriorityOrderingPolicy processes the tasks with higher priority first, such as the tasks to be reassigned,
+	// the tasks with the same priority are processed in the order they are enqueued.
+	PriorityOrderingPolicy = "priority"
+	// BuildIDOrderingPolicy processes the tasks by buildID, the priorities are ignored. It is the default policy.
+	BuildIDOrderingPolicy = "buildID"
+	// FIFOOrderingPolicy processes the tasks in the order they are enqueued, the priorities are ignored.
+	FIFOOrderingPolicy = "fifo"
+)
+
+// OrderingPolicy defines the order in which the index builder processes the tasks, it orders the heap of the task
+// queue, see indexCoord.scheduler.orderingPolicy.
+type OrderingPolicy interface {
+	// Less reports whether task a should be processed before task b.
+	Less(a, b *indexTask) bool
+}
+
+// NewOrderingPolicy creates the OrderingPolicy by name, the buildID policy is used if the name is unknown.
+func NewOrderingPolicy(name string) OrderingPolicy {
+	switch name {
+	case PriorityOrderingPolicy:
+		return priorityOrderingPolicy{}
+	case BuildIDOrderingPolicy:
+		return buildIDOrderingPolicy{}
+	case FIFOOrderingPolicy:
+		return fifoOrderingPolicy{}
+	default:
+		log.Warn("unknown ordering policy, use buildID policy", zap.String("policy", name))
+		return buildIDOrderingPolicy{}
+	}
+}
+
+// honorsPriority returns whether the policy orders the tasks by their priorities.
+func honorsPriority(policy OrderingPolicy) bool {
+	_, ok := policy.(priorityOrderingPolicy)
+	return ok
+}
+
+type priorityOrderingPolicy struct{}
+
+func (priorityOrderingPolicy) Less(a, b *indexTask) bool {
+	return lessTask(a, b)
+}
+
+type buildIDOrderingPolicy struct{}
+
+func (buildIDOrderingPolicy) Less(a, b *indexTask) bool {
+	return a.buildID < b.buildID
+}
+
+type fifoOrderingPolicy struct{}
+
+func (fifoOrderingPolicy) Less(a, b *indexTask) bool {
+	if !a.enqueueTime.Equal(b.enqueueTime) {
+		return a.enqueueTime.Before(b.enqueueTime)
+	}
+	return a.buildID < b.buildID
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestNewOrderingPolicy(t *testing.T) {
+	assert.IsType(t, priorityOrderingPolicy{}, NewOrderingPolicy(PriorityOrderingPolicy))
+	assert.IsType(t, buildIDOrderingPolicy{}, NewOrderingPolicy(BuildIDOrderingPolicy))
+	assert.IsType(t, fifoOrderingPolicy{}, NewOrderingPolicy(FIFOOrderingPolicy))
+	assert.IsType(t, buildIDOrderingPolicy{}, NewOrderingPolicy("unknown"))
+
+	// only the priority policy orders the tasks by their priorities.
+	assert.True(t, honorsPriority(NewOrderingPolicy(PriorityOrderingPolicy)))
+	assert.False(t, honorsPriority(NewOrderingPolicy(BuildIDOrderingPolicy)))
+	assert.False(t, honorsPriority(NewOrderingPolicy(FIFOOrderingPolicy)))
+}
+
+func TestOrderingPolicy(t *testing.T) {
+	now := time.Now()
+	tq := newTaskQueue(NewOrderingPolicy(PriorityOrderingPolicy))
+	tq.Push(&indexTask{buildID: 1, priority: defaultTaskPriority, enqueueTime: now.Add(time.Second)})
+	tq.Push(&indexTask{buildID: 2, priority: retryTaskPriority, enqueueTime: now.Add(2 * time.Second)})
+	tq.Push(&indexTask{buildID: 3, priority: defaultTaskPriority, enqueueTime: now})
+	tq.Push(&indexTask{buildID: 4, priority: defaultTaskPriority, enqueueTime: now})
+
+	assert.Equal(t, []UniqueID{2, 3, 4, 1}, tq.BuildIDs())
+	tq.SetPolicy(NewOrderingPolicy(BuildIDOrderingPolicy))
+	assert.Equal(t, []UniqueID{1, 2, 3, 4}, tq.BuildIDs())
+	tq.SetPolicy(NewOrderingPolicy(FIFOOrderingPolicy))
+	assert.Equal(t, []UniqueID{3, 4, 1, 2}, tq.BuildIDs())
+}
+
+func TestPriorityOrderingPolicy_FIFOWithinPriority(t *testing.T) {
+	now := time.Now()
+	tq := newTaskQueue(NewOrderingPolicy(PriorityOrderingPolicy))
+	// the tasks of each priority band are enqueued in the reverse order of buildID.
+	for i := 0; i < 5; i++ {
+		enqueueTime := now.Add(time.Duration(5-i) * time.Second)
+		tq.Push(&indexTask{buildID: UniqueID(10 + i), priority: defaultTaskPriority, enqueueTime: enqueueTime})
+		tq.Push(&indexTask{buildID: UniqueID(20 + i), priority: retryTaskPriority, enqueueTime: enqueueTime})
+	}
+
+	assert.Equal(t, []UniqueID{24, 23, 22, 21, 20, 14, 13, 12, 11, 10}, tq.BuildIDs())
+	tq.SetPolicy(NewOrderingPolicy(BuildIDOrderingPolicy))
+	assert.Equal(t, []UniqueID{10, 11, 12, 13, 14, 20, 21, 22, 23, 24}, tq.BuildIDs())
+}
+
+// setOrderingPolicy changes the ordering policy of the index builder and reorders its tasks.
+func setOrderingPolicy(ib *indexBuilder, policy OrderingPolicy) {
+	ib.orderingPolicy = policy
+	ib.tasks.SetPolicy(policy)
+}
+
+func TestIndexBuilder_RunOrder(t *testing.T) {
+	Params.Init()
+	assert.Equal(t, BuildIDOrderingPolicy, Params.IndexCoordCfg.OrderingPolicy)
+
+	// newOrderedIndexBuilder creates the index builder with the tasks 1 to 4 enqueued at the same time, the task 3 has
+	// the priority of the user tasks.
+	newOrderedIndexBuilder := func(node types.IndexNode) *indexBuilder {
+		mt := createMetaTable()
+		mt.indexBuildID2Meta = map[UniqueID]*Meta{}
+		for buildID := UniqueID(1); buildID <= 4; buildID++ {
+			mt.indexBuildID2Meta[buildID] = &Meta{
+				indexMeta: &indexpb.IndexMeta{
+					IndexBuildID: buildID,
+					State:        commonpb.IndexState_Unissued,
+					Req: &indexpb.BuildIndexRequest{
+						NumRows:   100,
+						DataPaths: []string{"DataPath"},
+						TypeParams: []*commonpb.KeyValuePair{
+							{
+								Key:   "dim",
+								Value: "128",
+							},
+						},
+					},
+				},
+			}
+		}
+		ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+			4: node,
+		}))
+		ib.maxConcurrentTasksPerNode = 0
+		ib.processParallelism = 1
+		now := time.Now()
+		for _, task := range ib.tasks.tasks {
+			task.enqueueTime = now
+		}
+		ib.tasks.SetPolicy(ib.orderingPolicy)
+		assert.True(t, ib.tasks.Update(3, userTaskPriority))
+		return ib
+	}
+	assignedBuildIDs := func(node *recordingIndexNode) []UniqueID {
+		buildIDs := make([]UniqueID, 0, len(node.reqs))
+		for _, req := range node.reqs {
+			buildIDs = append(buildIDs, req.IndexBuildID)
+		}
+		return buildIDs
+	}
+
+	t.Run("default", func(t *testing.T) {
+		node := &recordingIndexNode{}
+		ib := newOrderedIndexBuilder(node)
+		assert.IsType(t, buildIDOrderingPolicy{}, ib.orderingPolicy)
+
+		// the tasks are assigned by buildID by the default policy, the priority is ignored.
+		ib.run()
+		assert.Equal(t, []UniqueID{1, 2, 3, 4}, assignedBuildIDs(node))
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		node := &recordingIndexNode{}
+		ib := newOrderedIndexBuilder(node)
+		setOrderingPolicy(ib, NewOrderingPolicy(PriorityOrderingPolicy))
+
+		// the user task is assigned first.
+		ib.run()
+		assert.Equal(t, []UniqueID{3, 1, 2, 4}, assignedBuildIDs(node))
+	})
+}
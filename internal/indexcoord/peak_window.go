@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// peakWindow is a daily time window in which the number of concurrent tasks on each IndexNode is limited, so that
+// building indexes does not compete with the queries in peak hours.
+type peakWindow struct {
+	// start and end are the offsets from midnight in local time, the window crosses midnight if start > end.
+	start time.Duration
+	end   time.Duration
+	// maxConcurrent is the maximum number of in-progress tasks on an IndexNode in the window.
+	maxConcurrent int
+}
+
+// contains returns whether the time is in the window.
+func (w peakWindow) contains(t time.Time) bool {
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	if w.start <= w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+// parsePeakWindows parses the peak windows in the form of "09:00-18:00=2,22:00-02:00=4", empty means no peak
+// windows.
+func parsePeakWindows(value string) ([]peakWindow, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	var windows []peakWindow
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		kv := strings.Split(item, "=")
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid peak window: %s", item)
+		}
+		times := strings.Split(kv[0], "-")
+		if len(times) != 2 {
+			return nil, fmt.Errorf("invalid peak window: %s", item)
+		}
+		start, err := parseClock(times[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(times[1])
+		if err != nil {
+			return nil, err
+		}
+		maxConcurrent, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil || maxConcurrent <= 0 {
+			return nil, fmt.Errorf("invalid max concurrent tasks of peak window: %s", item)
+		}
+		windows = append(windows, peakWindow{start: start, end: end, maxConcurrent: maxConcurrent})
+	}
+	return windows, nil
+}
+
+// parseClock parses the time of day in the form of "15:04" to the offset from midnight.
+func parseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day: %s", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// peakConcurrency returns the minimum limit of the peak windows which contain the time, false if the time is not in
+// any peak window.
+func peakConcurrency(windows []peakWindow, t time.Time) (int, bool) {
+	limit, found := 0, false
+	for _, w := range windows {
+		if !w.contains(t) {
+			continue
+		}
+		if !found || w.maxConcurrent < limit {
+			limit = w.maxConcurrent
+		}
+		found = true
+	}
+	return limit, found
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parsePeakWindows(t *testing.T) {
+	windows, err := parsePeakWindows("")
+	assert.NoError(t, err)
+	assert.Nil(t, windows)
+
+	windows, err = parsePeakWindows("09:00-18:00=2, 22:00-02:30=4")
+	assert.NoError(t, err)
+	assert.Equal(t, []peakWindow{
+		{start: 9 * time.Hour, end: 18 * time.Hour, maxConcurrent: 2},
+		{start: 22 * time.Hour, end: 2*time.Hour + 30*time.Minute, maxConcurrent: 4},
+	}, windows)
+
+	for _, value := range []string{"09:00-18:00", "09:00=2", "9-18=2", "09:00-18:00=0", "09:00-18:00=a"} {
+		_, err = parsePeakWindows(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func Test_peakConcurrency(t *testing.T) {
+	windows, err := parsePeakWindows("09:00-18:00=4,12:00-13:00=1,22:00-02:00=2")
+	assert.NoError(t, err)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2022, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+	limit, ok := peakConcurrency(windows, at(10, 0))
+	assert.True(t, ok)
+	assert.Equal(t, 4, limit)
+	// the minimum limit of the overlapping windows applies.
+	limit, ok = peakConcurrency(windows, at(12, 30))
+	assert.True(t, ok)
+	assert.Equal(t, 1, limit)
+	// the window crosses midnight.
+	limit, ok = peakConcurrency(windows, at(1, 0))
+	assert.True(t, ok)
+	assert.Equal(t, 2, limit)
+	_, ok = peakConcurrency(windows, at(18, 0))
+	assert.False(t, ok)
+	_, ok = peakConcurrency(nil, at(10, 0))
+	assert.False(t, ok)
+}
+
+func TestIndexBuilder_PeakWindows(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.maxConcurrentTasksPerNode = 10
+
+	now := time.Now()
+	assert.Equal(t, 10, ib.concurrencyLimit(now))
+
+	// the lower limit of the peak window applies.
+	ib.setPeakWindows("00:00-00:00=1")
+	ib.peakWindows[0].end = 24 * time.Hour
+	assert.Equal(t, 1, ib.concurrencyLimit(now))
+	ib.maxConcurrentTasksPerNode = 0
+	assert.Equal(t, 1, ib.concurrencyLimit(now))
+
+	// the node with an in-progress task is busy in the peak window.
+	task, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	ib.setTaskState(task, indexTaskInProgress)
+	task.nodeID = 100
+	_, busy := ib.getBusyNodes()[100]
+	assert.True(t, busy)
+
+	// the invalid value is ignored.
+	ib.setPeakWindows("invalid")
+	assert.Equal(t, 1, len(ib.peakWindows))
+
+	ib.setPeakWindows("")
+	assert.Equal(t, 0, ib.concurrencyLimit(now))
+	assert.Equal(t, 0, len(ib.getBusyNodes()))
+}
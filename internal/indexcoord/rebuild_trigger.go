@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"go.uber.org/zap"
+)
+
+// rebuildTrigger rebuilds the finished indexes in the background, either when they have been finished for the
+// rebuild interval, or when the ratio of the rows deleted from the segment since the index was built reaches the
+// delete ratio. The rebuilds are enqueued to the index builder with rebuildTaskPriority.
+type rebuildTrigger struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	ic            *IndexCoord
+	meta          *metaTable
+	builder       *indexBuilder
+	checkInterval time.Duration
+
+	// interval is the time since the index was finished after which it is rebuilt, zero means no periodic rebuild.
+	interval time.Duration
+	// deleteRatio is the ratio of the deleted rows after which the index is rebuilt, zero means never.
+	deleteRatio float64
+	// maxTasks is the maximum number of indexes to rebuild in each check, zero means no limit.
+	maxTasks int
+
+	// IndexMeta has no build time, finishTime records the time when the index was first seen finished, it restarts
+	// after IndexCoord restarts.
+	finishTime map[UniqueID]time.Time
+	// builtDeletedRows records the deleted rows of the segment when the index was rebuilt, so that the index is not
+	// rebuilt again until more rows are deleted.
+	builtDeletedRows map[UniqueID]int64
+}
+
+func newRebuildTrigger(ctx context.Context, ic *IndexCoord, meta *metaTable, builder *indexBuilder) *rebuildTrigger {
+	ctx, cancel := context.WithCancel(ctx)
+	return &rebuildTrigger{
+		ctx:              ctx,
+		cancel:           cancel,
+		ic:               ic,
+		meta:             meta,
+		builder:          builder,
+		checkInterval:    rebuildCheckInterval,
+		interval:         Params.IndexCoordCfg.RebuildInterval,
+		deleteRatio:      Params.IndexCoordCfg.RebuildDeleteRatio,
+		maxTasks:         int(Params.IndexCoordCfg.RebuildMaxTasks),
+		finishTime:       make(map[UniqueID]time.Time),
+		builtDeletedRows: make(map[UniqueID]int64),
+	}
+}
+
+func (rt *rebuildTrigger) enabled() bool {
+	return rt.interval > 0 || rt.deleteRatio > 0
+}
+
+func (rt *rebuildTrigger) Start() {
+	if !rt.enabled() {
+		log.Info("IndexCoord periodic index rebuild is disabled")
+		return
+	}
+	rt.wg.Add(1)
+	go rt.loop()
+}
+
+func (rt *rebuildTrigger) Stop() {
+	rt.cancel()
+	rt.wg.Wait()
+}
+
+func (rt *rebuildTrigger) loop() {
+	defer rt.wg.Done()
+	log.Info("IndexCoord rebuildTrigger start", zap.Duration("interval", rt.interval),
+		zap.Float64("delete ratio", rt.deleteRatio))
+
+	ticker := time.NewTicker(rt.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-rt.ctx.Done():
+			log.Info("IndexCoord rebuildTrigger context has done")
+			return
+		case <-ticker.C:
+			rt.check(time.Now())
+		}
+	}
+}
+
+// check enqueues the rebuilds of the indexes which need to be rebuilt, it returns the build ids of them.
+func (rt *rebuildTrigger) check(now time.Time) []UniqueID {
+	metas := rt.meta.GetAllIndexMeta()
+	finished := make(map[UniqueID]*indexpb.IndexMeta)
+	for buildID, indexMeta := range metas {
+		// the index whose segment reference lock is not released is still being processed by the index builder.
+		// the shadow builds are only evaluated, they are not kept fresh.
+		if indexMeta.State == commonpb.IndexState_Finished && !indexMeta.MarkDeleted && indexMeta.NodeID == 0 &&
+			!indexMeta.GetReq().GetShadowBuild() {
+			finished[buildID] = indexMeta
+		}
+	}
+	for buildID := range rt.finishTime {
+		if _, ok := finished[buildID]; !ok {
+			delete(rt.finishTime, buildID)
+		}
+	}
+	// the deleted rows are kept while the index is being rebuilt.
+	for buildID := range rt.builtDeletedRows {
+		if indexMeta, ok := metas[buildID]; !ok || indexMeta.MarkDeleted {
+			delete(rt.builtDeletedRows, buildID)
+		}
+	}
+
+	deletedRows := rt.getDeletedRows(finished)
+	candidates := make([]UniqueID, 0)
+	for buildID, indexMeta := range finished {
+		finishTime, ok := rt.finishTime[buildID]
+		if !ok {
+			finishTime = now
+			rt.finishTime[buildID] = now
+		}
+		if rt.interval > 0 && now.Sub(finishTime) >= rt.interval {
+			candidates = append(candidates, buildID)
+			continue
+		}
+		if rows, ok := deletedRows[indexMeta.GetReq().GetSegmentID()]; ok && rt.deleteRatio > 0 {
+			numRows := indexMeta.GetReq().GetNumRows()
+			if numRows > 0 && float64(rows-rt.builtDeletedRows[buildID])/float64(numRows) >= rt.deleteRatio {
+				candidates = append(candidates, buildID)
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i] < candidates[j]
+	})
+	if rt.maxTasks > 0 && len(candidates) > rt.maxTasks {
+		candidates = candidates[:rt.maxTasks]
+	}
+
+	rebuilt := make([]UniqueID, 0, len(candidates))
+	for _, buildID := range candidates {
+		// the rebuilt indexes are enqueued together, they are not tracked by the index builder yet.
+		if err := rt.builder.acceptNewTasks(len(rebuilt) + 1); err != nil {
+			log.Warn("IndexCoord rebuildTrigger stop rebuilding the indexes, wait to retry", zap.Error(err))
+			break
+		}
+		if err := rt.meta.ResetForRebuild(buildID); err != nil {
+			log.Warn("IndexCoord rebuildTrigger reset index meta failed, wait to retry", zap.Int64("buildID", buildID),
+				zap.Error(err))
+			continue
+		}
+		delete(rt.finishTime, buildID)
+		if rows, ok := deletedRows[finished[buildID].GetReq().GetSegmentID()]; ok {
+			rt.builtDeletedRows[buildID] = rows
+		}
+		log.Info("IndexCoord rebuildTrigger rebuild the index", zap.Int64("buildID", buildID),
+			zap.Int64("segmentID", finished[buildID].GetReq().GetSegmentID()))
+		rebuilt = append(rebuilt, buildID)
+	}
+	if err := rt.builder.enqueueBatch(rebuilt, rebuildTaskPriority); err != nil {
+		// the meta is reset, the tasks are recovered when the tasks are reconciled with meta.
+		log.Warn("IndexCoord rebuildTrigger enqueue the rebuilt indexes failed", zap.Int64s("buildIDs", rebuilt),
+			zap.Error(err))
+		return nil
+	}
+	return rebuilt
+}
+
+// getDeletedRows returns the deleted rows of the segments of the finished indexes, it returns nil if the indexes are
+// not rebuilt by the delete ratio or DataCoord fails to return them.
+func (rt *rebuildTrigger) getDeletedRows(finished map[UniqueID]*indexpb.IndexMeta) map[UniqueID]int64 {
+	if rt.deleteRatio <= 0 || len(finished) == 0 {
+		return nil
+	}
+	segmentIDs := make([]UniqueID, 0, len(finished))
+	for _, indexMeta := range finished {
+		segmentIDs = append(segmentIDs, indexMeta.GetReq().GetSegmentID())
+	}
+	deletedRows, err := rt.ic.getDeletedRows(rt.ctx, segmentIDs)
+	if err != nil {
+		log.Warn("IndexCoord rebuildTrigger get the deleted rows of segments failed", zap.Error(err))
+		return nil
+	}
+	return deletedRows
+}
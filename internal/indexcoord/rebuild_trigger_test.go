@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func createRebuildTrigger(dataCoord *DataCoordMock) (*rebuildTrigger, *metaTable, *indexBuilder) {
+	ctx := context.Background()
+	ic := newTestIndexCoord(ctx, withDataCoord(dataCoord))
+	mt := createMetaTable()
+	for _, buildID := range []UniqueID{8, 9} {
+		mt.indexBuildID2Meta[buildID] = &Meta{
+			indexMeta: &indexpb.IndexMeta{
+				IndexBuildID: buildID,
+				State:        commonpb.IndexState_Finished,
+				Req: &indexpb.BuildIndexRequest{
+					SegmentID: buildID,
+					NumRows:   100,
+				},
+			},
+		}
+	}
+	ib := newIndexBuilder(ctx, ic, mt, []UniqueID{1, 2})
+	rt := newRebuildTrigger(ctx, ic, mt, ib)
+	return rt, mt, ib
+}
+
+func deltalogs(rows int64) []*datapb.FieldBinlog {
+	return []*datapb.FieldBinlog{
+		{
+			Binlogs: []*datapb.Binlog{{EntriesNum: rows}},
+		},
+	}
+}
+
+func TestRebuildTrigger_Interval(t *testing.T) {
+	rt, mt, ib := createRebuildTrigger(&DataCoordMock{})
+	rt.interval = time.Hour
+	assert.True(t, rt.enabled())
+	mt.indexBuildID2Meta[8].indexMeta.CheckpointPath = "checkpoints/8/1"
+
+	now := time.Now()
+	assert.Empty(t, rt.check(now))
+	assert.Empty(t, rt.check(now.Add(time.Minute)))
+	assert.Equal(t, []UniqueID{8, 9}, rt.check(now.Add(2*time.Hour)))
+
+	meta, ok := mt.GetMeta(8)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Unissued, meta.indexMeta.State)
+	// the rebuild starts from scratch.
+	assert.Equal(t, "", meta.indexMeta.GetCheckpointPath())
+	task, ok := ib.tasks.Get(8)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskInit, task.state)
+	assert.Equal(t, rebuildTaskPriority, task.priority)
+	assert.Equal(t, "", task.checkpointPath)
+
+	// the indexes being rebuilt are not rebuilt again.
+	assert.Empty(t, rt.check(now.Add(4*time.Hour)))
+}
+
+func TestRebuildTrigger_DeleteRatio(t *testing.T) {
+	dataCoord := &DataCoordMock{
+		SegmentInfos: map[UniqueID]*datapb.SegmentInfo{
+			8: {ID: 8, Deltalogs: deltalogs(30)},
+			9: {ID: 9, Deltalogs: deltalogs(10)},
+		},
+	}
+	rt, mt, ib := createRebuildTrigger(dataCoord)
+	rt.deleteRatio = 0.2
+
+	now := time.Now()
+	assert.Equal(t, []UniqueID{8}, rt.check(now))
+
+	// the rebuilt index is not rebuilt again until more rows are deleted.
+	mt.indexBuildID2Meta[8].indexMeta.State = commonpb.IndexState_Finished
+	ib.markTaskAsDeleted(8)
+	assert.Empty(t, rt.check(now))
+	dataCoord.SegmentInfos[8] = &datapb.SegmentInfo{ID: 8, Deltalogs: deltalogs(50)}
+	assert.Equal(t, []UniqueID{8}, rt.check(now))
+
+	// the deleted rows are not known.
+	dataCoord.Err = true
+	dataCoord.SegmentInfos[9] = &datapb.SegmentInfo{ID: 9, Deltalogs: deltalogs(50)}
+	assert.Empty(t, rt.check(now))
+}
+
+func TestRebuildTrigger_MaxTasks(t *testing.T) {
+	rt, _, _ := createRebuildTrigger(&DataCoordMock{})
+	rt.interval = time.Hour
+	rt.maxTasks = 1
+
+	now := time.Now()
+	rt.check(now)
+	assert.Equal(t, []UniqueID{8}, rt.check(now.Add(2*time.Hour)))
+	assert.Equal(t, []UniqueID{9}, rt.check(now.Add(2*time.Hour)))
+}
+
+func TestRebuildTrigger_MaxTrackedTasks(t *testing.T) {
+	rt, _, ib := createRebuildTrigger(&DataCoordMock{})
+	rt.interval = time.Hour
+	// the index builder tracks 6 tasks, only one more rebuild is accepted.
+	ib.maxTrackedTasks = ib.tasks.Len() + 1
+
+	now := time.Now()
+	rt.check(now)
+	select {
+	case <-ib.notifyChan:
+	default:
+	}
+	assert.Equal(t, []UniqueID{8}, rt.check(now.Add(2*time.Hour)))
+	// the rebuilt indexes are enqueued in a batch, the scheduler is notified once.
+	assert.Equal(t, 1, len(ib.notifyChan))
+	assert.True(t, ib.hasTask(8))
+	assert.False(t, ib.hasTask(9))
+}
+
+func TestRebuildTrigger_Disabled(t *testing.T) {
+	rt, _, _ := createRebuildTrigger(&DataCoordMock{})
+	rt.interval = 0
+	rt.deleteRatio = 0
+	assert.False(t, rt.enabled())
+	rt.Start()
+	rt.Stop()
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SchedulerHealth is a snapshot of the health of the index scheduler.
+type SchedulerHealth struct {
+	// Running means the schedule loop of the index builder is running.
+	Running bool
+	// LastRunTime is the time when a schedule run was completed last time, zero if no run has been completed.
+	LastRunTime time.Time
+	// BacklogGrowing means the number of tasks keeps increasing while no tasks are completed.
+	BacklogGrowing bool
+}
+
+// schedulerHealthTracker records the health of the schedule loop. It has its own lock, so the health can still be
+// checked when the schedule loop is stuck with the taskMutex held.
+type schedulerHealthTracker struct {
+	lock             sync.Mutex
+	running          bool
+	startTime        time.Time
+	lastRunTime      time.Time
+	scheduleDuration time.Duration
+
+	completed     int64
+	lastBacklog   int
+	lastCompleted int64
+	// growthRuns is the number of consecutive runs in which the backlog grows without completed tasks.
+	growthRuns int
+}
+
+func (t *schedulerHealthTracker) start(scheduleDuration time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.running = true
+	t.startTime = time.Now()
+	t.scheduleDuration = scheduleDuration
+}
+
+func (t *schedulerHealthTracker) stop() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.running = false
+}
+
+func (t *schedulerHealthTracker) setScheduleDuration(scheduleDuration time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.scheduleDuration = scheduleDuration
+}
+
+// taskCompleted records a task which reaches its final state.
+func (t *schedulerHealthTracker) taskCompleted() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.completed++
+}
+
+// runCompleted records a completed schedule run with the number of tasks at the beginning of the run.
+func (t *schedulerHealthTracker) runCompleted(backlog int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.lastRunTime = time.Now()
+	if t.completed != t.lastCompleted || backlog < t.lastBacklog {
+		t.growthRuns = 0
+	} else if backlog > t.lastBacklog {
+		t.growthRuns++
+	}
+	t.lastBacklog = backlog
+	t.lastCompleted = t.completed
+}
+
+func (t *schedulerHealthTracker) snapshot() SchedulerHealth {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return SchedulerHealth{
+		Running:        t.running,
+		LastRunTime:    t.lastRunTime,
+		BacklogGrowing: t.growthRuns >= backlogGrowthRuns,
+	}
+}
+
+// check returns nil if the scheduler is healthy, otherwise the reason. The scheduler runs at least once every
+// scheduleDuration, so it is stuck if no run has been completed for one more scheduleDuration.
+func (t *schedulerHealthTracker) check() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.running {
+		return fmt.Errorf("index scheduler is not running")
+	}
+	lastActive := t.lastRunTime
+	if lastActive.IsZero() {
+		lastActive = t.startTime
+	}
+	if idle := time.Since(lastActive); idle > 2*t.scheduleDuration {
+		return fmt.Errorf("index scheduler has not completed a run for %s", idle)
+	}
+	if t.growthRuns >= backlogGrowthRuns {
+		return fmt.Errorf("index task backlog keeps growing for %d runs without completed tasks", t.growthRuns)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerHealthTracker(t *testing.T) {
+	tracker := &schedulerHealthTracker{}
+	assert.Error(t, tracker.check())
+
+	tracker.start(time.Millisecond * 50)
+	assert.NoError(t, tracker.check())
+	assert.True(t, tracker.snapshot().Running)
+	assert.True(t, tracker.snapshot().LastRunTime.IsZero())
+
+	// the scheduler is stuck.
+	time.Sleep(time.Millisecond * 120)
+	assert.Error(t, tracker.check())
+
+	tracker.runCompleted(1)
+	assert.NoError(t, tracker.check())
+	assert.False(t, tracker.snapshot().LastRunTime.IsZero())
+
+	// the backlog grows without completed tasks.
+	for i := 0; i < backlogGrowthRuns; i++ {
+		tracker.runCompleted(i + 2)
+	}
+	assert.True(t, tracker.snapshot().BacklogGrowing)
+	assert.Error(t, tracker.check())
+
+	tracker.taskCompleted()
+	tracker.runCompleted(backlogGrowthRuns + 2)
+	assert.False(t, tracker.snapshot().BacklogGrowing)
+	assert.NoError(t, tracker.check())
+
+	tracker.stop()
+	assert.False(t, tracker.snapshot().Running)
+	assert.Error(t, tracker.check())
+}
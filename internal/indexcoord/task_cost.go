@@ -0,0 +1,34 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// TaskCostEstimator estimates the work to build the index of the task, the IndexNodes are balanced by the estimated
+// work of their tasks rather than the number of tasks.
+type TaskCostEstimator func(meta *indexpb.IndexMeta) int64
+
+// rowCountCost is the default TaskCostEstimator, the cost is proportional to the number of rows of the segment.
+func rowCountCost(meta *indexpb.IndexMeta) int64 {
+	if rows := meta.GetReq().GetNumRows(); rows > 0 {
+		return rows
+	}
+	// the task without the number of rows still takes a task slot.
+	return 1
+}
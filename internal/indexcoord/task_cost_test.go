@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func Test_rowCountCost(t *testing.T) {
+	assert.Equal(t, int64(100), rowCountCost(&indexpb.IndexMeta{Req: &indexpb.BuildIndexRequest{NumRows: 100}}))
+	assert.Equal(t, int64(1), rowCountCost(&indexpb.IndexMeta{}))
+}
+
+func TestIndexBuilder_BalanceByCost(t *testing.T) {
+	mt := createMetaTable()
+	ib := newTestIndexBuilder(mt, withIndexNodes(map[UniqueID]types.IndexNode{
+		1: &indexnode.Mock{},
+		4: &indexnode.Mock{},
+	}))
+	ib.maxConcurrentTasksPerNode = 0
+	ib.balanceByCost = true
+
+	// the recovered in-progress task on node 1 has the work of its rows.
+	task4, ok := ib.tasks.Get(4)
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), task4.cost)
+	ib.SetCostEstimator(func(meta *indexpb.IndexMeta) int64 {
+		return 1000
+	})
+
+	// the task is assigned to node 4 which has less work, though node 1 built it last time.
+	task, ok := ib.tasks.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), task.preferNodeID)
+	ib.process(3)
+	assert.Equal(t, indexTaskInit, task.state)
+	ib.process(3)
+	assert.Equal(t, indexTaskInProgress, task.state)
+	assert.Equal(t, UniqueID(4), task.nodeID)
+	assert.Equal(t, int64(1000), task.cost)
+
+	ib.taskMutex.RLock()
+	assert.Equal(t, map[UniqueID]int64{1: 100, 4: 1000}, ib.getNodeLoads())
+	ib.updateTaskMetrics()
+	ib.taskMutex.RUnlock()
+	assert.Equal(t, float64(1000), testutil.ToFloat64(metrics.IndexCoordIndexNodeEstimatedLoad.WithLabelValues("4")))
+
+	ib.SetCostEstimator(nil)
+	assert.Equal(t, int64(100), ib.estimateCost(&indexpb.IndexMeta{Req: &indexpb.BuildIndexRequest{NumRows: 100}}))
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// taskMutexSection is a critical section holding the taskMutex, the hold time of the sections is exported, so the
+// contention added to the schedule loop and the enqueued requests is found.
+type taskMutexSection int
+
+const (
+	runSection taskMutexSection = iota
+	processSection
+	enqueueSection
+	refreshSection
+	reconcileSection
+	cancelSection
+	livenessSection
+	numTaskMutexSections
+)
+
+var taskMutexSectionNames = [numTaskMutexSections]string{
+	runSection:       "run",
+	processSection:   "process",
+	enqueueSection:   "enqueue",
+	refreshSection:   "refresh",
+	reconcileSection: "reconcile",
+	cancelSection:    "cancel",
+	livenessSection:  "liveness",
+}
+
+func (s taskMutexSection) String() string {
+	return taskMutexSectionNames[s]
+}
+
+// taskMutexStats records the hold time of the taskMutex by section. The hold times are observed by a histogram, which
+// gives the average, and the maximum hold time of each section is kept until it is exported. It is updated by atomic
+// operations only, so it adds no contention to the sections it measures.
+type taskMutexStats struct {
+	// maxHoldTime is the maximum hold time of each section in nanoseconds since the last export.
+	maxHoldTime [numTaskMutexSections]int64
+}
+
+func (s *taskMutexStats) observe(section taskMutexSection, holdTime time.Duration) {
+	metrics.IndexCoordIndexBuilderTaskMutexHoldDuration.WithLabelValues(section.String()).Observe(holdTime.Seconds())
+	for {
+		maxHoldTime := atomic.LoadInt64(&s.maxHoldTime[section])
+		if int64(holdTime) <= maxHoldTime ||
+			atomic.CompareAndSwapInt64(&s.maxHoldTime[section], maxHoldTime, int64(holdTime)) {
+			return
+		}
+	}
+}
+
+// export sets the gauges to the maximum hold time of each section since the last export, and starts over.
+func (s *taskMutexStats) export() {
+	for section := taskMutexSection(0); section < numTaskMutexSections; section++ {
+		maxHoldTime := time.Duration(atomic.SwapInt64(&s.maxHoldTime[section], 0))
+		metrics.IndexCoordIndexBuilderTaskMutexMaxHoldTime.WithLabelValues(section.String()).Set(maxHoldTime.Seconds())
+	}
+}
+
+// lockTasks holds the taskMutex for the section, the returned function releases it and records the hold time. The
+// hold time is measured by the wall clock, since it is not the time of the tasks.
+func (ib *indexBuilder) lockTasks(section taskMutexSection) func() {
+	ib.taskMutex.Lock()
+	start := time.Now()
+	return func() {
+		holdTime := time.Since(start)
+		ib.taskMutex.Unlock()
+		ib.taskMutexStats.observe(section, holdTime)
+	}
+}
+
+// rlockTasks is lockTasks with the read lock.
+func (ib *indexBuilder) rlockTasks(section taskMutexSection) func() {
+	ib.taskMutex.RLock()
+	start := time.Now()
+	return func() {
+		holdTime := time.Since(start)
+		ib.taskMutex.RUnlock()
+		ib.taskMutexStats.observe(section, holdTime)
+	}
+}
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+func TestTaskMutexStats(t *testing.T) {
+	stats := &taskMutexStats{}
+	stats.observe(processSection, time.Millisecond)
+	stats.observe(processSection, time.Millisecond*3)
+	stats.observe(processSection, time.Millisecond*2)
+	stats.observe(enqueueSection, time.Second)
+
+	stats.export()
+	assert.Equal(t, 0.003, testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskMutexMaxHoldTime.WithLabelValues("process")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskMutexMaxHoldTime.WithLabelValues("enqueue")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskMutexMaxHoldTime.WithLabelValues("run")))
+
+	// the maximum hold time starts over after it is exported.
+	stats.observe(processSection, time.Millisecond)
+	stats.export()
+	assert.Equal(t, 0.001, testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskMutexMaxHoldTime.WithLabelValues("process")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.IndexCoordIndexBuilderTaskMutexMaxHoldTime.WithLabelValues("enqueue")))
+}
+
+func TestIndexBuilder_TaskMutexHoldTime(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	ib.Pause()
+	defer ib.Resume()
+
+	sampleCount := func(section taskMutexSection) uint64 {
+		m := &dto.Metric{}
+		err := metrics.IndexCoordIndexBuilderTaskMutexHoldDuration.WithLabelValues(section.String()).(prometheus.Histogram).Write(m)
+		assert.NoError(t, err)
+		return m.GetHistogram().GetSampleCount()
+	}
+	enqueueCount, runCount, processCount := sampleCount(enqueueSection), sampleCount(runSection), sampleCount(processSection)
+	assert.NoError(t, ib.enqueue(2))
+	ib.run()
+	assert.Equal(t, enqueueCount+1, sampleCount(enqueueSection))
+	assert.Equal(t, runCount+1, sampleCount(runSection))
+	assert.Greater(t, sampleCount(processSection), processCount)
+}
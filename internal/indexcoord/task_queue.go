@@ -0,0 +1,295 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"container/heap"
+	"sort"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	// rebuildTaskPriority is the priority of the periodic rebuilds, they are assigned after all the other tasks.
+	rebuildTaskPriority = -1
+	// defaultTaskPriority is the priority of the background index tasks, such as the tasks triggered by flush.
+	defaultTaskPriority = 0
+	// retryTaskPriority is the minimum priority of the tasks that need to be reassigned.
+	retryTaskPriority = 1
+	// userTaskPriority is the default priority of the tasks triggered by users, such as the manual rebuilds.
+	userTaskPriority = 2
+)
+
+// indexTask records the state and the scheduling priority of an index build task.
+type indexTask struct {
+	buildID UniqueID
+	state   indexTaskState
+
+	nodeID      UniqueID  // The IndexNode which the task is assigned to, zero if the task is not assigned.
+	cost        int64     // The estimated work of the task, it is estimated again when the task is assigned.
+	enqueueTime time.Time // The time when the task was added to the index builder.
+
+	retryCount int    // The number of times the task has been reassigned.
+	failReason string // The reason of the last failure, which is recorded to meta when the task fails.
+
+	lastRetryTime time.Time     // The time when the task went into retry state.
+	failTime      time.Time     // The time when the task went into failed state.
+	retryDelay    time.Duration // The backoff delay before the task can be reassigned again.
+	retryJitter   time.Duration // The random delay added to retryDelay, so that the retries of many tasks spread out.
+
+	inProgressTime time.Time // The time when the task was assigned to IndexNode, zero if it is unknown.
+	lastActiveTime time.Time // The time when the task was assigned or its IndexNode reported progress.
+	progress       *float32  // The fraction of the rows built by the IndexNode, nil if it is not reported.
+
+	preferNodeID UniqueID // The IndexNode which the task was assigned to last time, zero if there is no preference.
+	// checkpointPath is the last checkpoint of the build reported by the IndexNode, the build is resumed from it when
+	// the task is reassigned, empty if there is none.
+	checkpointPath string
+
+	// The index which the task builds, they are cached from meta when the task is added, so the tasks can be listed
+	// without looking up meta for each of them.
+	indexID     UniqueID
+	indexName   string
+	indexType   string
+	indexParams string // The summary of the build params, see summarizeIndexParams.
+	segmentID   UniqueID
+	shadow      bool // Whether the task is a shadow build.
+	// segmentOrigin is how the segment is created, such as flushed or imported.
+	segmentOrigin string
+	collectionID  UniqueID // The collection of the segment, zero if it is unknown, such as the metas of old versions.
+
+	// failedNodes are the IndexNodes which failed the task in the current retry cycle, the task is not reassigned to
+	// them while other IndexNodes are available.
+	failedNodes map[UniqueID]struct{}
+	deferUntil  time.Time // The time before which the task is not assigned, it is set when only failedNodes are available.
+
+	blockedReason string // The reason why the task in init state is not assigned last time, empty if it is unknown.
+
+	// lastError is the error which the processing of the task failed on last time, such as the failure to assign the
+	// task or to update meta, it is kept after the task recovers, lastErrorTime tells whether it is stale.
+	lastError     string
+	lastErrorTime time.Time
+
+	lockAcquireTime time.Time // The time when the segment reference lock was acquired, zero if it is unknown.
+	assignedVersion int64     // The index version of the latest assignment, zero if it is unknown.
+
+	releaseFailCount    int           // The number of failed attempts to release the segment reference lock.
+	lastReleaseFailTime time.Time     // The time of the last failed attempt to release the segment reference lock.
+	releaseDelay        time.Duration // The delay before the next attempt to release the segment reference lock.
+
+	pausedState indexTaskState // The state which the paused task returns to when it is resumed.
+	pauseTime   time.Time      // The time when the task was paused.
+
+	span opentracing.Span // The span of the task, it is started when the task is added and finished when the task is removed.
+
+	priority int // The task with higher priority is processed first by the priority ordering policy.
+	index    int // The index of the task in the heap, maintained by the heap.Interface methods.
+}
+
+// takesTaskSlot returns whether the task takes a task slot of its IndexNode, the task being assigned is still in init
+// state, but its IndexNode has been chosen. The paused task keeps building on the IndexNode.
+func (t *indexTask) takesTaskSlot() bool {
+	if t.nodeID == 0 {
+		return false
+	}
+	state := t.effectiveState()
+	return state == indexTaskInit || state == indexTaskInProgress
+}
+
+// effectiveState returns the state which the task is processed as, the paused task is processed as the state it
+// returns to when it is resumed.
+func (t *indexTask) effectiveState() indexTaskState {
+	if t.state == indexTaskPaused {
+		return t.pausedState
+	}
+	return t.state
+}
+
+// isUnfinished reports whether the task of the active index is waiting to be built or being built, the shadow build
+// is not counted since it does not replace the active index.
+func (t *indexTask) isUnfinished() bool {
+	state := t.effectiveState()
+	return !t.shadow && (state == indexTaskInit || state == indexTaskInProgress || state == indexTaskRetry)
+}
+
+// lessTask reports whether task a should be processed before task b.
+// When the priority is the same, the task enqueued earlier is preferred, so the older background builds are not
+// passed by the newer ones, and then the task with smaller buildID.
+func lessTask(a, b *indexTask) bool {
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	if !a.enqueueTime.Equal(b.enqueueTime) {
+		return a.enqueueTime.Before(b.enqueueTime)
+	}
+	return a.buildID < b.buildID
+}
+
+// taskHeap implements heap.Interface, the task which the ordering policy processes first is on the top.
+type taskHeap struct {
+	tasks  []*indexTask
+	policy OrderingPolicy
+}
+
+func (h *taskHeap) Len() int {
+	return len(h.tasks)
+}
+
+func (h *taskHeap) Less(i, j int) bool {
+	return h.policy.Less(h.tasks[i], h.tasks[j])
+}
+
+func (h *taskHeap) Swap(i, j int) {
+	h.tasks[i], h.tasks[j] = h.tasks[j], h.tasks[i]
+	h.tasks[i].index = i
+	h.tasks[j].index = j
+}
+
+func (h *taskHeap) Push(x interface{}) {
+	task := x.(*indexTask)
+	task.index = len(h.tasks)
+	h.tasks = append(h.tasks, task)
+}
+
+func (h *taskHeap) Pop() interface{} {
+	old := h.tasks
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	task.index = -1 // for safety
+	h.tasks = old[0 : n-1]
+	return task
+}
+
+// taskQueue is a priority queue of index build tasks ordered by the OrderingPolicy, which also supports looking up
+// a task by buildID.
+// taskQueue is not thread safe, the caller should protect it with a lock.
+type taskQueue struct {
+	heap  taskHeap
+	tasks map[UniqueID]*indexTask
+}
+
+func newTaskQueue(policy OrderingPolicy) *taskQueue {
+	return &taskQueue{
+		heap:  taskHeap{tasks: make([]*indexTask, 0, 1024), policy: policy},
+		tasks: make(map[UniqueID]*indexTask, 1024),
+	}
+}
+
+// Len returns the number of tasks in the queue.
+func (tq *taskQueue) Len() int {
+	return len(tq.tasks)
+}
+
+// Push adds the task to the queue, the task with the same buildID will be replaced.
+func (tq *taskQueue) Push(task *indexTask) {
+	if old, ok := tq.tasks[task.buildID]; ok {
+		heap.Remove(&tq.heap, old.index)
+	}
+	tq.tasks[task.buildID] = task
+	heap.Push(&tq.heap, task)
+}
+
+// Update changes the priority of the task and fixes its position in the queue, it returns false if the task doesn't
+// exist.
+func (tq *taskQueue) Update(buildID UniqueID, priority int) bool {
+	task, ok := tq.tasks[buildID]
+	if !ok {
+		return false
+	}
+	task.priority = priority
+	heap.Fix(&tq.heap, task.index)
+	return true
+}
+
+// Get returns the task of the buildID.
+func (tq *taskQueue) Get(buildID UniqueID) (*indexTask, bool) {
+	task, ok := tq.tasks[buildID]
+	return task, ok
+}
+
+// Pop removes the task which the ordering policy processes first from the queue and returns it, nil is returned if
+// the queue is empty.
+func (tq *taskQueue) Pop() *indexTask {
+	if tq.heap.Len() == 0 {
+		return nil
+	}
+	task := heap.Pop(&tq.heap).(*indexTask)
+	delete(tq.tasks, task.buildID)
+	return task
+}
+
+// Remove removes the task of the buildID from the queue.
+func (tq *taskQueue) Remove(buildID UniqueID) {
+	task, ok := tq.tasks[buildID]
+	if !ok {
+		return
+	}
+	heap.Remove(&tq.heap, task.index)
+	delete(tq.tasks, buildID)
+}
+
+// SetPolicy changes the ordering policy of the queue and reorders the tasks.
+func (tq *taskQueue) SetPolicy(policy OrderingPolicy) {
+	tq.heap.policy = policy
+	heap.Init(&tq.heap)
+}
+
+// interleaveTasks reorders the buildIDs in rounds, each group takes at most window tasks in a round, so that a group
+// with a large number of tasks does not starve the others. The order within a group and the order of the groups
+// follow the original order.
+func interleaveTasks(buildIDs []UniqueID, groups map[UniqueID]UniqueID, window int) []UniqueID {
+	groupOrder := make([]UniqueID, 0)
+	groupTasks := make(map[UniqueID][]UniqueID)
+	for _, buildID := range buildIDs {
+		group := groups[buildID]
+		if _, ok := groupTasks[group]; !ok {
+			groupOrder = append(groupOrder, group)
+		}
+		groupTasks[group] = append(groupTasks[group], buildID)
+	}
+
+	ordered := make([]UniqueID, 0, len(buildIDs))
+	for len(ordered) < len(buildIDs) {
+		for _, group := range groupOrder {
+			tasks := groupTasks[group]
+			n := window
+			if n > len(tasks) {
+				n = len(tasks)
+			}
+			ordered = append(ordered, tasks[:n]...)
+			groupTasks[group] = tasks[n:]
+		}
+	}
+	return ordered
+}
+
+// BuildIDs returns the buildIDs of all tasks in the order of the queue, a copy of the tasks is sorted once, so the
+// queue is not modified. The callers which don't need the order range over the tasks instead.
+func (tq *taskQueue) BuildIDs() []UniqueID {
+	tasks := make([]*indexTask, len(tq.heap.tasks))
+	copy(tasks, tq.heap.tasks)
+	sort.Slice(tasks, func(i, j int) bool {
+		return tq.heap.policy.Less(tasks[i], tasks[j])
+	})
+	buildIDs := make([]UniqueID, 0, len(tasks))
+	for _, task := range tasks {
+		buildIDs = append(buildIDs, task.buildID)
+	}
+	return buildIDs
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTaskQueue() *taskQueue {
+	tq := newTaskQueue(NewOrderingPolicy(PriorityOrderingPolicy))
+	for i := 1; i <= QueueLen; i++ {
+		tq.Push(&indexTask{
+			buildID:  UniqueID(i),
+			state:    indexTaskInit,
+			priority: defaultTaskPriority,
+		})
+	}
+	return tq
+}
+
+func TestTaskQueue_Push(t *testing.T) {
+	tq := newTestTaskQueue()
+	assert.Equal(t, QueueLen, tq.Len())
+
+	// push the same buildID again replaces the old task
+	tq.Push(&indexTask{buildID: 1, state: indexTaskRetry, priority: retryTaskPriority})
+	assert.Equal(t, QueueLen, tq.Len())
+	task, ok := tq.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, indexTaskRetry, task.state)
+}
+
+func TestTaskQueue_BuildIDs(t *testing.T) {
+	tq := newTestTaskQueue()
+	tq.Push(&indexTask{buildID: QueueLen + 1, priority: retryTaskPriority})
+
+	// the tasks are listed in the order of the heap, the task with higher priority first
+	buildIDs := tq.BuildIDs()
+	assert.Equal(t, QueueLen+1, len(buildIDs))
+	assert.Equal(t, UniqueID(QueueLen+1), buildIDs[0])
+	for i := 1; i <= QueueLen; i++ {
+		assert.Equal(t, UniqueID(i), buildIDs[i])
+	}
+	// the index of the tasks in the heap is kept
+	for i, task := range tq.heap.tasks {
+		assert.Equal(t, i, task.index)
+	}
+}
+
+func TestTaskQueue_Update(t *testing.T) {
+	tq := newTestTaskQueue()
+	assert.True(t, tq.Update(QueueLen, retryTaskPriority))
+	assert.False(t, tq.Update(QueueLen+1, retryTaskPriority))
+
+	buildIDs := tq.BuildIDs()
+	assert.Equal(t, QueueLen, len(buildIDs))
+	assert.Equal(t, UniqueID(QueueLen), buildIDs[0])
+	assert.Equal(t, UniqueID(1), buildIDs[1])
+
+	// BuildIDs doesn't modify the queue
+	assert.Equal(t, QueueLen, tq.Len())
+	assert.Equal(t, buildIDs, tq.BuildIDs())
+}
+
+func TestTaskQueue_Pop(t *testing.T) {
+	tq := newTestTaskQueue()
+	assert.True(t, tq.Update(QueueLen, retryTaskPriority))
+
+	// the tasks are popped in the order of the queue
+	buildIDs := tq.BuildIDs()
+	for _, buildID := range buildIDs {
+		task := tq.Pop()
+		assert.Equal(t, buildID, task.buildID)
+		assert.Equal(t, -1, task.index)
+		_, ok := tq.Get(buildID)
+		assert.False(t, ok)
+	}
+	assert.Equal(t, 0, tq.Len())
+	assert.Nil(t, tq.Pop())
+}
+
+func TestTaskQueue_Remove(t *testing.T) {
+	tq := newTestTaskQueue()
+	for i := 1; i <= QueueLen; i += 2 {
+		tq.Remove(UniqueID(i))
+	}
+	tq.Remove(QueueLen + 1)
+	assert.Equal(t, QueueLen/2, tq.Len())
+
+	_, ok := tq.Get(1)
+	assert.False(t, ok)
+	buildIDs := tq.BuildIDs()
+	for i := 2; i <= QueueLen; i += 2 {
+		assert.Equal(t, UniqueID(i), buildIDs[i/2-1])
+	}
+}
+
+func Test_interleaveTasks(t *testing.T) {
+	buildIDs := []UniqueID{1, 2, 3, 4, 5, 6, 7}
+	groups := map[UniqueID]UniqueID{1: 100, 2: 100, 3: 100, 4: 100, 5: 200, 6: 300}
+
+	assert.Equal(t, []UniqueID{1, 5, 6, 7, 2, 3, 4}, interleaveTasks(buildIDs, groups, 1))
+	assert.Equal(t, []UniqueID{1, 2, 5, 6, 7, 3, 4}, interleaveTasks(buildIDs, groups, 2))
+	assert.Equal(t, buildIDs, interleaveTasks(buildIDs, groups, 10))
+	assert.Empty(t, interleaveTasks(nil, groups, 1))
+}
@@ -29,6 +29,10 @@ const (
 	indexTaskRetry
 	// task has been deleted.
 	indexTaskDeleted
+	// task has failed too many times, it will not be reassigned.
+	indexTaskFailed
+	// task is held by the operator, it is not processed until it is resumed.
+	indexTaskPaused
 )
 
 var TaskStateNames = map[indexTaskState]string{
@@ -37,6 +41,35 @@ var TaskStateNames = map[indexTaskState]string{
 	2: "Done",
 	3: "Retry",
 	4: "Deleted",
+	5: "Failed",
+	6: "Paused",
+}
+
+// taskStateTransitions lists the states which the task can move to from each state, staying in the same state is
+// always allowed. The finished task is only reassigned through retry or init, such as when the index files are missing
+// or the index is rebuilt, so a late report of the IndexNode can not bring it back to in progress. The deleted task
+// never leaves its state, and the failed task can only be deleted.
+var taskStateTransitions = map[indexTaskState][]indexTaskState{
+	indexTaskInit:       {indexTaskInProgress, indexTaskDone, indexTaskRetry, indexTaskDeleted, indexTaskFailed, indexTaskPaused},
+	indexTaskInProgress: {indexTaskInit, indexTaskDone, indexTaskRetry, indexTaskDeleted, indexTaskFailed, indexTaskPaused},
+	indexTaskRetry:      {indexTaskInit, indexTaskInProgress, indexTaskDone, indexTaskDeleted, indexTaskFailed, indexTaskPaused},
+	indexTaskDone:       {indexTaskInit, indexTaskRetry, indexTaskDeleted},
+	indexTaskDeleted:    {},
+	indexTaskFailed:     {indexTaskDeleted},
+	indexTaskPaused:     {indexTaskInit, indexTaskInProgress, indexTaskDone, indexTaskRetry, indexTaskDeleted, indexTaskFailed},
+}
+
+// canTransitTo returns whether the task in this state can move to the target state.
+func (x indexTaskState) canTransitTo(target indexTaskState) bool {
+	if x == target {
+		return true
+	}
+	for _, state := range taskStateTransitions[x] {
+		if state == target {
+			return true
+		}
+	}
+	return false
 }
 
 func (x indexTaskState) String() string {
@@ -46,3 +79,8 @@ func (x indexTaskState) String() string {
 	}
 	return ret
 }
+
+// isTerminal returns whether the task has reached a final state and is waiting to be cleaned.
+func (x indexTaskState) isTerminal() bool {
+	return x == indexTaskDone || x == indexTaskDeleted || x == indexTaskFailed
+}
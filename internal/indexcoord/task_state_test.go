@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func Test_indexTaskState_canTransitTo(t *testing.T) {
+	for state := range TaskStateNames {
+		assert.True(t, state.canTransitTo(state))
+		assert.True(t, indexTaskInit.canTransitTo(state))
+		assert.False(t, indexTaskDeleted.canTransitTo(state) && state != indexTaskDeleted)
+	}
+	assert.True(t, indexTaskFailed.canTransitTo(indexTaskDeleted))
+	assert.False(t, indexTaskFailed.canTransitTo(indexTaskDone))
+	assert.False(t, indexTaskDone.canTransitTo(indexTaskInProgress))
+	assert.False(t, indexTaskDone.canTransitTo(indexTaskPaused))
+	assert.True(t, indexTaskPaused.canTransitTo(indexTaskDone))
+}
+
+func TestIndexBuilder_IllegalTransition(t *testing.T) {
+	ib := newTestIndexBuilder(createMetaTable())
+	counter := metrics.IndexCoordIndexBuilderIllegalTransitionCounter.WithLabelValues(indexTaskDone.String(),
+		indexTaskInProgress.String())
+	rejected := testutil.ToFloat64(counter)
+
+	t.Run("done to in progress", func(t *testing.T) {
+		task, ok := ib.tasks.Get(6)
+		assert.True(t, ok)
+		assert.False(t, ib.setTaskState(task, indexTaskInProgress))
+		assert.Equal(t, indexTaskDone, task.state)
+		assert.Equal(t, rejected+1, testutil.ToFloat64(counter))
+
+		assert.True(t, ib.setTaskState(task, indexTaskDone))
+		assert.True(t, ib.setTaskState(task, indexTaskRetry))
+		assert.True(t, ib.setTaskState(task, indexTaskInProgress))
+	})
+
+	t.Run("deleted task is finished", func(t *testing.T) {
+		ib.markTaskAsDeleted(4)
+		ib.updateStateByMeta(&indexpb.IndexMeta{
+			IndexBuildID: 4,
+			State:        commonpb.IndexState_Finished,
+			NodeID:       1,
+		})
+		state, ok := ib.GetTaskState(4)
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskDeleted, state)
+	})
+
+	t.Run("paused task", func(t *testing.T) {
+		task, ok := ib.tasks.Get(2)
+		assert.True(t, ok)
+		assert.NoError(t, ib.PauseTask(2))
+		task.pausedState = indexTaskDone
+		assert.False(t, ib.updateTaskState(task, indexTaskInProgress))
+		assert.Equal(t, indexTaskDone, task.pausedState)
+		assert.True(t, ib.updateTaskState(task, indexTaskDeleted))
+		assert.Equal(t, indexTaskDeleted, task.state)
+	})
+}
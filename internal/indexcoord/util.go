@@ -17,13 +17,23 @@
 package indexcoord
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"path"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -85,6 +95,247 @@ func estimateIndexSizeByReq(req *indexpb.BuildIndexRequest) (uint64, error) {
 	return estimateScalarIndexSize(req)
 }
 
+// getIndexType gets the index type from index params.
+func getIndexType(indexParams []*commonpb.KeyValuePair) string {
+	for _, kvPair := range indexParams {
+		if kvPair.GetKey() == indexTypeKey {
+			return kvPair.GetValue()
+		}
+	}
+	return unknownIndexType
+}
+
+// validateIndexParams checks whether the index can be built with the params of the request, as the IndexNode does
+// before training the index. The dimension in the type params and the field schema must be the same.
+func validateIndexParams(req *indexpb.BuildIndexRequest) error {
+	field := req.GetFieldSchema()
+	if field == nil {
+		return errors.New("field schema is not specified")
+	}
+	if err := checkBuildIndexRequest(req); err != nil {
+		return err
+	}
+	indexParams := make(map[string]string)
+	// the omitted params are filled with the defaults when the index is built.
+	params, _ := fillDefaultIndexParams(req.GetIndexParams())
+	for _, kvPair := range params {
+		indexParams[kvPair.GetKey()] = kvPair.GetValue()
+	}
+	indexType, ok := indexParams[indexTypeKey]
+	if !ok {
+		return errors.New("index type is not specified")
+	}
+
+	vecDTypes := []schemapb.DataType{
+		schemapb.DataType_FloatVector,
+		schemapb.DataType_BinaryVector,
+	}
+	if !funcutil.SliceContain(vecDTypes, field.GetDataType()) {
+		return indexparamcheck.CheckIndexValid(field.GetDataType(), indexType, indexParams)
+	}
+
+	adapter, err := indexparamcheck.GetConfAdapterMgrInstance().GetAdapter(indexType)
+	if err != nil {
+		return fmt.Errorf("invalid index type: %s", indexType)
+	}
+	dim, err := getDimension(req)
+	if err != nil {
+		return err
+	}
+	dimInSchema, err := funcutil.GetAttrByKeyFromRepeatedKV("dim", field.GetTypeParams())
+	if err == nil && dimInSchema != strconv.FormatInt(dim, 10) {
+		return fmt.Errorf("dimension mismatch, dimension in schema: %s, dimension: %d", dimInSchema, dim)
+	}
+	indexParams["dim"] = strconv.FormatInt(dim, 10)
+	if !adapter.CheckTrain(indexParams) {
+		return fmt.Errorf("invalid index params: %v", indexParams)
+	}
+	return nil
+}
+
+// validateDataPaths checks whether there is data to build the index, the IndexNode fails the task without data, such
+// as the segment without binlog.
+func validateDataPaths(dataPaths []string) error {
+	if len(dataPaths) == 0 {
+		return errors.New("no data paths to build the index")
+	}
+	for _, dataPath := range dataPaths {
+		if strings.TrimSpace(dataPath) == "" {
+			return fmt.Errorf("invalid data paths: %v", dataPaths)
+		}
+	}
+	return nil
+}
+
+// taskNumChanged returns whether the number of tasks changes by more than the ratio of the last number, it is always
+// true if the ratio is not positive.
+func taskNumChanged(last, current int, ratio float64) bool {
+	if ratio <= 0 {
+		return true
+	}
+	diff := current - last
+	if diff < 0 {
+		diff = -diff
+	}
+	return float64(diff) > ratio*float64(last)
+}
+
+// equalParams returns whether the two params have the same key-value pairs regardless of the order.
+func equalParams(params1, params2 []*commonpb.KeyValuePair) bool {
+	if len(params1) != len(params2) {
+		return false
+	}
+	kvs := make(map[string]string, len(params1))
+	for _, kvPair := range params1 {
+		kvs[kvPair.GetKey()] = kvPair.GetValue()
+	}
+	if len(kvs) != len(params1) {
+		// there are duplicate keys, compare the params in order.
+		for i := range params1 {
+			if params1[i].GetKey() != params2[i].GetKey() || params1[i].GetValue() != params2[i].GetValue() {
+				return false
+			}
+		}
+		return true
+	}
+	for _, kvPair := range params2 {
+		value, ok := kvs[kvPair.GetKey()]
+		if !ok || value != kvPair.GetValue() {
+			return false
+		}
+		delete(kvs, kvPair.GetKey())
+	}
+	return true
+}
+
+// lockFailureCategory returns the coarse category of the failure of the segment reference lock operation for metrics,
+// the failure which is neither a timeout nor a missing segment is regarded as DataCoord being unavailable.
+func lockFailureCategory(status *commonpb.Status, err error) string {
+	if err == nil && status.GetErrorCode() == commonpb.ErrorCode_SegmentNotFound {
+		return metrics.NotFoundErrorLabel
+	}
+	if err == nil {
+		err = errors.New(status.GetReason())
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || strings.Contains(msg, "deadline exceeded") ||
+		strings.Contains(msg, "timeout"):
+		return metrics.TimeoutErrorLabel
+	case strings.Contains(msg, "not found") || strings.Contains(msg, "not exist"):
+		return metrics.NotFoundErrorLabel
+	}
+	return metrics.UnavailableErrorLabel
+}
+
+// getSegmentOrigin returns the origin of the segment to build the index, the segment is regarded as flushed unless the
+// request marks it as imported.
+func getSegmentOrigin(req *indexpb.BuildIndexRequest) string {
+	if req.GetSegmentOrigin() == common.SegmentOriginImported {
+		return common.SegmentOriginImported
+	}
+	return common.SegmentOriginFlushed
+}
+
+// buildDataPaths returns the data paths sent to the IndexNode. The binlogs of the imported segment are written batch
+// by batch by the import task, the binlog of a retried batch may be listed more than once and the binlogs are not
+// ordered by log id, so the duplicated paths are removed and the paths are sorted by log id, which is the last element
+// of the path. The data paths of the flushed segment are sent as they are.
+func buildDataPaths(origin string, dataPaths []string) []string {
+	if origin != common.SegmentOriginImported {
+		return dataPaths
+	}
+	paths := make([]string, 0, len(dataPaths))
+	seen := make(map[string]struct{}, len(dataPaths))
+	for _, dataPath := range dataPaths {
+		if _, ok := seen[dataPath]; ok {
+			continue
+		}
+		seen[dataPath] = struct{}{}
+		paths = append(paths, dataPath)
+	}
+	sort.SliceStable(paths, func(i, j int) bool {
+		logID1, err1 := strconv.ParseInt(path.Base(paths[i]), 10, 64)
+		logID2, err2 := strconv.ParseInt(path.Base(paths[j]), 10, 64)
+		if err1 != nil || err2 != nil {
+			return paths[i] < paths[j]
+		}
+		return logID1 < logID2
+	})
+	return paths
+}
+
+// getBuildDeadline returns the time by which the index task must be finished, the zero time means no deadline.
+func getBuildDeadline(req *indexpb.BuildIndexRequest) (time.Time, error) {
+	seconds := req.GetBuildDeadline()
+	if seconds == 0 {
+		return time.Time{}, nil
+	}
+	if seconds < 0 {
+		return time.Time{}, fmt.Errorf("invalid build deadline: %d", seconds)
+	}
+	return time.Unix(seconds, 0), nil
+}
+
+// getBuildProgress returns the fraction of the rows built by the IndexNode in the index meta, nil if it is not reported,
+// such as by the IndexNodes of older versions.
+func getBuildProgress(meta *indexpb.IndexMeta) *float32 {
+	progress := meta.GetProgress()
+	if progress <= 0 {
+		return nil
+	}
+	if progress > 1 {
+		progress = 1
+	}
+	return &progress
+}
+
+// checkBuildIndexRequest checks the scheduling fields of the request, and whether the index params of the request carry
+// the keys which are set by the coordinators. The scheduling options of the build are carried by the fields of
+// BuildIndexRequest instead of the index params, so they are never taken as the params to build the index.
+func checkBuildIndexRequest(req *indexpb.BuildIndexRequest) error {
+	for _, kvPair := range req.GetIndexParams() {
+		if funcutil.SliceContain(common.IndexBuildOptionKeys, kvPair.GetKey()) ||
+			funcutil.SliceContain(common.ReservedIndexParamKeys, kvPair.GetKey()) {
+			return fmt.Errorf("index param %s is reserved", kvPair.GetKey())
+		}
+	}
+	if _, err := getBuildDeadline(req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// summarizeIndexParams returns the build params of the index except the index type, such as
+// "M=16,efConstruction=200,metric_type=L2". The params are sorted by key, so the summary of the same params is stable.
+func summarizeIndexParams(indexParams []*commonpb.KeyValuePair) string {
+	params := make([]string, 0, len(indexParams))
+	for _, kvPair := range indexParams {
+		if kvPair.GetKey() == indexTypeKey {
+			continue
+		}
+		params = append(params, kvPair.GetKey()+"="+kvPair.GetValue())
+	}
+	sort.Strings(params)
+	return strings.Join(params, ",")
+}
+
+// truncateString cuts the string longer than maxLen bytes without splitting a character, the cut is marked by "...".
+func truncateString(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	const suffix = "..."
+	n := maxLen - len(suffix)
+	if n < 0 {
+		n = 0
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + suffix
+}
+
 func parseBuildIDFromFilePath(key string) (UniqueID, error) {
 	ss := strings.Split(key, "/")
 	if strings.HasSuffix(key, "/") {
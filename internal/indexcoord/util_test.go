@@ -17,8 +17,13 @@
 package indexcoord
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -214,3 +219,205 @@ func Test_parseKey(t *testing.T) {
 	_, err2 := parseBuildIDFromFilePath(key2)
 	assert.Error(t, err2)
 }
+
+func Test_getIndexType(t *testing.T) {
+	indexParams := []*commonpb.KeyValuePair{
+		{
+			Key:   "metric_type",
+			Value: "L2",
+		},
+		{
+			Key:   "index_type",
+			Value: "HNSW",
+		},
+	}
+	assert.Equal(t, "HNSW", getIndexType(indexParams))
+	assert.Equal(t, unknownIndexType, getIndexType(nil))
+}
+
+func Test_validateIndexParams(t *testing.T) {
+	newReq := func(dataType schemapb.DataType, dim string, indexParams map[string]string) *indexpb.BuildIndexRequest {
+		req := &indexpb.BuildIndexRequest{
+			TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: dim}},
+			FieldSchema: &schemapb.FieldSchema{
+				DataType:   dataType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+			},
+		}
+		for key, value := range indexParams {
+			req.IndexParams = append(req.IndexParams, &commonpb.KeyValuePair{Key: key, Value: value})
+		}
+		return req
+	}
+	validParams := map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "1024"}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, validateIndexParams(newReq(schemapb.DataType_FloatVector, "128", validParams)))
+	})
+
+	t.Run("scalar field", func(t *testing.T) {
+		assert.NoError(t, validateIndexParams(newReq(schemapb.DataType_Int64, "128", map[string]string{"index_type": "sort"})))
+	})
+
+	t.Run("no field schema", func(t *testing.T) {
+		req := newReq(schemapb.DataType_FloatVector, "128", validParams)
+		req.FieldSchema = nil
+		assert.Error(t, validateIndexParams(req))
+	})
+
+	t.Run("no index type", func(t *testing.T) {
+		assert.Error(t, validateIndexParams(newReq(schemapb.DataType_FloatVector, "128", map[string]string{"metric_type": "L2"})))
+	})
+
+	t.Run("invalid index type", func(t *testing.T) {
+		assert.Error(t, validateIndexParams(newReq(schemapb.DataType_FloatVector, "128", map[string]string{"index_type": "invalid"})))
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		assert.Error(t, validateIndexParams(newReq(schemapb.DataType_FloatVector, "64", validParams)))
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		params := map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "-1"}
+		assert.Error(t, validateIndexParams(newReq(schemapb.DataType_FloatVector, "128", params)))
+	})
+}
+
+func Test_equalParams(t *testing.T) {
+	params1 := []*commonpb.KeyValuePair{
+		{Key: "index_type", Value: "HNSW"},
+		{Key: "metric_type", Value: "L2"},
+	}
+	params2 := []*commonpb.KeyValuePair{
+		{Key: "metric_type", Value: "L2"},
+		{Key: "index_type", Value: "HNSW"},
+	}
+	assert.True(t, equalParams(params1, params2))
+	assert.True(t, equalParams(nil, []*commonpb.KeyValuePair{}))
+
+	params2[0].Value = "IP"
+	assert.False(t, equalParams(params1, params2))
+	assert.False(t, equalParams(params1, params2[:1]))
+
+	// the duplicate keys are compared in order.
+	params3 := []*commonpb.KeyValuePair{
+		{Key: "index_type", Value: "HNSW"},
+		{Key: "index_type", Value: "HNSW"},
+	}
+	assert.False(t, equalParams(params1, params3))
+	assert.False(t, equalParams(params3, params1))
+	assert.True(t, equalParams(params3, params3))
+}
+
+func Test_getBuildDeadline(t *testing.T) {
+	req := &indexpb.BuildIndexRequest{}
+	deadline, err := getBuildDeadline(req)
+	assert.NoError(t, err)
+	assert.True(t, deadline.IsZero())
+
+	req.BuildDeadline = 1700000000
+	deadline, err = getBuildDeadline(req)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 0), deadline)
+
+	req.BuildDeadline = -1
+	_, err = getBuildDeadline(req)
+	assert.Error(t, err)
+}
+
+func Test_getBuildProgress(t *testing.T) {
+	assert.Nil(t, getBuildProgress(&indexpb.IndexMeta{}))
+	assert.Nil(t, getBuildProgress(nil))
+	assert.Equal(t, float32(0.25), *getBuildProgress(&indexpb.IndexMeta{Progress: 0.25}))
+	assert.Equal(t, float32(1), *getBuildProgress(&indexpb.IndexMeta{Progress: 2}))
+}
+
+func Test_checkBuildIndexRequest(t *testing.T) {
+	req := &indexpb.BuildIndexRequest{
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "HNSW"},
+		},
+		CollectionID:  100,
+		ResourceGroup: "g1",
+		BuildPriority: 5,
+		BuildDeadline: 1700000000,
+		ShadowBuild:   true,
+	}
+	assert.NoError(t, checkBuildIndexRequest(req))
+
+	// the scheduling options and the keys set by the coordinators are rejected in the index params.
+	for _, key := range []string{"resource_group", "build_priority", "build_deadline", "shadow_build",
+		"pinned_node_id", "collection_id", "segment_origin"} {
+		params := append(req.IndexParams[:1:1], &commonpb.KeyValuePair{Key: key, Value: "1"})
+		err := checkBuildIndexRequest(&indexpb.BuildIndexRequest{IndexParams: params})
+		assert.Error(t, err, key)
+	}
+
+	req.BuildDeadline = -1
+	assert.Error(t, checkBuildIndexRequest(req))
+}
+
+func Test_summarizeIndexParams(t *testing.T) {
+	indexParams := []*commonpb.KeyValuePair{
+		{Key: "metric_type", Value: "L2"},
+		{Key: "index_type", Value: "HNSW"},
+		{Key: "M", Value: "16"},
+		{Key: "efConstruction", Value: "200"},
+	}
+	assert.Equal(t, "M=16,efConstruction=200,metric_type=L2", summarizeIndexParams(indexParams))
+	assert.Equal(t, "", summarizeIndexParams(nil))
+}
+
+func Test_validateDataPaths(t *testing.T) {
+	assert.Error(t, validateDataPaths(nil))
+	assert.Error(t, validateDataPaths([]string{"file1", " "}))
+	assert.NoError(t, validateDataPaths([]string{"file1", "file2"}))
+}
+
+func Test_lockFailureCategory(t *testing.T) {
+	assert.Equal(t, metrics.TimeoutErrorLabel, lockFailureCategory(nil, context.DeadlineExceeded))
+	assert.Equal(t, metrics.TimeoutErrorLabel, lockFailureCategory(nil, errors.New("rpc error: code = DeadlineExceeded desc = context deadline exceeded")))
+	assert.Equal(t, metrics.NotFoundErrorLabel, lockFailureCategory(nil, errors.New("segment 1 not found")))
+	assert.Equal(t, metrics.UnavailableErrorLabel, lockFailureCategory(nil, errors.New("connection refused")))
+
+	status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_SegmentNotFound}
+	assert.Equal(t, metrics.NotFoundErrorLabel, lockFailureCategory(status, nil))
+	status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "request timeout"}
+	assert.Equal(t, metrics.TimeoutErrorLabel, lockFailureCategory(status, nil))
+	status.Reason = "DataCoord is not healthy"
+	assert.Equal(t, metrics.UnavailableErrorLabel, lockFailureCategory(status, nil))
+}
+
+func Test_getSegmentOrigin(t *testing.T) {
+	req := &indexpb.BuildIndexRequest{}
+	assert.Equal(t, common.SegmentOriginFlushed, getSegmentOrigin(req))
+	req.SegmentOrigin = "invalid"
+	assert.Equal(t, common.SegmentOriginFlushed, getSegmentOrigin(req))
+	req.SegmentOrigin = common.SegmentOriginImported
+	assert.Equal(t, common.SegmentOriginImported, getSegmentOrigin(req))
+}
+
+func Test_buildDataPaths(t *testing.T) {
+	dataPaths := []string{"binlog/10", "binlog/2", "binlog/10", "binlog/1"}
+	assert.Equal(t, dataPaths, buildDataPaths(common.SegmentOriginFlushed, dataPaths))
+	assert.Equal(t, []string{"binlog/1", "binlog/2", "binlog/10"}, buildDataPaths(common.SegmentOriginImported, dataPaths))
+	assert.Equal(t, []string{"binlog/a", "binlog/b"}, buildDataPaths(common.SegmentOriginImported, []string{"binlog/b", "binlog/a"}))
+	assert.Equal(t, 0, len(buildDataPaths(common.SegmentOriginImported, nil)))
+}
+
+func Test_truncateString(t *testing.T) {
+	assert.Equal(t, "error", truncateString("error", 5))
+	assert.Equal(t, "er...", truncateString("errors", 5))
+	// the character is not split.
+	assert.Equal(t, "a...", truncateString("a中文", 6))
+}
+
+func Test_taskNumChanged(t *testing.T) {
+	assert.True(t, taskNumChanged(100, 100, 0))
+	assert.False(t, taskNumChanged(100, 100, 0.1))
+	assert.False(t, taskNumChanged(100, 110, 0.1))
+	assert.True(t, taskNumChanged(100, 111, 0.1))
+	assert.True(t, taskNumChanged(100, 89, 0.1))
+	assert.True(t, taskNumChanged(0, 1, 0.1))
+	assert.False(t, taskNumChanged(0, 0, 0.1))
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 
+	"github.com/opentracing/opentracing-go"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
@@ -289,15 +290,23 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 		zap.Any("TypeParams", request.TypeParams),
 		zap.Any("IndexParams", request.IndexParams))
 
-	sp, ctx2 := trace.StartSpanFromContextWithOperationName(i.loopCtx, "IndexNode-CreateIndex")
+	// the span follows the index task of IndexCoord, so the build joins the trace of the BuildIndex request.
+	var opts []opentracing.StartSpanOption
+	if sc := trace.ExtractContextFromMap(request.GetTraceContext()); sc != nil {
+		opts = append(opts, opentracing.FollowsFrom(sc))
+	}
+	sp, ctx2 := trace.StartSpanFromContextWithOperationName(i.loopCtx, "IndexNode-CreateIndex", opts...)
 	defer sp.Finish()
 	sp.SetTag("IndexBuildID", strconv.FormatInt(request.IndexBuildID, 10))
 	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(Params.IndexNodeCfg.GetNodeID(), 10), metrics.TotalLabel).Inc()
 
+	// the task is canceled by CancelIndex.
+	taskCtx, taskCancel := context.WithCancel(ctx2)
 	t := &IndexBuildTask{
 		BaseTask: BaseTask{
-			ctx:  ctx2,
-			done: make(chan error),
+			ctx:    taskCtx,
+			cancel: taskCancel,
+			done:   make(chan error),
 		},
 		req:            request,
 		cm:             i.chunkManager,
@@ -312,6 +321,7 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 
 	err := i.sched.IndexBuildQueue.Enqueue(t)
 	if err != nil {
+		taskCancel()
 		log.Warn("IndexNode failed to schedule", zap.Int64("indexBuildID", request.IndexBuildID), zap.Error(err))
 		ret.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		ret.Reason = err.Error()
@@ -324,6 +334,26 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 	return ret, nil
 }
 
+// CancelIndex receives request from IndexCoordinator to cancel the build of an index. The task is removed if it has not
+// been started, otherwise its context is canceled and it is abandoned without saving the index. IndexCoord updates the
+// index meta before canceling, so the task which is not found is abandoned once it checks the meta.
+func (i *IndexNode) CancelIndex(ctx context.Context, req *indexpb.CancelIndexRequest) (*commonpb.Status, error) {
+	if i.stateCode.Load().(internalpb.StateCode) != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "state code is not healthy",
+		}, nil
+	}
+	log.Info("IndexNode canceling index ...", zap.Int64("IndexBuildID", req.GetIndexBuildID()))
+
+	if !i.sched.IndexBuildQueue.CancelTask(req.GetIndexBuildID()) {
+		log.Info("IndexNode cancel index, the task is not found", zap.Int64("IndexBuildID", req.GetIndexBuildID()))
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetTaskSlots gets how many task the IndexNode can still perform.
 func (i *IndexNode) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error) {
 	if i.stateCode.Load().(internalpb.StateCode) != internalpb.StateCode_Healthy {
@@ -343,7 +373,8 @@ func (i *IndexNode) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsR
 	}
 
 	ret.Slots = int64(i.sched.GetTaskSlots())
-	log.Info("IndexNode GetTaskSlots success", zap.Int64("slots", ret.Slots))
+	ret.Capacity = int64(i.sched.GetCapacity())
+	log.Info("IndexNode GetTaskSlots success", zap.Int64("slots", ret.Slots), zap.Int64("capacity", ret.Capacity))
 	return ret, nil
 }
 
@@ -265,6 +265,20 @@ func (inm *Mock) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	}, nil
 }
 
+// CancelIndex receives a cancel index request and returns success. If the internal member `Err` is true, it will return
+// an error.
+func (inm *Mock) CancelIndex(ctx context.Context, req *indexpb.CancelIndexRequest) (*commonpb.Status, error) {
+	if inm.Err {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexNode CancelIndex failed")
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetMetrics gets the metrics of mocked IndexNode, if the internal member `Failure` is true, it will return an error.
 func (inm *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if inm.Err {
@@ -337,7 +351,8 @@ func (inm *Mock) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequ
 		}, nil
 	}
 	return &indexpb.GetTaskSlotsResponse{
-		Slots: 1,
+		Slots:    1,
+		Capacity: 1,
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 			Reason:    "",
@@ -77,6 +77,12 @@ func TestIndexNodeMock(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndex", func(t *testing.T) {
+		resp, err := inm.CancelIndex(ctx, &indexpb.CancelIndexRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
 		assert.Nil(t, err)
@@ -136,6 +142,12 @@ func TestIndexNodeMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndex error", func(t *testing.T) {
+		resp, err := inm.CancelIndex(ctx, &indexpb.CancelIndexRequest{})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	})
+
 	t.Run("GetMetrics error", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{}
 		resp, err := inm.GetMetrics(ctx, req)
@@ -782,6 +782,12 @@ func TestIndexNode_Error(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 	})
 
+	t.Run("CancelIndex", func(t *testing.T) {
+		status, err := in.CancelIndex(ctx, &indexpb.CancelIndexRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		resp, err := in.GetMetrics(ctx, &milvuspb.GetMetricsRequest{})
 		assert.Nil(t, err)
@@ -832,6 +838,13 @@ func TestIndexNode_InitError(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 	})
+
+	t.Run("CancelIndex", func(t *testing.T) {
+		// the task which is not found has been finished or abandoned.
+		status, err := in.CancelIndex(ctx, &indexpb.CancelIndexRequest{IndexBuildID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
 }
 
 func TestIndexNode_GetComponentStates(t *testing.T) {
@@ -67,12 +67,14 @@ type task interface {
 	SetError(err error)
 	SetState(state TaskState)
 	GetState() TaskState
+	Cancel()
 }
 
 // BaseTask is an basic instance of task.
 type BaseTask struct {
 	done        chan error
 	ctx         context.Context
+	cancel      context.CancelFunc
 	id          UniqueID
 	err         error
 	internalErr error
@@ -119,6 +121,13 @@ func (bt *BaseTask) Notify(err error) {
 	bt.done <- err
 }
 
+// Cancel cancels the context of the task, the task is abandoned once it finds the context is done.
+func (bt *BaseTask) Cancel() {
+	if bt.cancel != nil {
+		bt.cancel()
+	}
+}
+
 // IndexBuildTask is used to record the information of the index tasks.
 type IndexBuildTask struct {
 	BaseTask
@@ -216,6 +225,13 @@ func (it *IndexBuildTask) updateTaskState(indexMeta *indexpb.IndexMeta, err erro
 func (it *IndexBuildTask) saveIndexMeta(ctx context.Context) error {
 	defer it.tr.Record("IndexNode IndexBuildTask saveIndexMeta")
 
+	if it.GetState() == TaskStateAbandon {
+		// the task is canceled, IndexCoord has updated the index meta.
+		log.Info("IndexNode IndexBuildTask skip saveIndexMeta because task abandon",
+			zap.Int64("IndexBuildID", it.req.IndexBuildID))
+		return nil
+	}
+
 	fn := func() error {
 		indexMeta, version, err := it.loadIndexMeta(ctx)
 		if err != nil {
@@ -243,6 +259,7 @@ func (it *IndexBuildTask) saveIndexMeta(ctx context.Context) error {
 		} else { // TaskStateNormal
 			indexMeta.IndexFilePaths = it.savePaths
 			indexMeta.SerializeSize = it.serializedSize
+			indexMeta.Progress = 1
 			log.Info("IndexNode IndexBuildTask saveIndexMeta indexMeta.state to IndexState_Finished",
 				zap.String("TaskState", taskState.String()),
 				zap.Int64("IndexBuildID", indexMeta.IndexBuildID))
@@ -277,6 +294,30 @@ func (it *IndexBuildTask) saveIndexMeta(ctx context.Context) error {
 	return nil
 }
 
+// saveProgress saves the fraction of the rows built into the index to meta, so that IndexCoord reports the progress of
+// the build. It is best effort, the failure is only logged since the build is not affected.
+func (it *IndexBuildTask) saveProgress(ctx context.Context, progress float32) {
+	indexMeta, version, err := it.loadIndexMeta(ctx)
+	if err != nil {
+		log.Warn("IndexNode IndexBuildTask saveProgress fail to load index meta", zap.Int64("buildID", it.req.IndexBuildID), zap.Error(err))
+		return
+	}
+	if it.updateTaskState(indexMeta, nil) == TaskStateAbandon {
+		return
+	}
+	indexMeta.Progress = progress
+	metaValue, err := proto.Marshal(indexMeta)
+	if err != nil {
+		log.Warn("IndexNode IndexBuildTask saveProgress fail to marshal index meta", zap.Int64("buildID", it.req.IndexBuildID), zap.Error(err))
+		return
+	}
+	success, err := it.etcdKV.CompareVersionAndSwap(it.req.MetaPath, version, string(metaValue))
+	if err != nil || !success {
+		log.Warn("IndexNode IndexBuildTask saveProgress fail to save index meta", zap.Int64("buildID", it.req.IndexBuildID),
+			zap.Int64("source version", version), zap.Error(err))
+	}
+}
+
 // PreExecute does some checks before building the index, for example, whether the index has been deleted.
 func (it *IndexBuildTask) PreExecute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask preExecute...", zap.Int64("buildId", it.req.IndexBuildID))
@@ -556,6 +597,17 @@ func (it *IndexBuildTask) releaseMemory() {
 	debug.FreeOSMemory()
 }
 
+// checkCanceled abandons the task if its context is done, the task is canceled by IndexCoord or IndexNode is stopping.
+// The index meta has been updated by IndexCoord, so the abandoned task neither saves the index nor updates the meta.
+func (it *IndexBuildTask) checkCanceled(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		it.SetState(TaskStateAbandon)
+		log.Info("IndexNode IndexBuildTask is canceled", zap.Int64("buildId", it.req.IndexBuildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // Execute actually performs the task of building an index.
 func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask Execute ...", zap.Int64("buildId", it.req.IndexBuildID))
@@ -569,6 +621,10 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		return nil
 	}
 
+	if err := it.checkCanceled(ctx); err != nil {
+		return err
+	}
+
 	if err := it.prepareParams(ctx); err != nil {
 		it.SetState(TaskStateFailed)
 		log.Error("IndexNode IndexBuildTask Execute prepareParams failed",
@@ -596,6 +652,12 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	if err := it.checkCanceled(ctx); err != nil {
+		return err
+	}
+	// all the rows are built into the index, the index files are being saved.
+	it.saveProgress(ctx, 1)
+
 	err = it.saveIndex(ctx, blobs)
 	if err != nil {
 		it.SetState(TaskStateRetry)
@@ -44,6 +44,7 @@ type TaskQueue interface {
 	Enqueue(t task) error
 	//tryToRemoveUselessIndexBuildTask(indexID UniqueID) []UniqueID
 	GetTaskNum() int
+	CancelTask(tID UniqueID) bool
 }
 
 // BaseTaskQueue is a basic instance of TaskQueue.
@@ -170,6 +171,29 @@ func (queue *BaseTaskQueue) Enqueue(t task) error {
 	return queue.addUnissuedTask(t)
 }
 
+// CancelTask removes the task from the unissued tasks, or cancels the context of the active task. It returns false if
+// the task is not found, such as the task has been finished.
+func (queue *BaseTaskQueue) CancelTask(tID UniqueID) bool {
+	queue.utLock.Lock()
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		if t := e.Value.(task); t.ID() == tID {
+			queue.unissuedTasks.Remove(e)
+			queue.utLock.Unlock()
+			t.Cancel()
+			return true
+		}
+	}
+	queue.utLock.Unlock()
+
+	queue.atLock.Lock()
+	defer queue.atLock.Unlock()
+	if t, ok := queue.activeTasks[tID]; ok {
+		t.Cancel()
+		return true
+	}
+	return false
+}
+
 func (queue *BaseTaskQueue) GetTaskNum() int {
 	queue.utLock.Lock()
 	utNum := queue.unissuedTasks.Len()
@@ -254,6 +278,8 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		})
 
 	defer span.Finish()
+	// release the context of the task once it is processed.
+	defer t.Cancel()
 	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
 	err := t.PreExecute(ctx)
 	t.SetError(err)
@@ -332,3 +358,8 @@ func (sched *TaskScheduler) Close() {
 func (sched *TaskScheduler) GetTaskSlots() int {
 	return sched.buildParallel - sched.IndexBuildQueue.GetTaskNum()
 }
+
+// GetCapacity returns the total task slots, the number of the tasks which are built in parallel.
+func (sched *TaskScheduler) GetCapacity() int {
+	return sched.buildParallel
+}
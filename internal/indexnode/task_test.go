@@ -130,6 +130,62 @@ func TestIndexBuildTask_Execute(t *testing.T) {
 		assert.Equal(t, TaskStateFailed, indexTask.state)
 
 	})
+
+	t.Run("task canceled", func(t *testing.T) {
+		indexTask := &IndexBuildTask{
+			req: &indexpb.CreateIndexRequest{
+				IndexBuildID: 1,
+				DataPaths:    []string{"path1", "path2"},
+			},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := indexTask.Execute(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, TaskStateAbandon, indexTask.state)
+
+		// the abandoned task does not update the meta.
+		indexTask.tr = timerecord.NewTimeRecorder("test")
+		err = indexTask.saveIndexMeta(ctx)
+		assert.NoError(t, err)
+	})
+}
+
+func TestIndexBuildTaskQueue_CancelTask(t *testing.T) {
+	queue := NewIndexBuildTaskQueue(nil)
+	newTask := func(buildID UniqueID) *IndexBuildTask {
+		ctx, cancel := context.WithCancel(context.Background())
+		return &IndexBuildTask{
+			BaseTask: BaseTask{
+				ctx:    ctx,
+				cancel: cancel,
+			},
+			req: &indexpb.CreateIndexRequest{
+				IndexBuildID: buildID,
+			},
+		}
+	}
+
+	unissued := newTask(1)
+	err := queue.Enqueue(unissued)
+	assert.NoError(t, err)
+	active := newTask(2)
+	active.SetID(2)
+	queue.AddActiveTask(active)
+	assert.Equal(t, 2, queue.GetTaskNum())
+
+	// the unissued task is removed.
+	assert.True(t, queue.CancelTask(1))
+	assert.Error(t, unissued.Ctx().Err())
+	assert.Equal(t, 1, queue.GetTaskNum())
+
+	// the active task is canceled, it is removed once it is processed.
+	assert.True(t, queue.CancelTask(2))
+	assert.Error(t, active.Ctx().Err())
+	assert.Equal(t, 1, queue.GetTaskNum())
+
+	assert.False(t, queue.CancelTask(3))
 }
 
 type mockETCDKV struct {
@@ -48,6 +48,246 @@ var (
 			Name:      "indexnode_num",
 			Help:      "number of IndexNodes managed by IndexCoord",
 		}, []string{})
+
+	// IndexCoordIndexBuilderTaskNum records the number of tasks in the index builder queue.
+	IndexCoordIndexBuilderTaskNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_num",
+			Help:      "number of tasks in the index builder queue",
+		}, []string{})
+
+	// IndexCoordIndexBuilderTaskWeight records the estimated work of the unfinished tasks in the index builder queue, it
+	// correlates with the time to drain the queue better than the number of tasks.
+	IndexCoordIndexBuilderTaskWeight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_weight",
+			Help:      "estimated work of the unfinished tasks in the index builder queue",
+		}, []string{})
+
+	// IndexCoordIndexBuilderTaskStateNum records the number of tasks in the index builder queue of each state.
+	IndexCoordIndexBuilderTaskStateNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_state_num",
+			Help:      "number of tasks in the index builder queue of each state",
+		}, []string{indexTaskStateLabelName})
+
+	// IndexCoordIndexBuilderTaskCompletedCounter records the number of tasks completed by the index builder.
+	IndexCoordIndexBuilderTaskCompletedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_completed_count",
+			Help:      "number of tasks completed by the index builder",
+		}, []string{})
+
+	// IndexCoordIndexBuilderTaskRetryCounter records the number of times the index builder reassigns tasks.
+	IndexCoordIndexBuilderTaskRetryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_retry_count",
+			Help:      "number of times the index builder reassigns tasks",
+		}, []string{})
+
+	// IndexCoordIndexBuildDuration records the time spent on building each index, from assignment to finish.
+	IndexCoordIndexBuildDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_build_duration",
+			Help:      "time spent on building each index",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 20, 50, 100, 250, 500, 1000, 3600, 5000, 10000}, // unit seconds
+		}, []string{indexTypeLabelName})
+
+	// IndexCoordSegmentLockHoldDuration records the time from acquiring the segment reference lock of an index task
+	// to releasing it after the index is built.
+	IndexCoordSegmentLockHoldDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "segment_lock_hold_duration",
+			Help:      "time of holding the segment reference lock for each index task",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 20, 50, 100, 250, 500, 1000, 3600, 5000, 10000}, // unit seconds
+		}, []string{})
+
+	// IndexCoordPeekIndexNodeFailCounter records the number of times the index builder fails to peek an IndexNode.
+	IndexCoordPeekIndexNodeFailCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "peek_indexnode_fail_count",
+			Help:      "number of times the index builder fails to peek an IndexNode",
+		}, []string{reasonLabelName})
+
+	// IndexCoordNoIndexNodeDuration records how long there has been no IndexNode online when there are tasks to assign.
+	IndexCoordNoIndexNodeDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "no_indexnode_duration",
+			Help:      "seconds that there has been no IndexNode online to assign index tasks",
+		}, []string{})
+
+	// IndexCoordResourceGroupSaturatedCounter records the number of times the index builder fails to peek an IndexNode
+	// in the resource group.
+	IndexCoordResourceGroupSaturatedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "resource_group_saturated_count",
+			Help:      "number of times the index builder fails to peek an IndexNode in the resource group",
+		}, []string{resourceGroupLabelName, reasonLabelName})
+
+	// IndexCoordIndexBuilderPaused records whether the index builder is paused.
+	IndexCoordIndexBuilderPaused = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_paused",
+			Help:      "whether the index builder is paused, 1 means paused",
+		}, []string{})
+
+	// IndexCoordIndexBuilderDeferredTaskCounter records the number of tasks deferred to the next schedule run because
+	// of the limit of tasks processed in one run.
+	IndexCoordIndexBuilderDeferredTaskCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_deferred_task_count",
+			Help:      "number of tasks deferred to the next schedule run of the index builder",
+		}, []string{})
+
+	// IndexCoordIndexNodeEstimatedLoad records the estimated work of the in-progress index tasks on each IndexNode.
+	IndexCoordIndexNodeEstimatedLoad = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "indexnode_estimated_load",
+			Help:      "estimated work of the in-progress index tasks on each IndexNode",
+		}, []string{nodeIDLabelName})
+
+	// IndexCoordIndexBuilderOldestTaskAge records the seconds since the oldest unfinished task was enqueued.
+	IndexCoordIndexBuilderOldestTaskAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_oldest_task_age",
+			Help:      "seconds since the oldest unfinished task of the index builder was enqueued",
+		}, []string{})
+
+	// IndexCoordIndexBuilderStarvingTaskNum records the number of unfinished tasks enqueued longer than the threshold.
+	IndexCoordIndexBuilderStarvingTaskNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_starving_task_num",
+			Help:      "number of unfinished tasks of the index builder enqueued longer than the starving threshold",
+		}, []string{})
+
+	// IndexCoordIndexNodeStateNum records the number of IndexNodes without tasks, the number of IndexNodes whose tasks
+	// have reached the concurrency limit, and the total number of IndexNodes seen by the index builder.
+	IndexCoordIndexNodeStateNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "indexnode_state_num",
+			Help:      "number of idle, busy and total IndexNodes seen by the index builder",
+		}, []string{indexNodeStateLabelName})
+
+	// IndexCoordIndexBuilderRejectedTaskCounter records the number of tasks rejected because the index builder tracks
+	// the maximum number of tasks.
+	IndexCoordIndexBuilderRejectedTaskCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_rejected_task_count",
+			Help:      "number of tasks rejected because the index builder tracks the maximum number of tasks",
+		}, []string{})
+
+	// IndexCoordSegmentLockThrottledCounter records the number of segment reference lock acquisitions delayed to the
+	// next schedule run by the rate limit.
+	IndexCoordSegmentLockThrottledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "segment_lock_throttled_count",
+			Help:      "number of segment reference lock acquisitions delayed by the rate limit",
+		}, []string{})
+
+	// IndexCoordIndexBuilderIllegalTransitionCounter records the number of illegal state transitions of the index tasks
+	// rejected by the index builder.
+	IndexCoordIndexBuilderIllegalTransitionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_illegal_transition_count",
+			Help:      "number of illegal state transitions of index tasks rejected by the index builder",
+		}, []string{fromStateLabelName, toStateLabelName})
+
+	// IndexCoordSegmentLockAcquireFailureCounter records the number of failed segment reference lock acquisitions by
+	// the category of the error.
+	IndexCoordSegmentLockAcquireFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "segment_refer_lock_acquire_failures",
+			Help:      "number of failed segment reference lock acquisitions",
+		}, []string{errorCategoryLabelName})
+
+	// IndexCoordSegmentLockReleaseFailureCounter records the number of failed segment reference lock releases by the
+	// category of the error, the lock which is not released blocks the garbage collection of the segment.
+	IndexCoordSegmentLockReleaseFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "segment_refer_lock_release_failures",
+			Help:      "number of failed segment reference lock releases",
+		}, []string{errorCategoryLabelName})
+
+	// IndexCoordIndexBuilderTaskMutexHoldDuration records the time of holding the task lock of the index builder by the
+	// critical section.
+	IndexCoordIndexBuilderTaskMutexHoldDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_mutex_hold_duration",
+			Help:      "time of holding the task lock of the index builder",
+			Buckets:   []float64{0.00001, 0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}, // unit seconds
+		}, []string{sectionLabelName})
+
+	// IndexCoordIndexBuilderTaskMutexMaxHoldTime records the maximum time of holding the task lock of the index builder
+	// by the critical section in the last schedule interval.
+	IndexCoordIndexBuilderTaskMutexMaxHoldTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "index_builder_task_mutex_max_hold_seconds",
+			Help:      "maximum time of holding the task lock of the index builder in the last schedule interval",
+		}, []string{sectionLabelName})
+
+	// IndexCoordOrphanedSegmentLockCounter records the number of segment reference locks which IndexCoord failed to release.
+	IndexCoordOrphanedSegmentLockCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "orphaned_segment_lock_count",
+			Help:      "number of segment reference locks of finished index tasks which need to be released manually",
+		}, []string{})
+
+	// IndexCoordCollectionIndexReadyCounter records the number of times all the index tasks of a collection are finished.
+	IndexCoordCollectionIndexReadyCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "collection_index_ready_count",
+			Help:      "number of times all the index tasks of a collection are finished",
+		}, []string{})
 )
 
 //RegisterIndexCoord registers IndexCoord metrics
@@ -55,4 +295,29 @@ func RegisterIndexCoord(registry *prometheus.Registry) {
 	registry.MustRegister(IndexCoordIndexRequestCounter)
 	registry.MustRegister(IndexCoordIndexTaskCounter)
 	registry.MustRegister(IndexCoordIndexNodeNum)
+	registry.MustRegister(IndexCoordIndexBuilderTaskNum)
+	registry.MustRegister(IndexCoordIndexBuilderTaskWeight)
+	registry.MustRegister(IndexCoordIndexBuilderTaskStateNum)
+	registry.MustRegister(IndexCoordIndexBuilderTaskCompletedCounter)
+	registry.MustRegister(IndexCoordIndexBuilderTaskRetryCounter)
+	registry.MustRegister(IndexCoordIndexBuildDuration)
+	registry.MustRegister(IndexCoordPeekIndexNodeFailCounter)
+	registry.MustRegister(IndexCoordNoIndexNodeDuration)
+	registry.MustRegister(IndexCoordResourceGroupSaturatedCounter)
+	registry.MustRegister(IndexCoordOrphanedSegmentLockCounter)
+	registry.MustRegister(IndexCoordIndexBuilderPaused)
+	registry.MustRegister(IndexCoordIndexBuilderDeferredTaskCounter)
+	registry.MustRegister(IndexCoordSegmentLockHoldDuration)
+	registry.MustRegister(IndexCoordIndexNodeEstimatedLoad)
+	registry.MustRegister(IndexCoordIndexBuilderOldestTaskAge)
+	registry.MustRegister(IndexCoordIndexBuilderStarvingTaskNum)
+	registry.MustRegister(IndexCoordIndexNodeStateNum)
+	registry.MustRegister(IndexCoordIndexBuilderRejectedTaskCounter)
+	registry.MustRegister(IndexCoordSegmentLockThrottledCounter)
+	registry.MustRegister(IndexCoordIndexBuilderIllegalTransitionCounter)
+	registry.MustRegister(IndexCoordSegmentLockAcquireFailureCounter)
+	registry.MustRegister(IndexCoordSegmentLockReleaseFailureCounter)
+	registry.MustRegister(IndexCoordIndexBuilderTaskMutexHoldDuration)
+	registry.MustRegister(IndexCoordIndexBuilderTaskMutexMaxHoldTime)
+	registry.MustRegister(IndexCoordCollectionIndexReadyCounter)
 }
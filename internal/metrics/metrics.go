@@ -56,6 +56,16 @@ const (
 	FailedIndexTaskLabel     = "failed"
 	RecycledIndexTaskLabel   = "recycled"
 
+	TimeoutErrorLabel     = "timeout"
+	NotFoundErrorLabel    = "not-found"
+	UnavailableErrorLabel = "unavailable"
+
+	NoIndexNodeLabel    = "no_indexnode"
+	IndexNodesBusyLabel = "indexnodes_busy"
+
+	IdleIndexNodeLabel = "idle"
+	BusyIndexNodeLabel = "busy"
+
 	SealedSegmentLabel   = "Sealed"
 	GrowingSegmentLabel  = "Growing"
 	FlushedSegmentLabel  = "Flushed"
@@ -65,6 +75,15 @@ const (
 	nodeIDLabelName          = "node_id"
 	statusLabelName          = "status"
 	indexTaskStatusLabelName = "index_task_status"
+	indexTaskStateLabelName  = "index_task_state"
+	fromStateLabelName       = "from_state"
+	toStateLabelName         = "to_state"
+	errorCategoryLabelName   = "error_category"
+	sectionLabelName         = "section"
+	indexTypeLabelName       = "index_type"
+	reasonLabelName          = "reason"
+	resourceGroupLabelName   = "resource_group"
+	indexNodeStateLabelName  = "indexnode_state"
 	msgTypeLabelName         = "msg_type"
 	collectionIDLabelName    = "collection_id"
 	channelNameLabelName     = "channel_name"
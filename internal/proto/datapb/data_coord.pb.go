@@ -568,14 +568,18 @@ func (m *GetSegmentStatesRequest) GetSegmentIDs() []int64 {
 }
 
 type SegmentStateInfo struct {
-	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	State                commonpb.SegmentState   `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,3,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
-	EndPosition          *internalpb.MsgPosition `protobuf:"bytes,4,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
-	Status               *commonpb.Status        `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	SegmentID     int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	State         commonpb.SegmentState   `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	StartPosition *internalpb.MsgPosition `protobuf:"bytes,3,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	EndPosition   *internalpb.MsgPosition `protobuf:"bytes,4,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	Status        *commonpb.Status        `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// whether the segment is being compacted
+	Compacting bool `protobuf:"varint,6,opt,name=compacting,proto3" json:"compacting,omitempty"`
+	// the segment which the dropped segment is compacted into, 0 if there is none
+	CompactedTo          int64    `protobuf:"varint,7,opt,name=compactedTo,proto3" json:"compactedTo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentStateInfo) Reset()         { *m = SegmentStateInfo{} }
@@ -638,6 +642,20 @@ func (m *SegmentStateInfo) GetStatus() *commonpb.Status {
 	return nil
 }
 
+func (m *SegmentStateInfo) GetCompacting() bool {
+	if m != nil {
+		return m.Compacting
+	}
+	return false
+}
+
+func (m *SegmentStateInfo) GetCompactedTo() int64 {
+	if m != nil {
+		return m.CompactedTo
+	}
+	return 0
+}
+
 type GetSegmentStatesResponse struct {
 	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	States               []*SegmentStateInfo `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
@@ -4324,244 +4342,245 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 3792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x5b, 0x6f, 0x1b, 0x47,
-	0x77, 0x5e, 0xde, 0x44, 0x1e, 0x5e, 0x44, 0x8d, 0x1d, 0x89, 0xa6, 0x6d, 0x59, 0x5e, 0xc7, 0x8e,
-	0xec, 0xcf, 0xb1, 0x13, 0xb9, 0xc1, 0x17, 0xd4, 0xdf, 0x97, 0x0f, 0x96, 0x65, 0xcb, 0x6c, 0x25,
-	0x57, 0x5e, 0xc9, 0x31, 0xd0, 0x14, 0x25, 0x56, 0xdc, 0x11, 0xb5, 0x11, 0x77, 0x97, 0xde, 0x5d,
-	0x5a, 0x56, 0x5e, 0x92, 0x36, 0x40, 0x81, 0x14, 0x45, 0x53, 0xb4, 0x28, 0xd0, 0x02, 0x2d, 0x50,
-	0xf4, 0x29, 0x6d, 0x51, 0xa0, 0x40, 0xd0, 0x87, 0xb4, 0xe8, 0x7b, 0xd0, 0x3e, 0xf4, 0x0f, 0xf4,
-	0xbd, 0x7d, 0xea, 0x6f, 0x28, 0xe6, 0xb2, 0xb3, 0x77, 0x72, 0x45, 0xda, 0x71, 0xdf, 0x38, 0xb3,
-	0xe7, 0x9c, 0x39, 0x73, 0xe6, 0xdc, 0x67, 0x08, 0x4d, 0x4d, 0x75, 0xd5, 0x6e, 0xcf, 0xb2, 0x6c,
-	0xed, 0xf6, 0xd0, 0xb6, 0x5c, 0x0b, 0x2d, 0x18, 0xfa, 0xe0, 0xe5, 0xc8, 0x61, 0xa3, 0xdb, 0xe4,
-	0x73, 0xbb, 0xd6, 0xb3, 0x0c, 0xc3, 0x32, 0xd9, 0x54, 0xbb, 0xa1, 0x9b, 0x2e, 0xb6, 0x4d, 0x75,
-	0xc0, 0xc7, 0xb5, 0x20, 0x42, 0xbb, 0xe6, 0xf4, 0x0e, 0xb1, 0xa1, 0xb2, 0x91, 0x3c, 0x07, 0xc5,
-	0x87, 0xc6, 0xd0, 0x3d, 0x91, 0xff, 0x42, 0x82, 0xda, 0xa3, 0xc1, 0xc8, 0x39, 0x54, 0xf0, 0x8b,
-	0x11, 0x76, 0x5c, 0xf4, 0x01, 0x14, 0xf6, 0x55, 0x07, 0xb7, 0xa4, 0x15, 0x69, 0xb5, 0xba, 0x76,
-	0xf1, 0x76, 0x68, 0x55, 0xbe, 0xde, 0xb6, 0xd3, 0x5f, 0x57, 0x1d, 0xac, 0x50, 0x48, 0x84, 0xa0,
-	0xa0, 0xed, 0x77, 0x36, 0x5a, 0xb9, 0x15, 0x69, 0x35, 0xaf, 0xd0, 0xdf, 0x68, 0x19, 0xc0, 0xc1,
-	0x7d, 0x03, 0x9b, 0x6e, 0x67, 0xc3, 0x69, 0xe5, 0x57, 0xf2, 0xab, 0x79, 0x25, 0x30, 0x83, 0x64,
-	0xa8, 0xf5, 0xac, 0xc1, 0x00, 0xf7, 0x5c, 0xdd, 0x32, 0x3b, 0x1b, 0xad, 0x02, 0xc5, 0x0d, 0xcd,
-	0xc9, 0x7f, 0x25, 0x41, 0x9d, 0xb3, 0xe6, 0x0c, 0x2d, 0xd3, 0xc1, 0xe8, 0x2e, 0x94, 0x1c, 0x57,
-	0x75, 0x47, 0x0e, 0xe7, 0xee, 0x42, 0x22, 0x77, 0xbb, 0x14, 0x44, 0xe1, 0xa0, 0x89, 0xec, 0x45,
-	0x97, 0xcf, 0xc7, 0x97, 0x8f, 0x6c, 0xa1, 0x10, 0xdd, 0x82, 0xfc, 0x8f, 0x12, 0x34, 0x77, 0xbd,
-	0xa1, 0x27, 0xbd, 0x73, 0x50, 0xec, 0x59, 0x23, 0xd3, 0xa5, 0x0c, 0xd6, 0x15, 0x36, 0x40, 0x57,
-	0xa0, 0xd6, 0x3b, 0x54, 0x4d, 0x13, 0x0f, 0xba, 0xa6, 0x6a, 0x60, 0xca, 0x4a, 0x45, 0xa9, 0xf2,
-	0xb9, 0x27, 0xaa, 0x81, 0x33, 0x71, 0xb4, 0x02, 0xd5, 0xa1, 0x6a, 0xbb, 0x7a, 0x48, 0x66, 0xc1,
-	0x29, 0xd4, 0x86, 0xb2, 0xee, 0x74, 0x8c, 0xa1, 0x65, 0xbb, 0xad, 0xe2, 0x8a, 0xb4, 0x5a, 0x56,
-	0xc4, 0x58, 0xfe, 0x1b, 0x09, 0x16, 0xef, 0x3b, 0x8e, 0xde, 0x37, 0x63, 0x5c, 0x2f, 0x42, 0xc9,
-	0xb4, 0x34, 0xdc, 0xd9, 0xa0, 0x6c, 0xe7, 0x15, 0x3e, 0x42, 0x17, 0xa0, 0x32, 0xc4, 0xd8, 0xee,
-	0xda, 0xd6, 0xc0, 0x63, 0xba, 0x4c, 0x26, 0x14, 0x6b, 0x80, 0xd1, 0x53, 0x58, 0x70, 0x22, 0x84,
-	0xd8, 0x49, 0x57, 0xd7, 0xae, 0xde, 0x8e, 0xe9, 0xea, 0xed, 0xe8, 0xa2, 0x4a, 0x1c, 0x5b, 0xfe,
-	0x2a, 0x07, 0x67, 0x05, 0x1c, 0xe3, 0x95, 0xfc, 0x26, 0x52, 0x75, 0x70, 0x5f, 0xb0, 0xc7, 0x06,
-	0x59, 0xa4, 0x2a, 0x8e, 0x23, 0x1f, 0x3c, 0x8e, 0x0c, 0xca, 0x17, 0x95, 0x75, 0x31, 0x2e, 0xeb,
-	0xcb, 0x50, 0xc5, 0xaf, 0x86, 0xba, 0x8d, 0xbb, 0xae, 0x6e, 0xe0, 0x56, 0x69, 0x45, 0x5a, 0x2d,
-	0x28, 0xc0, 0xa6, 0xf6, 0x74, 0x23, 0xa8, 0xad, 0x73, 0x99, 0xb5, 0x55, 0xfe, 0x5b, 0x09, 0x96,
-	0x62, 0xa7, 0xc4, 0xd5, 0x5f, 0x81, 0x26, 0xdd, 0xb9, 0x2f, 0x19, 0x62, 0x08, 0x44, 0xe0, 0xd7,
-	0xc7, 0x09, 0xdc, 0x07, 0x57, 0x62, 0xf8, 0x01, 0x26, 0x73, 0xd9, 0x99, 0x3c, 0x82, 0xa5, 0x4d,
-	0xec, 0xf2, 0x05, 0xc8, 0x37, 0xec, 0x4c, 0xef, 0x3e, 0xc2, 0x76, 0x96, 0x8b, 0xd9, 0xd9, 0x3f,
-	0xe5, 0x84, 0x9d, 0xd1, 0xa5, 0x3a, 0xe6, 0x81, 0x85, 0x2e, 0x42, 0x45, 0x80, 0x70, 0xad, 0xf0,
-	0x27, 0xd0, 0xcf, 0xa1, 0x48, 0x38, 0x65, 0x2a, 0xd1, 0x58, 0xbb, 0x92, 0xbc, 0xa7, 0x00, 0x4d,
-	0x85, 0xc1, 0xa3, 0x0e, 0x34, 0x1c, 0x57, 0xb5, 0xdd, 0xee, 0xd0, 0x72, 0xe8, 0x39, 0x53, 0xc5,
-	0xa9, 0xae, 0xc9, 0x61, 0x0a, 0xc2, 0xd1, 0x6e, 0x3b, 0xfd, 0x1d, 0x0e, 0xa9, 0xd4, 0x29, 0xa6,
-	0x37, 0x44, 0x0f, 0xa1, 0x86, 0x4d, 0xcd, 0x27, 0x54, 0xc8, 0x4c, 0xa8, 0x8a, 0x4d, 0x4d, 0x90,
-	0xf1, 0xcf, 0xa7, 0x98, 0xfd, 0x7c, 0xfe, 0x48, 0x82, 0x56, 0xfc, 0x80, 0x66, 0x71, 0xa2, 0xf7,
-	0x18, 0x12, 0x66, 0x07, 0x34, 0xd6, 0xc2, 0xc5, 0x21, 0x29, 0x1c, 0x45, 0xfe, 0x73, 0x09, 0xde,
-	0xf1, 0xd9, 0xa1, 0x9f, 0xde, 0x94, 0xb6, 0xa0, 0x9b, 0xd0, 0xd4, 0xcd, 0xde, 0x60, 0xa4, 0xe1,
-	0x67, 0xe6, 0x63, 0xac, 0x0e, 0xdc, 0xc3, 0x13, 0x7a, 0x86, 0x65, 0x25, 0x36, 0x2f, 0x7f, 0x2d,
-	0xc1, 0x62, 0x94, 0xaf, 0x59, 0x84, 0xf4, 0x6b, 0x50, 0xd4, 0xcd, 0x03, 0xcb, 0x93, 0xd1, 0xf2,
-	0x18, 0xa3, 0x24, 0x6b, 0x31, 0x60, 0xd9, 0x80, 0x0b, 0x9b, 0xd8, 0xed, 0x98, 0x0e, 0xb6, 0xdd,
-	0x75, 0xdd, 0x1c, 0x58, 0xfd, 0x1d, 0xd5, 0x3d, 0x9c, 0xc1, 0xa0, 0x42, 0xb6, 0x91, 0x8b, 0xd8,
-	0x86, 0xfc, 0x9d, 0x04, 0x17, 0x93, 0xd7, 0xe3, 0x5b, 0x6f, 0x43, 0xf9, 0x40, 0xc7, 0x03, 0x8d,
-	0xc8, 0x57, 0xa2, 0xf2, 0x15, 0x63, 0x62, 0x58, 0x43, 0x02, 0xcc, 0x77, 0x78, 0x25, 0x45, 0x9b,
-	0x77, 0x5d, 0x5b, 0x37, 0xfb, 0x5b, 0xba, 0xe3, 0x2a, 0x0c, 0x3e, 0x20, 0xcf, 0x7c, 0x76, 0x35,
-	0xfe, 0x43, 0x09, 0x96, 0x37, 0xb1, 0xfb, 0x40, 0xf8, 0x65, 0xf2, 0x5d, 0x77, 0x5c, 0xbd, 0xe7,
-	0xbc, 0xde, 0x6c, 0x25, 0x43, 0xf0, 0x95, 0xbf, 0x95, 0xe0, 0x72, 0x2a, 0x33, 0x5c, 0x74, 0xdc,
-	0xef, 0x78, 0x5e, 0x39, 0xd9, 0xef, 0xfc, 0x26, 0x3e, 0xf9, 0x54, 0x1d, 0x8c, 0xf0, 0x8e, 0xaa,
-	0xdb, 0xcc, 0xef, 0x4c, 0xe9, 0x85, 0xff, 0x41, 0x82, 0x4b, 0x9b, 0xd8, 0xdd, 0xf1, 0x62, 0xd2,
-	0x5b, 0x94, 0xce, 0xe4, 0xd4, 0x44, 0xfe, 0x63, 0x76, 0x98, 0x89, 0xdc, 0xbe, 0x15, 0xf1, 0x2d,
-	0x53, 0x3b, 0x08, 0x18, 0xe4, 0x03, 0x96, 0x38, 0x70, 0xe1, 0xc9, 0x7f, 0x2d, 0xc1, 0xf9, 0xfb,
-	0xbd, 0x17, 0x23, 0xdd, 0xc6, 0x1c, 0x68, 0xcb, 0xea, 0x1d, 0x4d, 0x2f, 0x5a, 0x3f, 0xc9, 0xca,
-	0x85, 0x92, 0xac, 0x49, 0xa9, 0xf2, 0x22, 0x94, 0x5c, 0xd5, 0x39, 0x12, 0x52, 0xe5, 0x23, 0xca,
-	0x9f, 0x82, 0x07, 0x58, 0x75, 0xfe, 0x7f, 0xf2, 0xf7, 0x6d, 0x01, 0x6a, 0x9f, 0xf2, 0x64, 0x8c,
-	0xc6, 0xec, 0xa8, 0x1e, 0x49, 0xc9, 0x7a, 0x14, 0xc8, 0xdf, 0x92, 0x52, 0xba, 0x4d, 0xa8, 0x3b,
-	0x18, 0x1f, 0x4d, 0x13, 0xa1, 0x6b, 0x04, 0x51, 0x44, 0xd6, 0x2d, 0x58, 0x18, 0x99, 0x07, 0xa4,
-	0xbe, 0xc0, 0x1a, 0x17, 0x20, 0x4b, 0xf3, 0x27, 0x7b, 0xee, 0x38, 0x22, 0x7a, 0x0c, 0xf3, 0x51,
-	0x5a, 0xc5, 0x4c, 0xb4, 0xa2, 0x68, 0xa8, 0x03, 0x4d, 0xcd, 0xb6, 0x86, 0x43, 0xac, 0x75, 0x1d,
-	0x8f, 0x54, 0x29, 0x1b, 0x29, 0x8e, 0x27, 0x48, 0x7d, 0x00, 0x67, 0xa3, 0x9c, 0x76, 0x34, 0x92,
-	0x8e, 0x92, 0x33, 0x4c, 0xfa, 0x84, 0x6e, 0xc1, 0x42, 0x1c, 0xbe, 0x4c, 0xe1, 0xe3, 0x1f, 0xd0,
-	0xfb, 0x80, 0x22, 0xac, 0x12, 0xf0, 0x0a, 0x03, 0x0f, 0x33, 0xd3, 0xd1, 0x1c, 0xf9, 0x1b, 0x09,
-	0x16, 0x9f, 0xab, 0x6e, 0xef, 0x70, 0xc3, 0xe0, 0xb6, 0x36, 0x83, 0xa7, 0xfa, 0x25, 0x54, 0x5e,
-	0x72, 0xbd, 0xf0, 0xc2, 0xd1, 0xe5, 0x04, 0xf9, 0x04, 0x35, 0x50, 0xf1, 0x31, 0xe4, 0x1f, 0x25,
-	0x38, 0x47, 0x8b, 0x4b, 0x4f, 0x58, 0x3f, 0xbd, 0xcf, 0x9c, 0x50, 0x60, 0xa2, 0xeb, 0xd0, 0x30,
-	0x54, 0xfb, 0x68, 0xd7, 0x87, 0x29, 0x52, 0x98, 0xc8, 0xac, 0xfc, 0x0a, 0x80, 0x8f, 0xb6, 0x9d,
-	0xfe, 0x14, 0xfc, 0x7f, 0x0c, 0x73, 0x7c, 0x55, 0xee, 0x3e, 0x27, 0xe9, 0x99, 0x07, 0x2e, 0xff,
-	0xbb, 0x04, 0x0d, 0x3f, 0x20, 0x52, 0x23, 0x6f, 0x40, 0x4e, 0x98, 0x76, 0xae, 0xb3, 0x81, 0x7e,
-	0x09, 0x25, 0xd6, 0x78, 0xe0, 0xb4, 0xaf, 0x85, 0x69, 0xf3, 0xa6, 0x44, 0x20, 0xaa, 0xd2, 0x09,
-	0x85, 0x23, 0x11, 0x19, 0x89, 0x20, 0x22, 0x9c, 0x8f, 0x3f, 0x83, 0x3a, 0x30, 0x1f, 0x4e, 0xd8,
-	0x3d, 0x13, 0x5e, 0x49, 0x0b, 0x1e, 0x1b, 0xaa, 0xab, 0xd2, 0xd8, 0xd1, 0x08, 0xe5, 0xeb, 0x8e,
-	0xfc, 0xbf, 0x45, 0xa8, 0x06, 0x76, 0x19, 0xdb, 0x49, 0xf4, 0x48, 0x73, 0x93, 0xc3, 0x60, 0x3e,
-	0x5e, 0x35, 0x5e, 0x83, 0x86, 0x4e, 0x53, 0xaf, 0x2e, 0x57, 0x45, 0xea, 0x35, 0x2b, 0x4a, 0x9d,
-	0xcd, 0x72, 0xbb, 0x40, 0xcb, 0x50, 0x35, 0x47, 0x46, 0xd7, 0x3a, 0xe8, 0xda, 0xd6, 0xb1, 0xc3,
-	0xcb, 0xcf, 0x8a, 0x39, 0x32, 0x7e, 0xeb, 0x40, 0xb1, 0x8e, 0x1d, 0xbf, 0xc2, 0x29, 0x9d, 0xb2,
-	0xc2, 0x59, 0x86, 0xaa, 0xa1, 0xbe, 0x22, 0x54, 0xbb, 0xe6, 0xc8, 0xa0, 0x95, 0x69, 0x5e, 0xa9,
-	0x18, 0xea, 0x2b, 0xc5, 0x3a, 0x7e, 0x32, 0x32, 0xd0, 0x2a, 0x34, 0x07, 0xaa, 0xe3, 0x76, 0x83,
-	0xa5, 0x6d, 0x99, 0x96, 0xb6, 0x0d, 0x32, 0xff, 0xd0, 0x2f, 0x6f, 0xe3, 0xb5, 0x52, 0x65, 0x86,
-	0x5a, 0x49, 0x33, 0x06, 0x3e, 0x21, 0xc8, 0x5e, 0x2b, 0x69, 0xc6, 0x40, 0x90, 0xf9, 0x18, 0xe6,
-	0xf6, 0x69, 0x42, 0xeb, 0xb4, 0xaa, 0xa9, 0x0e, 0xf3, 0x11, 0xc9, 0x65, 0x59, 0xde, 0xab, 0x78,
-	0xe0, 0xe8, 0x17, 0x50, 0xa1, 0x99, 0x04, 0xc5, 0xad, 0x65, 0xc2, 0xf5, 0x11, 0x08, 0xb6, 0x86,
-	0x07, 0xae, 0x4a, 0xb1, 0xeb, 0xd9, 0xb0, 0x05, 0x02, 0x71, 0xd2, 0x3d, 0x1b, 0xab, 0x2e, 0xd6,
-	0xd6, 0x4f, 0x1e, 0x58, 0xc6, 0x50, 0xa5, 0xca, 0xd4, 0x6a, 0xd0, 0xa2, 0x25, 0xe9, 0x13, 0x71,
-	0x0c, 0x3d, 0x31, 0x7a, 0x64, 0x5b, 0x46, 0x6b, 0x9e, 0x39, 0x86, 0xf0, 0x2c, 0xba, 0x04, 0xe0,
-	0xb9, 0x67, 0xd5, 0x6d, 0x35, 0xe9, 0x29, 0x56, 0xf8, 0xcc, 0x7d, 0x57, 0xfe, 0x12, 0xce, 0xf9,
-	0x1a, 0x12, 0x38, 0x8d, 0xf8, 0xc1, 0x4a, 0xd3, 0x1e, 0xec, 0xf8, 0x52, 0xe4, 0x3f, 0x0b, 0xb0,
-	0xb8, 0xab, 0xbe, 0xc4, 0x6f, 0xbe, 0xea, 0xc9, 0xe4, 0x8f, 0xb7, 0x60, 0x81, 0x16, 0x3a, 0x6b,
-	0x01, 0x7e, 0xc6, 0x24, 0x04, 0xc1, 0xe3, 0x8c, 0x23, 0xa2, 0x5f, 0x91, 0x4c, 0x06, 0xf7, 0x8e,
-	0x76, 0x2c, 0xdd, 0x4f, 0x06, 0x2e, 0x25, 0xd0, 0x79, 0x20, 0xa0, 0x94, 0x20, 0x06, 0xda, 0x89,
-	0xbb, 0x36, 0x96, 0x06, 0xbc, 0x37, 0xb6, 0xf6, 0xf6, 0xa5, 0x1f, 0xf5, 0x70, 0xa8, 0x05, 0x73,
-	0x3c, 0x86, 0x53, 0xbb, 0x2f, 0x2b, 0xde, 0x10, 0xed, 0xc0, 0x59, 0xb6, 0x83, 0x5d, 0xae, 0xd4,
-	0x6c, 0xf3, 0xe5, 0x4c, 0x9b, 0x4f, 0x42, 0x0d, 0xdb, 0x44, 0xe5, 0xb4, 0x36, 0xd1, 0x82, 0x39,
-	0xae, 0xa7, 0xd4, 0x17, 0x94, 0x15, 0x6f, 0x48, 0x8e, 0x59, 0xa7, 0xfd, 0x4c, 0xdd, 0xec, 0xb7,
-	0xaa, 0xf4, 0x9b, 0x3f, 0x41, 0x2a, 0x46, 0xf0, 0xe5, 0x39, 0xa1, 0x4b, 0xf4, 0x09, 0x94, 0x85,
-	0x86, 0xe7, 0x32, 0x6b, 0xb8, 0xc0, 0x89, 0xfa, 0xe8, 0x7c, 0xc4, 0x47, 0xcb, 0xff, 0x21, 0x41,
-	0x6d, 0x83, 0x6c, 0x69, 0xcb, 0xea, 0xd3, 0x88, 0x72, 0x0d, 0x1a, 0x36, 0xee, 0x59, 0xb6, 0xd6,
-	0xc5, 0xa6, 0x6b, 0xeb, 0x98, 0x35, 0x17, 0x0a, 0x4a, 0x9d, 0xcd, 0x3e, 0x64, 0x93, 0x04, 0x8c,
-	0xb8, 0x5d, 0xc7, 0x55, 0x8d, 0x61, 0xf7, 0x80, 0x98, 0x77, 0x8e, 0x81, 0x89, 0x59, 0x6a, 0xdd,
-	0x57, 0xa0, 0xe6, 0x83, 0xb9, 0x16, 0x5d, 0xbf, 0xa0, 0x54, 0xc5, 0xdc, 0x9e, 0x85, 0xde, 0x85,
-	0x06, 0x95, 0x69, 0x77, 0x60, 0xf5, 0xbb, 0xa4, 0x10, 0xe7, 0xc1, 0xa6, 0xa6, 0x71, 0xb6, 0xc8,
-	0x59, 0x85, 0xa1, 0x1c, 0xfd, 0x0b, 0xcc, 0xc3, 0x8d, 0x80, 0xda, 0xd5, 0xbf, 0xc0, 0x24, 0xd6,
-	0xd7, 0x49, 0xec, 0x7c, 0x62, 0x69, 0x78, 0x6f, 0xca, 0x4c, 0x23, 0x43, 0xc7, 0xf6, 0x22, 0x54,
-	0xc4, 0x0e, 0xf8, 0x96, 0xfc, 0x09, 0xf4, 0x08, 0x1a, 0x5e, 0x4e, 0xdc, 0x65, 0xa5, 0x62, 0x21,
-	0x35, 0xf3, 0x0b, 0x44, 0x3f, 0x47, 0xa9, 0x7b, 0x68, 0x74, 0x28, 0x3f, 0x82, 0x5a, 0xf0, 0x33,
-	0x59, 0x75, 0x37, 0xaa, 0x28, 0x62, 0x82, 0x68, 0xe3, 0x93, 0x91, 0x41, 0xce, 0x94, 0x3b, 0x16,
-	0x6f, 0x28, 0x7f, 0x2d, 0x41, 0x9d, 0x87, 0xec, 0x5d, 0x71, 0xdb, 0x40, 0xb7, 0x26, 0xd1, 0xad,
-	0xd1, 0xdf, 0xe8, 0xd7, 0xc3, 0xed, 0xc8, 0x77, 0x13, 0x9d, 0x00, 0x25, 0x42, 0xb3, 0xe3, 0x50,
-	0xbc, 0xce, 0xd2, 0x9a, 0xf8, 0x8a, 0x28, 0x1a, 0x3f, 0x1a, 0xaa, 0x68, 0x2d, 0x98, 0x53, 0x35,
-	0xcd, 0xc6, 0x8e, 0xc3, 0xf9, 0xf0, 0x86, 0xe4, 0xcb, 0x4b, 0x6c, 0x3b, 0x9e, 0xca, 0xe7, 0x15,
-	0x6f, 0x88, 0x7e, 0x01, 0x65, 0x91, 0x4e, 0xe7, 0x93, 0x52, 0xa8, 0x20, 0x9f, 0xbc, 0x94, 0x16,
-	0x18, 0xf2, 0x3f, 0xe7, 0xa0, 0xc1, 0x05, 0xb6, 0xce, 0x63, 0xea, 0x78, 0xe3, 0x5b, 0x87, 0xda,
-	0x81, 0x6f, 0xfb, 0xe3, 0x5a, 0x66, 0x41, 0x17, 0x11, 0xc2, 0x99, 0x64, 0x80, 0xe1, 0xa8, 0x5e,
-	0x98, 0x29, 0xaa, 0x17, 0x4f, 0xeb, 0xc1, 0xe2, 0x79, 0x5e, 0x29, 0x21, 0xcf, 0x93, 0x7f, 0x07,
-	0xaa, 0x01, 0x02, 0xd4, 0x43, 0xb3, 0x5e, 0x1b, 0x97, 0x98, 0x37, 0x44, 0x77, 0xfd, 0xdc, 0x86,
-	0x89, 0xea, 0x7c, 0x02, 0x2f, 0x91, 0xb4, 0x46, 0xfe, 0x3b, 0x09, 0x4a, 0x9c, 0xf2, 0x65, 0xa8,
-	0x72, 0xa7, 0x43, 0xf3, 0x3e, 0x46, 0x1d, 0xf8, 0x14, 0x49, 0xfc, 0x5e, 0x9f, 0xd7, 0x39, 0x0f,
-	0xe5, 0x88, 0xbf, 0x99, 0xe3, 0x61, 0xc1, 0xfb, 0x14, 0x70, 0x32, 0xe4, 0x13, 0xf5, 0x2f, 0x3f,
-	0x4a, 0xf4, 0x52, 0x41, 0xc1, 0x3d, 0xeb, 0x25, 0xb6, 0x4f, 0x66, 0xef, 0xc6, 0xde, 0x0b, 0x28,
-	0x74, 0xc6, 0xfa, 0x50, 0x20, 0xa0, 0x7b, 0xbe, 0xb8, 0xf3, 0x49, 0xcd, 0xa8, 0xa0, 0x87, 0xe1,
-	0xea, 0xe8, 0x8b, 0xfd, 0x4f, 0x58, 0x5f, 0x39, 0xbc, 0x95, 0x69, 0xf3, 0x9a, 0xd7, 0x52, 0x76,
-	0xc8, 0x7f, 0x26, 0xc1, 0xf9, 0x4d, 0xec, 0x3e, 0x0a, 0xf7, 0x1a, 0xde, 0x36, 0x57, 0x06, 0xb4,
-	0x93, 0x98, 0x9a, 0xe5, 0xd4, 0xdb, 0x50, 0x16, 0x5d, 0x13, 0x76, 0x3b, 0x20, 0xc6, 0xf2, 0x1f,
-	0x48, 0xd0, 0xe2, 0xab, 0xd0, 0x35, 0x49, 0x4a, 0x3d, 0xc0, 0x2e, 0xd6, 0x7e, 0xea, 0xba, 0xf9,
-	0xdf, 0x24, 0x68, 0x06, 0x3d, 0x3e, 0x75, 0xda, 0x1f, 0x41, 0x91, 0xb6, 0x27, 0x38, 0x07, 0x13,
-	0x95, 0x95, 0x41, 0x13, 0x97, 0x41, 0xd3, 0xbc, 0x3d, 0x11, 0x9c, 0xf8, 0xd0, 0x0f, 0x3b, 0xf9,
-	0xd3, 0x87, 0x1d, 0x1e, 0x86, 0xad, 0x11, 0xa1, 0xcb, 0xfa, 0x7a, 0xfe, 0x84, 0xfc, 0x1b, 0xb0,
-	0xe8, 0x97, 0x23, 0x0c, 0x6f, 0x5a, 0x4d, 0x92, 0xbf, 0xcf, 0x41, 0x2b, 0x40, 0xec, 0xa7, 0x8e,
-	0x21, 0x29, 0x99, 0x6f, 0xfe, 0x35, 0x65, 0xbe, 0x85, 0xd9, 0xe3, 0x46, 0x31, 0x29, 0x6e, 0xfc,
-	0x6b, 0x0e, 0x1a, 0xbe, 0xd4, 0x76, 0x06, 0xaa, 0x89, 0x16, 0xa1, 0x34, 0x1c, 0xa8, 0x7e, 0x63,
-	0x95, 0x8f, 0xd0, 0xae, 0xc8, 0x99, 0xc2, 0x72, 0xfa, 0x59, 0x92, 0x3e, 0xa4, 0x1c, 0x84, 0x12,
-	0x21, 0x41, 0x4a, 0x4b, 0x56, 0x9c, 0xd0, 0x06, 0x01, 0xcf, 0xd3, 0x98, 0xe2, 0xe9, 0x06, 0x46,
-	0xb7, 0x00, 0x71, 0x6d, 0xe9, 0xea, 0x66, 0xd7, 0xc1, 0x3d, 0xcb, 0xd4, 0x98, 0x1e, 0x15, 0x95,
-	0x26, 0xff, 0xd2, 0x31, 0x77, 0xd9, 0x3c, 0xfa, 0x08, 0x0a, 0xee, 0xc9, 0x90, 0x45, 0x84, 0x46,
-	0xa2, 0xa7, 0xf5, 0xf9, 0xda, 0x3b, 0x19, 0x62, 0x85, 0x82, 0xa3, 0x65, 0x00, 0x42, 0xca, 0xb5,
-	0xd5, 0x97, 0x3c, 0xbc, 0x16, 0x94, 0xc0, 0x0c, 0xb1, 0x0c, 0x4f, 0x86, 0x73, 0x2c, 0x0c, 0xf1,
-	0xa1, 0xfc, 0x43, 0x0e, 0x9a, 0x3e, 0x49, 0x05, 0x3b, 0xa3, 0x81, 0x9b, 0x2a, 0xbf, 0xf1, 0x85,
-	0xe5, 0xa4, 0x1c, 0xe4, 0x57, 0x50, 0xe5, 0xe7, 0x79, 0x0a, 0x7d, 0x00, 0x86, 0xb2, 0x35, 0x46,
-	0x41, 0x8b, 0xaf, 0x49, 0x41, 0x4b, 0xa7, 0x54, 0x50, 0xf9, 0x3b, 0x09, 0xde, 0x89, 0x19, 0xff,
-	0x58, 0x01, 0x8e, 0x4f, 0x7f, 0xb9, 0x53, 0x88, 0x92, 0xe4, 0x7e, 0xe8, 0x1e, 0x94, 0x6c, 0x4a,
-	0x9d, 0xb7, 0xf9, 0xaf, 0x8e, 0x55, 0x0e, 0xc6, 0x88, 0xc2, 0x51, 0xe4, 0x3f, 0x95, 0x60, 0x29,
-	0xce, 0xea, 0x0c, 0xc1, 0x65, 0x1d, 0xe6, 0x18, 0x69, 0xcf, 0x86, 0x56, 0xc7, 0xdb, 0x90, 0x2f,
-	0x1c, 0xc5, 0x43, 0x94, 0x77, 0x61, 0xd1, 0x8b, 0x41, 0xbe, 0x80, 0xb7, 0xb1, 0xab, 0x8e, 0x49,
-	0xfe, 0x2e, 0x43, 0x95, 0xe5, 0x16, 0x2c, 0xa9, 0x62, 0x65, 0x13, 0xec, 0x8b, 0x6e, 0x83, 0xfc,
-	0xbb, 0x70, 0x8e, 0xfa, 0xf0, 0x68, 0x5b, 0x3d, 0xcb, 0x95, 0x8b, 0x2c, 0x8a, 0x32, 0x52, 0x80,
-	0xb1, 0x9d, 0x55, 0x94, 0xd0, 0x9c, 0xbc, 0x05, 0xef, 0x44, 0xe8, 0xcf, 0x20, 0x46, 0x92, 0x96,
-	0x2e, 0xee, 0x86, 0x9f, 0x27, 0x4c, 0x9f, 0x89, 0x5c, 0x12, 0x5d, 0xf4, 0xae, 0xae, 0x45, 0xed,
-	0x53, 0x43, 0x9f, 0x40, 0xc5, 0xc4, 0xc7, 0xdd, 0x60, 0x20, 0xcc, 0xd0, 0x2c, 0x2d, 0x9b, 0xf8,
-	0x98, 0xfe, 0x92, 0x9f, 0xc0, 0x52, 0x8c, 0xd5, 0x59, 0xf6, 0xfe, 0x2f, 0x12, 0x9c, 0xdf, 0xb0,
-	0xad, 0xe1, 0xa7, 0xba, 0xed, 0x8e, 0xd4, 0x41, 0xf8, 0xce, 0xf1, 0xcd, 0x94, 0xd4, 0x8f, 0x03,
-	0x29, 0x11, 0x8b, 0x6b, 0xb7, 0x12, 0xd4, 0x36, 0xce, 0x14, 0xdf, 0x74, 0x20, 0x81, 0xfa, 0xef,
-	0x7c, 0x12, 0xf3, 0x1c, 0x6e, 0x42, 0xb0, 0xce, 0x92, 0x31, 0x26, 0x76, 0xe0, 0xf2, 0xd3, 0x76,
-	0xe0, 0x52, 0x3c, 0x67, 0xe1, 0x35, 0x79, 0xce, 0x53, 0x97, 0x84, 0x8f, 0x21, 0xdc, 0x1d, 0xa5,
-	0x21, 0x6b, 0xaa, 0xb6, 0xea, 0x3a, 0x80, 0xdf, 0x29, 0xe4, 0xaf, 0xcb, 0xb2, 0x90, 0x09, 0x60,
-	0x91, 0xd3, 0x12, 0x51, 0x8a, 0x76, 0xf8, 0x43, 0xbd, 0xab, 0xa7, 0xd0, 0x4e, 0xd2, 0xd2, 0x59,
-	0x34, 0xff, 0xfb, 0x1c, 0x00, 0x7b, 0x8a, 0xb8, 0xa7, 0x3a, 0x47, 0xd3, 0x39, 0xe0, 0xab, 0x50,
-	0xf7, 0x15, 0xc6, 0xb7, 0xf7, 0xa0, 0x16, 0x69, 0xc4, 0x24, 0x44, 0x91, 0x41, 0x60, 0x62, 0x85,
-	0x87, 0x46, 0xe9, 0x04, 0xac, 0x86, 0x29, 0x45, 0xc4, 0xe9, 0xa1, 0x0b, 0x50, 0xb1, 0xad, 0xe3,
-	0x2e, 0x31, 0x33, 0xcd, 0x7b, 0x4d, 0x69, 0x5b, 0xc7, 0xc4, 0xf8, 0x34, 0xb4, 0x04, 0x73, 0xae,
-	0xea, 0x1c, 0x11, 0xfa, 0xa5, 0xc0, 0xb5, 0xb7, 0x86, 0xce, 0x41, 0xf1, 0x40, 0x1f, 0x60, 0x76,
-	0xcb, 0x5a, 0x51, 0xd8, 0x00, 0xfd, 0xdc, 0x7b, 0x1a, 0x54, 0xce, 0xfc, 0xb4, 0x81, 0xbd, 0x0e,
-	0xfa, 0x51, 0x82, 0x79, 0x5f, 0x6a, 0xd4, 0x01, 0x11, 0x9f, 0x46, 0xfd, 0xd9, 0x03, 0x4b, 0x63,
-	0xae, 0xa2, 0x91, 0x72, 0xdd, 0xc5, 0x10, 0x99, 0xd7, 0xf2, 0x51, 0xc6, 0xd5, 0x48, 0x64, 0x5f,
-	0x64, 0xd3, 0xba, 0xe6, 0xdd, 0xb6, 0x95, 0x6c, 0xeb, 0xb8, 0xa3, 0x09, 0x69, 0xb0, 0xe7, 0x94,
-	0xac, 0x22, 0x20, 0xd2, 0x78, 0x40, 0x5f, 0x54, 0x5e, 0x85, 0x3a, 0xb6, 0x6d, 0xcb, 0xee, 0x1a,
-	0xd8, 0x71, 0xd4, 0x3e, 0xe6, 0x49, 0x6b, 0x8d, 0x4e, 0x6e, 0xb3, 0x39, 0xf9, 0x87, 0x3c, 0x34,
-	0xfc, 0xad, 0x78, 0x77, 0x6c, 0xba, 0xe6, 0xdd, 0xb1, 0xe9, 0x1a, 0x71, 0xe6, 0x36, 0x73, 0x85,
-	0x01, 0x67, 0xce, 0x67, 0x3a, 0x1a, 0x89, 0x83, 0xc4, 0xc0, 0x4c, 0x4b, 0xc3, 0xfe, 0xc1, 0x82,
-	0x37, 0xc5, 0xcf, 0x35, 0xa4, 0x1f, 0x85, 0x0c, 0xfa, 0x51, 0xcc, 0xa0, 0x1f, 0xa5, 0x04, 0xfd,
-	0x58, 0x84, 0xd2, 0xfe, 0xa8, 0x77, 0x84, 0x5d, 0x9e, 0x5e, 0xf2, 0x51, 0x58, 0x6f, 0xca, 0x11,
-	0xbd, 0x11, 0xea, 0x51, 0x09, 0xaa, 0xc7, 0x05, 0xa8, 0xb0, 0x8b, 0x9e, 0xae, 0xeb, 0xd0, 0x8e,
-	0x77, 0x5e, 0x29, 0xb3, 0x89, 0x3d, 0x07, 0x7d, 0xec, 0xe5, 0x4f, 0xd5, 0x24, 0x43, 0xa7, 0x1e,
-	0x27, 0xa2, 0x21, 0x5e, 0xf6, 0x74, 0x0d, 0x1a, 0xf4, 0x19, 0xf9, 0x8b, 0x11, 0xb6, 0x4f, 0xd4,
-	0xfd, 0x01, 0x6e, 0xd5, 0x28, 0x3b, 0x75, 0x32, 0xfb, 0xd4, 0x9b, 0x24, 0x02, 0xa1, 0x60, 0xba,
-	0xa9, 0xe1, 0x57, 0x58, 0x6b, 0xd5, 0x29, 0x10, 0x15, 0x75, 0x87, 0x4d, 0xc9, 0x9f, 0x03, 0xf2,
-	0xd7, 0x98, 0x2d, 0x89, 0x8a, 0x1c, 0x62, 0x2e, 0x7a, 0x88, 0xf2, 0xdf, 0x4b, 0xb0, 0x10, 0x5c,
-	0x6c, 0xda, 0xd0, 0xf8, 0x09, 0x54, 0xd9, 0xcd, 0x40, 0x97, 0x98, 0x26, 0xaf, 0xd1, 0x2f, 0x8d,
-	0x95, 0x9e, 0x02, 0xba, 0xef, 0xa1, 0xae, 0x42, 0xfd, 0xd8, 0xb2, 0x8f, 0x74, 0xb3, 0xdf, 0x25,
-	0x9c, 0x79, 0x06, 0x51, 0xe3, 0x93, 0x4f, 0xc8, 0x9c, 0xfc, 0x8d, 0x04, 0xcb, 0xcf, 0x86, 0x9a,
-	0xea, 0xe2, 0x40, 0x8e, 0x30, 0xeb, 0x2b, 0xac, 0x8f, 0xbc, 0x87, 0x50, 0xb9, 0x6c, 0xdd, 0x6d,
-	0x06, 0x2d, 0x6f, 0xc3, 0x79, 0x05, 0x3b, 0xd8, 0xd4, 0x42, 0x1f, 0xa7, 0xae, 0xcc, 0x87, 0xd0,
-	0x4e, 0x22, 0x37, 0xcb, 0xd9, 0xb3, 0x64, 0xad, 0x6b, 0x13, 0xb2, 0x2e, 0xf7, 0x3d, 0x24, 0x47,
-	0xa0, 0xeb, 0xb8, 0xf2, 0xff, 0x48, 0xb0, 0x70, 0x5f, 0xf3, 0xd6, 0x7b, 0x63, 0x39, 0x61, 0x34,
-	0x67, 0xca, 0xc7, 0x73, 0xa6, 0xd7, 0xe5, 0x48, 0xb8, 0x3b, 0x35, 0x47, 0x86, 0x17, 0x26, 0x6c,
-	0x7a, 0xcf, 0x2e, 0x1f, 0x88, 0xcb, 0x57, 0x05, 0x1f, 0x60, 0x1b, 0x9b, 0x3d, 0xbc, 0x65, 0xf5,
-	0x8e, 0x02, 0xaf, 0xa9, 0xa4, 0xe0, 0x6b, 0xaa, 0x69, 0x5f, 0x67, 0xdd, 0xfc, 0x4b, 0x09, 0x16,
-	0x62, 0x5d, 0x1e, 0xd4, 0x00, 0x78, 0x66, 0xf6, 0x78, 0xfb, 0xab, 0x79, 0x06, 0xd5, 0xa0, 0xec,
-	0x35, 0xc3, 0x9a, 0x12, 0xaa, 0xc2, 0xdc, 0x9e, 0x45, 0xa1, 0x9b, 0x39, 0xd4, 0x84, 0x1a, 0x43,
-	0x1c, 0xf5, 0x7a, 0xd8, 0x71, 0x9a, 0x79, 0x31, 0xf3, 0x48, 0xd5, 0x07, 0x23, 0x1b, 0x37, 0x0b,
-	0xa8, 0x0e, 0x95, 0x3d, 0x8b, 0xbf, 0x45, 0x6b, 0x16, 0x11, 0x82, 0x86, 0xf7, 0x30, 0x8d, 0x23,
-	0x95, 0x02, 0x73, 0x1e, 0xda, 0xdc, 0xcd, 0x83, 0x60, 0x0f, 0x83, 0x14, 0xf6, 0x68, 0x09, 0xce,
-	0x3e, 0x33, 0x35, 0x7c, 0xa0, 0x9b, 0x58, 0xf3, 0x3f, 0x35, 0xcf, 0xa0, 0xb3, 0x30, 0xdf, 0x31,
-	0x4d, 0x6c, 0x07, 0x26, 0x25, 0x32, 0xb9, 0x8d, 0xed, 0x3e, 0x0e, 0x4c, 0xe6, 0xd0, 0x02, 0xd4,
-	0xb7, 0xf5, 0x57, 0x81, 0xa9, 0xfc, 0xda, 0xef, 0x2d, 0x41, 0x65, 0x43, 0x75, 0xd5, 0x07, 0x96,
-	0x65, 0x6b, 0x68, 0x08, 0x88, 0xbe, 0xe3, 0x34, 0x86, 0x96, 0x29, 0x5e, 0x47, 0xa3, 0x0f, 0x52,
-	0xd2, 0xa7, 0x38, 0x28, 0x57, 0xcb, 0xf6, 0xf5, 0x14, 0x8c, 0x08, 0xb8, 0x7c, 0x06, 0x19, 0x74,
-	0xc5, 0x3d, 0xdd, 0xc0, 0x7b, 0x7a, 0xef, 0xc8, 0x7b, 0xe2, 0x31, 0x66, 0xc5, 0x08, 0xa8, 0xb7,
-	0x62, 0xa4, 0xf8, 0xe5, 0x03, 0xf6, 0xd8, 0xd6, 0xb3, 0x4b, 0xf9, 0x0c, 0x7a, 0x01, 0xe7, 0x36,
-	0x71, 0xc0, 0x0f, 0x79, 0x0b, 0xae, 0xa5, 0x2f, 0x18, 0x03, 0x3e, 0xe5, 0x92, 0x5b, 0x50, 0xa4,
-	0x1d, 0x55, 0x94, 0xe4, 0xaa, 0x82, 0x7f, 0x2f, 0x6a, 0xaf, 0xa4, 0x03, 0x08, 0x6a, 0x9f, 0xc3,
-	0x7c, 0xe4, 0x2f, 0x10, 0xe8, 0x46, 0x02, 0x5a, 0xf2, 0x9f, 0x59, 0xda, 0x37, 0xb3, 0x80, 0x8a,
-	0xb5, 0xfa, 0xd0, 0x08, 0xbf, 0x02, 0x45, 0x49, 0x35, 0x7d, 0xe2, 0xeb, 0xf5, 0xf6, 0x8d, 0x0c,
-	0x90, 0x62, 0x21, 0x03, 0x9a, 0xd1, 0x27, 0xf9, 0xe8, 0xe6, 0x58, 0x02, 0x61, 0x75, 0xfb, 0x59,
-	0x26, 0x58, 0xb1, 0xdc, 0x09, 0x55, 0x82, 0xd8, 0x2b, 0x6f, 0x74, 0x3b, 0x99, 0x4c, 0xda, 0xf3,
-	0xf3, 0xf6, 0x9d, 0xcc, 0xf0, 0x62, 0xe9, 0xdf, 0x67, 0x37, 0x39, 0x49, 0x2f, 0xa5, 0xd1, 0x87,
-	0xc9, 0xe4, 0xc6, 0x3c, 0xf1, 0x6e, 0xaf, 0x9d, 0x06, 0x45, 0x30, 0xf1, 0x25, 0xbd, 0x82, 0x49,
-	0x78, 0x6d, 0x1c, 0xb5, 0x3b, 0x8f, 0x5e, 0xfa, 0x33, 0xea, 0xf6, 0x87, 0xa7, 0xc0, 0x10, 0x0c,
-	0x58, 0xd1, 0xff, 0x3c, 0x78, 0x66, 0x78, 0x67, 0xa2, 0xd6, 0x4c, 0x67, 0x83, 0x9f, 0xc1, 0x7c,
-	0xe4, 0x31, 0x4d, 0xa2, 0xd5, 0x24, 0x3f, 0xb8, 0x69, 0x8f, 0x0b, 0xdf, 0xcc, 0x24, 0x23, 0x37,
-	0x5a, 0x28, 0x45, 0xfb, 0x13, 0x6e, 0xbd, 0xda, 0x37, 0xb3, 0x80, 0x8a, 0x8d, 0x38, 0xd4, 0x5d,
-	0x46, 0x6e, 0x85, 0xd0, 0xad, 0x64, 0x1a, 0xc9, 0x37, 0x5a, 0xed, 0xf7, 0x33, 0x42, 0x8b, 0x45,
-	0xbb, 0x00, 0x9b, 0xd8, 0xdd, 0xc6, 0xae, 0x4d, 0x74, 0xe4, 0x7a, 0xa2, 0xc8, 0x7d, 0x00, 0x6f,
-	0x99, 0xf7, 0x26, 0xc2, 0x05, 0xbc, 0x72, 0x73, 0x5b, 0x35, 0x49, 0x31, 0xed, 0x3f, 0xe4, 0xba,
-	0x95, 0x88, 0x1e, 0x05, 0x4b, 0xd9, 0x53, 0x2a, 0xb4, 0x58, 0xf2, 0x58, 0x44, 0xba, 0x40, 0x3f,
-	0x32, 0xea, 0x01, 0x7c, 0x9e, 0x93, 0xaf, 0x74, 0xa2, 0x1e, 0x60, 0x0c, 0xbc, 0x58, 0xf8, 0x2b,
-	0x89, 0xfe, 0xa7, 0x25, 0x02, 0xf0, 0x5c, 0x77, 0x0f, 0x77, 0x06, 0xaa, 0xe9, 0x64, 0x61, 0x81,
-	0x02, 0x9e, 0x82, 0x05, 0x0e, 0x2f, 0x58, 0xd0, 0xa0, 0x1e, 0xea, 0x58, 0xa2, 0xa4, 0x57, 0x53,
-	0x49, 0x3d, 0xd3, 0xf6, 0xea, 0x64, 0x40, 0xb1, 0xca, 0x21, 0xd4, 0x3d, 0xad, 0x62, 0xc2, 0xbd,
-	0x91, 0xc6, 0xa9, 0x0f, 0x93, 0x62, 0x14, 0xc9, 0xa0, 0x41, 0xa3, 0x88, 0x37, 0x64, 0x50, 0xb6,
-	0x46, 0xde, 0x38, 0xa3, 0x48, 0xef, 0xf2, 0x30, 0xab, 0x8f, 0x34, 0x3f, 0x93, 0x5d, 0x4a, 0x62,
-	0x2f, 0x37, 0xd1, 0xea, 0x53, 0x7a, 0xa9, 0xf2, 0x19, 0xf4, 0x1c, 0x4a, 0xac, 0x0e, 0x43, 0xef,
-	0x8e, 0x2f, 0xd1, 0x38, 0xf5, 0x6b, 0x13, 0xa0, 0x04, 0xe1, 0x23, 0x58, 0x4a, 0x29, 0xd0, 0x12,
-	0xa3, 0xd1, 0xf8, 0x62, 0x6e, 0x92, 0x9f, 0x54, 0x01, 0xc5, 0xff, 0x33, 0x92, 0x78, 0x4c, 0xa9,
-	0x7f, 0x2d, 0xc9, 0xb0, 0x44, 0xfc, 0x6f, 0x1f, 0x89, 0x4b, 0xa4, 0xfe, 0x3b, 0x64, 0xd2, 0x12,
-	0x4f, 0x01, 0xfc, 0x32, 0x2c, 0xf1, 0x3c, 0x62, 0x55, 0xda, 0x04, 0x92, 0x6b, 0xff, 0x35, 0x07,
-	0x65, 0xef, 0x8d, 0xd2, 0x5b, 0x48, 0xc1, 0xdf, 0x42, 0x4e, 0xfc, 0x19, 0xcc, 0x47, 0xfe, 0xec,
-	0x90, 0x68, 0x3c, 0xc9, 0x7f, 0x88, 0x98, 0x74, 0x42, 0xcf, 0xf9, 0x5f, 0xe3, 0x45, 0x78, 0x7c,
-	0x2f, 0x2d, 0xaf, 0x8e, 0x46, 0xc6, 0x09, 0x84, 0xdf, 0x78, 0x1c, 0x7c, 0x02, 0x10, 0x88, 0x80,
-	0xe3, 0x2f, 0x7b, 0x89, 0x53, 0x9f, 0xc4, 0xb0, 0x91, 0x18, 0xe4, 0x6e, 0x64, 0xb9, 0x98, 0x4b,
-	0x77, 0x53, 0xe9, 0xa1, 0x6d, 0xfb, 0x94, 0x6e, 0x6a, 0x02, 0xf7, 0x0e, 0x31, 0xe6, 0x68, 0x8f,
-	0x25, 0xc5, 0x98, 0x53, 0x3a, 0x3b, 0x89, 0x6e, 0x3d, 0xbd, 0x71, 0xf3, 0x46, 0xcc, 0x7b, 0xfd,
-	0xee, 0x6f, 0x7f, 0xd8, 0xd7, 0xdd, 0xc3, 0xd1, 0x3e, 0xf9, 0x72, 0x87, 0x81, 0xbe, 0xaf, 0x5b,
-	0xfc, 0xd7, 0x1d, 0xcf, 0xae, 0xee, 0x50, 0xec, 0x3b, 0x64, 0x8d, 0xe1, 0xfe, 0x7e, 0x89, 0x8e,
-	0xee, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0xfe, 0xd9, 0xf2, 0xec, 0x42, 0x00, 0x00,
+	// 3793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x99, 0xea, 0x79, 0xcf, 0x37, 0x0f, 0x0e, 0x4b, 0x32, 0x39, 0x1a, 0x49, 0x14, 0xd5, 0xb6, 0x64,
+	0x4a, 0x96, 0x28, 0x99, 0x5a, 0x63, 0x8d, 0x95, 0x1f, 0x10, 0x45, 0x89, 0x9a, 0x5d, 0x52, 0x4b,
+	0x35, 0x29, 0x0b, 0x58, 0x2f, 0x76, 0xd0, 0x9c, 0x2e, 0x0e, 0xdb, 0x9c, 0xee, 0x1e, 0x75, 0xf7,
+	0x88, 0xa2, 0x2f, 0xf6, 0xee, 0x02, 0x0b, 0xec, 0x62, 0x11, 0x07, 0x09, 0x02, 0x24, 0x40, 0x02,
+	0x04, 0x39, 0xe5, 0x81, 0x9c, 0x8c, 0x1c, 0x9c, 0x20, 0x77, 0x23, 0x39, 0xe4, 0x0f, 0xe4, 0x9e,
+	0x9c, 0x72, 0xc8, 0x2f, 0x08, 0xea, 0xd1, 0xef, 0xee, 0x99, 0xe6, 0x0c, 0x65, 0xe5, 0x36, 0x55,
+	0xfd, 0x7d, 0x5f, 0x7d, 0xf5, 0xd5, 0xf7, 0xae, 0x1a, 0x68, 0x28, 0xb2, 0x2d, 0x77, 0xba, 0x86,
+	0x61, 0x2a, 0xcb, 0x03, 0xd3, 0xb0, 0x0d, 0x34, 0xab, 0xa9, 0xfd, 0xe7, 0x43, 0x8b, 0x8d, 0x96,
+	0xc9, 0xe7, 0x56, 0xb5, 0x6b, 0x68, 0x9a, 0xa1, 0xb3, 0xa9, 0x56, 0x5d, 0xd5, 0x6d, 0x6c, 0xea,
+	0x72, 0x9f, 0x8f, 0xab, 0x7e, 0x84, 0x56, 0xd5, 0xea, 0xee, 0x63, 0x4d, 0x66, 0x23, 0xb1, 0x08,
+	0xf9, 0xfb, 0xda, 0xc0, 0x3e, 0x12, 0xbf, 0x2f, 0x40, 0xf5, 0x41, 0x7f, 0x68, 0xed, 0x4b, 0xf8,
+	0xd9, 0x10, 0x5b, 0x36, 0xba, 0x05, 0xb9, 0x5d, 0xd9, 0xc2, 0x4d, 0x61, 0x51, 0x58, 0xaa, 0xac,
+	0x9c, 0x5f, 0x0e, 0xac, 0xca, 0xd7, 0xdb, 0xb4, 0x7a, 0xab, 0xb2, 0x85, 0x25, 0x0a, 0x89, 0x10,
+	0xe4, 0x94, 0xdd, 0xf6, 0x5a, 0x33, 0xb3, 0x28, 0x2c, 0x65, 0x25, 0xfa, 0x1b, 0x2d, 0x00, 0x58,
+	0xb8, 0xa7, 0x61, 0xdd, 0x6e, 0xaf, 0x59, 0xcd, 0xec, 0x62, 0x76, 0x29, 0x2b, 0xf9, 0x66, 0x90,
+	0x08, 0xd5, 0xae, 0xd1, 0xef, 0xe3, 0xae, 0xad, 0x1a, 0x7a, 0x7b, 0xad, 0x99, 0xa3, 0xb8, 0x81,
+	0x39, 0xf1, 0x87, 0x02, 0xd4, 0x38, 0x6b, 0xd6, 0xc0, 0xd0, 0x2d, 0x8c, 0x6e, 0x43, 0xc1, 0xb2,
+	0x65, 0x7b, 0x68, 0x71, 0xee, 0xce, 0xc5, 0x72, 0xb7, 0x4d, 0x41, 0x24, 0x0e, 0x1a, 0xcb, 0x5e,
+	0x78, 0xf9, 0x6c, 0x74, 0xf9, 0xd0, 0x16, 0x72, 0xe1, 0x2d, 0x88, 0xbf, 0x14, 0xa0, 0xb1, 0xed,
+	0x0c, 0x1d, 0xe9, 0x9d, 0x81, 0x7c, 0xd7, 0x18, 0xea, 0x36, 0x65, 0xb0, 0x26, 0xb1, 0x01, 0xba,
+	0x04, 0xd5, 0xee, 0xbe, 0xac, 0xeb, 0xb8, 0xdf, 0xd1, 0x65, 0x0d, 0x53, 0x56, 0xca, 0x52, 0x85,
+	0xcf, 0x3d, 0x92, 0x35, 0x9c, 0x8a, 0xa3, 0x45, 0xa8, 0x0c, 0x64, 0xd3, 0x56, 0x03, 0x32, 0xf3,
+	0x4f, 0xa1, 0x16, 0x94, 0x54, 0xab, 0xad, 0x0d, 0x0c, 0xd3, 0x6e, 0xe6, 0x17, 0x85, 0xa5, 0x92,
+	0xe4, 0x8e, 0xc5, 0x1f, 0x0b, 0x30, 0x77, 0xd7, 0xb2, 0xd4, 0x9e, 0x1e, 0xe1, 0x7a, 0x0e, 0x0a,
+	0xba, 0xa1, 0xe0, 0xf6, 0x1a, 0x65, 0x3b, 0x2b, 0xf1, 0x11, 0x3a, 0x07, 0xe5, 0x01, 0xc6, 0x66,
+	0xc7, 0x34, 0xfa, 0x0e, 0xd3, 0x25, 0x32, 0x21, 0x19, 0x7d, 0x8c, 0x1e, 0xc3, 0xac, 0x15, 0x22,
+	0xc4, 0x4e, 0xba, 0xb2, 0xf2, 0xfa, 0x72, 0x44, 0x57, 0x97, 0xc3, 0x8b, 0x4a, 0x51, 0x6c, 0xf1,
+	0xf3, 0x0c, 0x9c, 0x76, 0xe1, 0x18, 0xaf, 0xe4, 0x37, 0x91, 0xaa, 0x85, 0x7b, 0x2e, 0x7b, 0x6c,
+	0x90, 0x46, 0xaa, 0xee, 0x71, 0x64, 0xfd, 0xc7, 0x91, 0x42, 0xf9, 0xc2, 0xb2, 0xce, 0x47, 0x65,
+	0x7d, 0x11, 0x2a, 0xf8, 0xc5, 0x40, 0x35, 0x71, 0xc7, 0x56, 0x35, 0xdc, 0x2c, 0x2c, 0x0a, 0x4b,
+	0x39, 0x09, 0xd8, 0xd4, 0x8e, 0xaa, 0xf9, 0xb5, 0xb5, 0x98, 0x5a, 0x5b, 0xc5, 0x9f, 0x08, 0x30,
+	0x1f, 0x39, 0x25, 0xae, 0xfe, 0x12, 0x34, 0xe8, 0xce, 0x3d, 0xc9, 0x10, 0x43, 0x20, 0x02, 0xbf,
+	0x32, 0x4a, 0xe0, 0x1e, 0xb8, 0x14, 0xc1, 0xf7, 0x31, 0x99, 0x49, 0xcf, 0xe4, 0x01, 0xcc, 0xaf,
+	0x63, 0x9b, 0x2f, 0x40, 0xbe, 0x61, 0x6b, 0x72, 0xf7, 0x11, 0xb4, 0xb3, 0x4c, 0xc4, 0xce, 0xfe,
+	0x9a, 0x81, 0x86, 0x7f, 0xa9, 0xb6, 0xbe, 0x67, 0xa0, 0xf3, 0x50, 0x76, 0x41, 0xb8, 0x56, 0x78,
+	0x13, 0xe8, 0x1f, 0x21, 0x4f, 0x38, 0x65, 0x2a, 0x51, 0x5f, 0xb9, 0x14, 0xbf, 0x27, 0x1f, 0x4d,
+	0x89, 0xc1, 0xa3, 0x36, 0xd4, 0x2d, 0x5b, 0x36, 0xed, 0xce, 0xc0, 0xb0, 0xe8, 0x39, 0x53, 0xc5,
+	0xa9, 0xac, 0x88, 0x41, 0x0a, 0xae, 0xa3, 0xdd, 0xb4, 0x7a, 0x5b, 0x1c, 0x52, 0xaa, 0x51, 0x4c,
+	0x67, 0x88, 0xee, 0x43, 0x15, 0xeb, 0x8a, 0x47, 0x28, 0x97, 0x9a, 0x50, 0x05, 0xeb, 0x8a, 0x4b,
+	0xc6, 0x3b, 0x9f, 0x7c, 0x7a, 0x97, 0xb7, 0x00, 0xd0, 0x35, 0xb4, 0x81, 0xdc, 0xb5, 0x55, 0xbd,
+	0x47, 0x35, 0xb3, 0x24, 0xf9, 0x66, 0x88, 0x72, 0xf3, 0x11, 0x56, 0x76, 0x0c, 0xaa, 0x9e, 0x59,
+	0xc9, 0x3f, 0x25, 0xfe, 0xbf, 0x00, 0xcd, 0xe8, 0x11, 0x4f, 0xe3, 0x86, 0xef, 0x30, 0x24, 0xcc,
+	0x8e, 0x78, 0xa4, 0x8f, 0x70, 0x8f, 0x59, 0xe2, 0x28, 0xe2, 0xf7, 0x04, 0x78, 0xcd, 0x63, 0x87,
+	0x7e, 0x7a, 0x59, 0xfa, 0x86, 0xae, 0x41, 0x43, 0xd5, 0xbb, 0xfd, 0xa1, 0x82, 0x9f, 0xe8, 0x0f,
+	0xb1, 0xdc, 0xb7, 0xf7, 0x8f, 0xa8, 0x16, 0x94, 0xa4, 0xc8, 0xbc, 0xf8, 0xdf, 0x02, 0xcc, 0x85,
+	0xf9, 0x9a, 0x46, 0x48, 0xff, 0x00, 0x79, 0x55, 0xdf, 0x33, 0x1c, 0x19, 0x2d, 0x8c, 0x30, 0x6b,
+	0xb2, 0x16, 0x03, 0x16, 0x35, 0x38, 0xb7, 0x8e, 0xed, 0xb6, 0x6e, 0x61, 0xd3, 0x5e, 0x55, 0xf5,
+	0xbe, 0xd1, 0xdb, 0x92, 0xed, 0xfd, 0x29, 0x4c, 0x32, 0x60, 0x5d, 0x99, 0x90, 0x75, 0x89, 0x3f,
+	0x15, 0xe0, 0x7c, 0xfc, 0x7a, 0x7c, 0xeb, 0x2d, 0x28, 0xed, 0xa9, 0xb8, 0xaf, 0xb4, 0xd7, 0x98,
+	0x7f, 0xca, 0x4a, 0xee, 0x98, 0x98, 0xe6, 0x80, 0x00, 0xf3, 0x1d, 0x5e, 0x4a, 0xb0, 0x87, 0x6d,
+	0xdb, 0x54, 0xf5, 0xde, 0x86, 0x6a, 0xd9, 0x12, 0x83, 0xf7, 0xc9, 0x33, 0x9b, 0xde, 0x51, 0xfd,
+	0x9f, 0x00, 0x0b, 0xeb, 0xd8, 0xbe, 0xe7, 0x7a, 0x76, 0xf2, 0x5d, 0xb5, 0x6c, 0xb5, 0x6b, 0x9d,
+	0x6c, 0xbe, 0x93, 0x22, 0x7c, 0x8b, 0x5f, 0x08, 0x70, 0x31, 0x91, 0x19, 0x2e, 0x3a, 0xee, 0xb9,
+	0x1c, 0xbf, 0x1e, 0xef, 0xb9, 0xfe, 0x05, 0x1f, 0x7d, 0x24, 0xf7, 0x87, 0x78, 0x4b, 0x56, 0x4d,
+	0xe6, 0xb9, 0x26, 0xf4, 0xe3, 0xbf, 0x10, 0xe0, 0xc2, 0x3a, 0xb6, 0xb7, 0x9c, 0xa8, 0xf6, 0x0a,
+	0xa5, 0x33, 0x3e, 0xb9, 0x11, 0xbf, 0xc5, 0x0e, 0x33, 0x96, 0xdb, 0x57, 0x22, 0xbe, 0x05, 0x6a,
+	0x07, 0x3e, 0x83, 0xbc, 0xc7, 0x52, 0x0f, 0x2e, 0x3c, 0xf1, 0x47, 0x02, 0x9c, 0xbd, 0xdb, 0x7d,
+	0x36, 0x54, 0x4d, 0xcc, 0x81, 0x36, 0x8c, 0xee, 0xc1, 0xe4, 0xa2, 0xf5, 0xd2, 0xb4, 0x4c, 0x20,
+	0x4d, 0x1b, 0x97, 0x6c, 0xcf, 0x41, 0xc1, 0x96, 0xad, 0x03, 0x57, 0xaa, 0x7c, 0x44, 0xf9, 0x93,
+	0x70, 0x1f, 0xcb, 0xd6, 0xdf, 0x27, 0x7f, 0x5f, 0xe4, 0xa0, 0xfa, 0x11, 0x4f, 0xe7, 0x68, 0xd4,
+	0x0f, 0xeb, 0x91, 0x10, 0xaf, 0x47, 0xbe, 0x0c, 0x30, 0x2e, 0x29, 0x5c, 0x87, 0x9a, 0x85, 0xf1,
+	0xc1, 0x24, 0x31, 0xbe, 0x4a, 0x10, 0x9d, 0x11, 0xda, 0x80, 0xd9, 0xa1, 0xbe, 0x47, 0x2a, 0x14,
+	0xac, 0x70, 0x01, 0xb2, 0x42, 0x61, 0xbc, 0xe7, 0x8e, 0x22, 0xa2, 0x87, 0x30, 0x13, 0xa6, 0x95,
+	0x4f, 0x45, 0x2b, 0x8c, 0x86, 0xda, 0xd0, 0x50, 0x4c, 0x63, 0x30, 0xc0, 0x4a, 0xc7, 0x72, 0x48,
+	0x15, 0xd2, 0x91, 0xe2, 0x78, 0x2e, 0xa9, 0x5b, 0x70, 0x3a, 0xcc, 0x69, 0x5b, 0x21, 0x09, 0x2d,
+	0x39, 0xc3, 0xb8, 0x4f, 0xe8, 0x3a, 0xcc, 0x46, 0xe1, 0x4b, 0x14, 0x3e, 0xfa, 0x01, 0xdd, 0x00,
+	0x14, 0x62, 0x95, 0x80, 0x97, 0x19, 0x78, 0x90, 0x99, 0xb6, 0x62, 0x89, 0xff, 0x2b, 0xc0, 0xdc,
+	0x53, 0xd9, 0xee, 0xee, 0xaf, 0x69, 0xdc, 0xd6, 0xa6, 0xf0, 0x54, 0xef, 0x43, 0xf9, 0x39, 0xd7,
+	0x0b, 0x27, 0x1c, 0x5d, 0x8c, 0x91, 0x8f, 0x5f, 0x03, 0x25, 0x0f, 0x43, 0xfc, 0x5a, 0x80, 0x33,
+	0xb4, 0x3c, 0x75, 0x84, 0xf5, 0xcd, 0xfb, 0xcc, 0x31, 0x25, 0x2a, 0xba, 0x02, 0x75, 0x4d, 0x36,
+	0x0f, 0xb6, 0x3d, 0x98, 0x3c, 0x85, 0x09, 0xcd, 0x8a, 0x2f, 0x00, 0xf8, 0x68, 0xd3, 0xea, 0x4d,
+	0xc0, 0xff, 0xbb, 0x50, 0xe4, 0xab, 0x72, 0xf7, 0x39, 0x4e, 0xcf, 0x1c, 0x70, 0xf1, 0x77, 0x02,
+	0xd4, 0xbd, 0x80, 0x48, 0x8d, 0xbc, 0x0e, 0x19, 0xd7, 0xb4, 0x33, 0xed, 0x35, 0xf4, 0x3e, 0x14,
+	0x58, 0xeb, 0x82, 0xd3, 0xbe, 0x1c, 0xa4, 0xcd, 0xbe, 0x2d, 0xfb, 0xa2, 0x2a, 0x9d, 0x90, 0x38,
+	0x12, 0x91, 0x91, 0x1b, 0x44, 0x5c, 0xe7, 0xe3, 0xcd, 0xa0, 0x36, 0xcc, 0x04, 0x53, 0x7e, 0xc7,
+	0x84, 0x17, 0x93, 0x82, 0xc7, 0x9a, 0x6c, 0xcb, 0x34, 0x76, 0xd4, 0x03, 0x19, 0xbf, 0x25, 0xfe,
+	0x25, 0x0f, 0x15, 0xdf, 0x2e, 0x23, 0x3b, 0x09, 0x1f, 0x69, 0x66, 0x7c, 0x18, 0xcc, 0x46, 0xeb,
+	0xce, 0xcb, 0x50, 0x57, 0x69, 0xea, 0xd5, 0xe1, 0xaa, 0x48, 0xbd, 0x66, 0x59, 0xaa, 0xb1, 0x59,
+	0x6e, 0x17, 0x68, 0x01, 0x2a, 0xfa, 0x50, 0xeb, 0x18, 0x7b, 0x1d, 0xd3, 0x38, 0xb4, 0x78, 0x01,
+	0x5b, 0xd6, 0x87, 0xda, 0xbf, 0xee, 0x49, 0xc6, 0xa1, 0xe5, 0xd5, 0x48, 0x85, 0x63, 0xd6, 0x48,
+	0x0b, 0x50, 0xd1, 0xe4, 0x17, 0x84, 0x6a, 0x47, 0x1f, 0x6a, 0xbc, 0x78, 0x28, 0x6b, 0xf2, 0x0b,
+	0xc9, 0x38, 0x7c, 0x34, 0xd4, 0xd0, 0x12, 0x34, 0xfa, 0xb2, 0x65, 0x77, 0xfc, 0xc5, 0x71, 0x89,
+	0x16, 0xc7, 0x75, 0x32, 0x7f, 0xdf, 0x2b, 0x90, 0xa3, 0xd5, 0x56, 0x79, 0x8a, 0x6a, 0x4b, 0xd1,
+	0xfa, 0x1e, 0x21, 0x48, 0x5f, 0x6d, 0x29, 0x5a, 0xdf, 0x25, 0xf3, 0x2e, 0x14, 0x77, 0x69, 0x42,
+	0x6b, 0x35, 0x2b, 0x89, 0x0e, 0xf3, 0x01, 0xc9, 0x65, 0x59, 0xde, 0x2b, 0x39, 0xe0, 0xe8, 0x3d,
+	0x28, 0xd3, 0x4c, 0x82, 0xe2, 0x56, 0x53, 0xe1, 0x7a, 0x08, 0x04, 0x5b, 0xc1, 0x7d, 0x5b, 0xa6,
+	0xd8, 0xb5, 0x74, 0xd8, 0x2e, 0x02, 0x71, 0xd2, 0x5d, 0x13, 0xcb, 0x36, 0x56, 0x56, 0x8f, 0xee,
+	0xf1, 0x2a, 0xcf, 0xd0, 0x9b, 0x75, 0x5a, 0xb4, 0xc4, 0x7d, 0x22, 0x8e, 0xa1, 0xeb, 0x8e, 0x1e,
+	0x98, 0x86, 0xd6, 0x9c, 0x61, 0x8e, 0x21, 0x38, 0x8b, 0x2e, 0x00, 0x38, 0xee, 0x59, 0xb6, 0x9b,
+	0x0d, 0x7a, 0x8a, 0x65, 0x3e, 0x73, 0xd7, 0x16, 0x3f, 0x83, 0x33, 0x9e, 0x86, 0xf8, 0x4e, 0x23,
+	0x7a, 0xb0, 0xc2, 0xa4, 0x07, 0x3b, 0xba, 0x14, 0xf9, 0x43, 0x0e, 0xe6, 0xb6, 0xe5, 0xe7, 0xf8,
+	0xe5, 0x57, 0x3d, 0xa9, 0xfc, 0xf1, 0x06, 0xcc, 0xd2, 0x42, 0x67, 0xc5, 0xc7, 0x4f, 0x33, 0x97,
+	0xea, 0x38, 0xa3, 0x88, 0xe8, 0x43, 0x92, 0xc9, 0xe0, 0xee, 0xc1, 0x96, 0xa1, 0x7a, 0xc9, 0xc0,
+	0x85, 0x18, 0x3a, 0xf7, 0x5c, 0x28, 0xc9, 0x8f, 0x81, 0xb6, 0xa2, 0xae, 0x8d, 0xa5, 0x01, 0x6f,
+	0x8e, 0xac, 0xbd, 0x3d, 0xe9, 0x87, 0x3d, 0x1c, 0x6a, 0x42, 0x91, 0xc7, 0x70, 0x6a, 0xf7, 0x25,
+	0xc9, 0x19, 0xa2, 0x2d, 0x38, 0xcd, 0x76, 0xb0, 0xcd, 0x95, 0x9a, 0x6d, 0xbe, 0x94, 0x6a, 0xf3,
+	0x71, 0xa8, 0x41, 0x9b, 0x28, 0x1f, 0xd7, 0x26, 0x9a, 0x50, 0xe4, 0x7a, 0x4a, 0x7d, 0x41, 0x49,
+	0x72, 0x86, 0xe4, 0x98, 0x55, 0xda, 0x11, 0x25, 0xbd, 0x91, 0x0a, 0xfd, 0xe6, 0x4d, 0x90, 0x8a,
+	0x11, 0x3c, 0x79, 0x8e, 0xe9, 0x33, 0x7d, 0x00, 0x25, 0x57, 0xc3, 0x33, 0xa9, 0x35, 0xdc, 0xc5,
+	0x09, 0xfb, 0xe8, 0x6c, 0xc8, 0x47, 0x8b, 0xbf, 0x17, 0xa0, 0xba, 0x46, 0xb6, 0xb4, 0x61, 0xf4,
+	0x68, 0x44, 0xb9, 0x0c, 0x75, 0x13, 0x77, 0x0d, 0x53, 0xe9, 0x60, 0xdd, 0x36, 0x55, 0xcc, 0x9a,
+	0x0b, 0x39, 0xa9, 0xc6, 0x66, 0xef, 0xb3, 0x49, 0x02, 0x46, 0xdc, 0xae, 0x65, 0xcb, 0xda, 0xa0,
+	0xb3, 0x47, 0xcc, 0x3b, 0xc3, 0xc0, 0xdc, 0x59, 0x6a, 0xdd, 0x97, 0xa0, 0xea, 0x81, 0xd9, 0x06,
+	0x5d, 0x3f, 0x27, 0x55, 0xdc, 0xb9, 0x1d, 0x03, 0xbd, 0x01, 0x75, 0x2a, 0xd3, 0x4e, 0xdf, 0xe8,
+	0x75, 0x48, 0x21, 0xce, 0x83, 0x4d, 0x55, 0xe1, 0x6c, 0x91, 0xb3, 0x0a, 0x42, 0x59, 0xea, 0xa7,
+	0x98, 0x87, 0x1b, 0x17, 0x6a, 0x5b, 0xfd, 0x14, 0x93, 0x58, 0x5f, 0x23, 0xb1, 0xf3, 0x91, 0xa1,
+	0xe0, 0x9d, 0x09, 0x33, 0x8d, 0x14, 0x3d, 0xdf, 0xf3, 0x50, 0x76, 0x77, 0xc0, 0xb7, 0xe4, 0x4d,
+	0xa0, 0x07, 0x50, 0x77, 0x72, 0xe2, 0x0e, 0x2b, 0x15, 0x73, 0x89, 0x99, 0x9f, 0x2f, 0xfa, 0x59,
+	0x52, 0xcd, 0x41, 0xa3, 0x43, 0xf1, 0x01, 0x54, 0xfd, 0x9f, 0xc9, 0xaa, 0xdb, 0x61, 0x45, 0x71,
+	0x27, 0x88, 0x36, 0x3e, 0x1a, 0x6a, 0xe4, 0x4c, 0xb9, 0x63, 0x71, 0x86, 0xa4, 0x83, 0x54, 0xe3,
+	0x21, 0x7b, 0xdb, 0xbd, 0xaf, 0xa0, 0x5b, 0x13, 0xe8, 0xd6, 0xe8, 0x6f, 0xf4, 0x4f, 0xc1, 0x86,
+	0xe6, 0x1b, 0xb1, 0x4e, 0x80, 0x12, 0xa1, 0xd9, 0x71, 0x20, 0x5e, 0xa7, 0x69, 0x4d, 0x7c, 0x4e,
+	0x14, 0x8d, 0x1f, 0x0d, 0x55, 0xb4, 0x26, 0x14, 0x65, 0x45, 0x31, 0xb1, 0x65, 0x71, 0x3e, 0x9c,
+	0x21, 0xf9, 0xf2, 0x1c, 0x9b, 0x96, 0xa3, 0xf2, 0x59, 0xc9, 0x19, 0xa2, 0xf7, 0xa0, 0xe4, 0xa6,
+	0xd3, 0xd9, 0xb8, 0x14, 0xca, 0xcf, 0x27, 0x2f, 0xa5, 0x5d, 0x0c, 0xf1, 0x57, 0x19, 0xa8, 0x73,
+	0x81, 0xad, 0xf2, 0x98, 0x3a, 0xda, 0xf8, 0x56, 0xa1, 0xba, 0xe7, 0xd9, 0xfe, 0xa8, 0x96, 0x99,
+	0xdf, 0x45, 0x04, 0x70, 0xc6, 0x19, 0x60, 0x30, 0xaa, 0xe7, 0xa6, 0x8a, 0xea, 0xf9, 0xe3, 0x7a,
+	0xb0, 0x68, 0x9e, 0x57, 0x88, 0xc9, 0xf3, 0xc4, 0x7f, 0x87, 0x8a, 0x8f, 0x00, 0xf5, 0xd0, 0xac,
+	0xd7, 0xc6, 0x25, 0xe6, 0x0c, 0xd1, 0x6d, 0x2f, 0xb7, 0x61, 0xa2, 0x3a, 0x1b, 0xc3, 0x4b, 0x28,
+	0xad, 0x11, 0x7f, 0x26, 0x40, 0x81, 0x53, 0x26, 0xf7, 0x1d, 0xcc, 0xbf, 0xd0, 0xbc, 0x8f, 0x51,
+	0x07, 0x3e, 0x45, 0x12, 0xbf, 0x93, 0xf3, 0x3a, 0x67, 0xa1, 0x14, 0xf2, 0x37, 0x45, 0x1e, 0x16,
+	0x9c, 0x4f, 0x3e, 0x27, 0x53, 0xec, 0x73, 0xff, 0xf2, 0xb5, 0x40, 0xaf, 0x25, 0x24, 0xdc, 0x35,
+	0x9e, 0x63, 0xf3, 0x68, 0xfa, 0x6e, 0xec, 0x1d, 0x9f, 0x42, 0xa7, 0xac, 0x0f, 0x5d, 0x04, 0x74,
+	0xc7, 0x13, 0x77, 0x36, 0xae, 0x19, 0xe5, 0xf7, 0x30, 0x5c, 0x1d, 0x3d, 0xb1, 0x7f, 0x9b, 0xf5,
+	0x95, 0x83, 0x5b, 0x99, 0x34, 0xaf, 0x39, 0x91, 0xb2, 0x43, 0xfc, 0xae, 0x00, 0x67, 0xd7, 0xb1,
+	0xfd, 0x20, 0xd8, 0x6b, 0x78, 0xd5, 0x5c, 0x69, 0xd0, 0x8a, 0x63, 0x6a, 0x9a, 0x53, 0x6f, 0x41,
+	0xc9, 0xed, 0x9a, 0xb0, 0xdb, 0x01, 0x77, 0x2c, 0xfe, 0x8f, 0x00, 0x4d, 0xbe, 0x0a, 0x5d, 0x93,
+	0xa4, 0xd4, 0x7d, 0x6c, 0x63, 0xe5, 0x9b, 0xae, 0x9b, 0x7f, 0x2b, 0x40, 0xc3, 0xef, 0xf1, 0xc9,
+	0x57, 0xf4, 0x0e, 0xe4, 0x69, 0x7b, 0x82, 0x73, 0x30, 0x56, 0x59, 0x19, 0x34, 0x71, 0x19, 0x34,
+	0xcd, 0xdb, 0x71, 0x83, 0x13, 0x1f, 0x7a, 0x61, 0x27, 0x7b, 0xfc, 0xb0, 0xc3, 0xc3, 0xb0, 0x31,
+	0x24, 0x74, 0x59, 0x5f, 0xcf, 0x9b, 0x10, 0xff, 0x19, 0xe6, 0xbc, 0x72, 0x84, 0xe1, 0x4d, 0xaa,
+	0x49, 0xe2, 0x97, 0x19, 0x68, 0xfa, 0x88, 0x7d, 0xd3, 0x31, 0x24, 0x21, 0xf3, 0xcd, 0x9e, 0x50,
+	0xe6, 0x9b, 0x9b, 0x3e, 0x6e, 0xe4, 0xe3, 0xe2, 0xc6, 0x6f, 0x32, 0x50, 0xf7, 0xa4, 0xb6, 0xd5,
+	0x97, 0x75, 0xd2, 0x87, 0x1d, 0xf4, 0x65, 0xaf, 0xb1, 0xca, 0x47, 0x68, 0xdb, 0xcd, 0x99, 0x82,
+	0x72, 0x7a, 0x2b, 0x4e, 0x1f, 0x12, 0x0e, 0x42, 0x0a, 0x91, 0x20, 0xa5, 0x25, 0x2b, 0x4e, 0x68,
+	0x83, 0x80, 0xe7, 0x69, 0x4c, 0xf1, 0x48, 0x6f, 0xe0, 0x3a, 0x20, 0xae, 0x2d, 0x1d, 0x55, 0xef,
+	0x58, 0xb8, 0x6b, 0xe8, 0x0a, 0xd3, 0xa3, 0xbc, 0xd4, 0xe0, 0x5f, 0xda, 0xfa, 0x36, 0x9b, 0x47,
+	0xef, 0x40, 0xce, 0x3e, 0x1a, 0xb0, 0x88, 0x50, 0x5f, 0xb9, 0x34, 0x92, 0xaf, 0x9d, 0xa3, 0x01,
+	0x96, 0x28, 0x38, 0xe9, 0x0d, 0x11, 0x52, 0xb6, 0x29, 0x3f, 0xe7, 0xe1, 0x35, 0x27, 0xf9, 0x66,
+	0x88, 0x65, 0x38, 0x32, 0x2c, 0xb2, 0x30, 0xc4, 0x87, 0xe2, 0x57, 0x19, 0x68, 0x78, 0x24, 0x25,
+	0x6c, 0x0d, 0xfb, 0x76, 0xa2, 0xfc, 0x46, 0x17, 0x96, 0xe3, 0x72, 0x90, 0x0f, 0xa1, 0xc2, 0xcf,
+	0xf3, 0x18, 0xfa, 0x00, 0x0c, 0x65, 0x63, 0x84, 0x82, 0xe6, 0x4f, 0x48, 0x41, 0x0b, 0xc7, 0x54,
+	0x50, 0x72, 0x7f, 0xf8, 0x5a, 0xc4, 0xf8, 0x47, 0x0a, 0x70, 0x74, 0xfa, 0xcb, 0x9d, 0x42, 0x98,
+	0x24, 0xf7, 0x43, 0x77, 0xa0, 0x60, 0x52, 0xea, 0xbc, 0xcd, 0xff, 0xfa, 0x48, 0xe5, 0x60, 0x8c,
+	0x48, 0x1c, 0x45, 0xfc, 0x8e, 0x00, 0xf3, 0x51, 0x56, 0xa7, 0x08, 0x2e, 0xab, 0x50, 0x64, 0xa4,
+	0x1d, 0x1b, 0x5a, 0x1a, 0x6d, 0x43, 0x9e, 0x70, 0x24, 0x07, 0x51, 0xdc, 0x86, 0x39, 0x27, 0x06,
+	0x79, 0x02, 0xde, 0xc4, 0xb6, 0x3c, 0x22, 0xf9, 0xbb, 0x08, 0x15, 0x96, 0x5b, 0xb0, 0xa4, 0x8a,
+	0x95, 0x4d, 0xb0, 0xeb, 0x76, 0x1b, 0xc4, 0xff, 0x80, 0x33, 0xd4, 0x87, 0x87, 0xdb, 0xea, 0x69,
+	0xae, 0x5c, 0x44, 0xa8, 0xfa, 0x0a, 0x30, 0xb6, 0xb3, 0xb2, 0x14, 0x98, 0x13, 0x37, 0xe0, 0xb5,
+	0x10, 0xfd, 0x29, 0xc4, 0x48, 0xd2, 0xd2, 0xb9, 0xed, 0xe0, 0xf3, 0x84, 0xc9, 0x33, 0x91, 0x0b,
+	0x6e, 0x17, 0xbd, 0xa3, 0x2a, 0x61, 0xfb, 0x54, 0xd0, 0x07, 0x50, 0xd6, 0xf1, 0x61, 0xc7, 0x1f,
+	0x08, 0x53, 0x34, 0x4b, 0x4b, 0x3a, 0x3e, 0xa4, 0xbf, 0xc4, 0x47, 0x30, 0x1f, 0x61, 0x75, 0x9a,
+	0xbd, 0xff, 0x5a, 0x80, 0xb3, 0x6b, 0xa6, 0x31, 0xf8, 0x48, 0x35, 0xed, 0xa1, 0xdc, 0x0f, 0xde,
+	0x39, 0xbe, 0x9c, 0x92, 0xfa, 0xa1, 0x2f, 0x25, 0x62, 0x71, 0xed, 0x7a, 0x8c, 0xda, 0x46, 0x99,
+	0xe2, 0x9b, 0xf6, 0x25, 0x50, 0x7f, 0xca, 0xc2, 0xd9, 0x44, 0xb8, 0x31, 0xc1, 0x3a, 0x4d, 0xc6,
+	0x18, 0xdb, 0x81, 0xcb, 0x4e, 0xda, 0x81, 0x4b, 0xf0, 0x9c, 0xb9, 0x13, 0xf2, 0x9c, 0xc7, 0x2e,
+	0x09, 0x1f, 0x42, 0xb0, 0x3b, 0xda, 0x2c, 0xa4, 0x6e, 0x3a, 0x05, 0x11, 0xd1, 0x2a, 0x80, 0xd7,
+	0x29, 0x6c, 0x16, 0x53, 0x93, 0xf1, 0x61, 0x91, 0xd3, 0x72, 0xa3, 0x54, 0xb3, 0x14, 0x0a, 0x5b,
+	0xe2, 0x63, 0x68, 0xc5, 0x69, 0xe9, 0x34, 0x9a, 0xff, 0x65, 0x06, 0x80, 0x3d, 0x66, 0xdc, 0x91,
+	0xad, 0x83, 0xc9, 0x1c, 0xf0, 0xeb, 0x50, 0xf3, 0x14, 0xc6, 0xb3, 0x77, 0xbf, 0x16, 0x29, 0xc4,
+	0x24, 0xdc, 0x22, 0x83, 0xc0, 0x44, 0x0a, 0x0f, 0x85, 0xd2, 0xf1, 0x59, 0x0d, 0x53, 0x8a, 0x90,
+	0xd3, 0x23, 0xef, 0x27, 0xc9, 0x35, 0x09, 0x31, 0x33, 0xc5, 0x79, 0x8f, 0x69, 0x1a, 0x87, 0xc4,
+	0xf8, 0x14, 0x34, 0x0f, 0x45, 0x72, 0xcf, 0x4d, 0xe8, 0x17, 0x7c, 0xd7, 0xde, 0x0a, 0x79, 0xb4,
+	0xb8, 0xa7, 0xf6, 0x31, 0xbb, 0x65, 0x2d, 0x4b, 0x6c, 0x40, 0xee, 0x6b, 0xd8, 0xd3, 0xa0, 0x52,
+	0xea, 0xa7, 0x0d, 0x14, 0x9e, 0x94, 0xc5, 0x33, 0x9e, 0xd4, 0xa8, 0x03, 0x22, 0x3e, 0x8d, 0xfa,
+	0xb3, 0x7b, 0x86, 0xc2, 0x5c, 0x45, 0x3d, 0xe1, 0xba, 0x8b, 0x21, 0x52, 0x24, 0xc9, 0x43, 0x19,
+	0x55, 0x23, 0x91, 0x7d, 0x91, 0x4d, 0xab, 0x8a, 0x73, 0xdb, 0x56, 0x30, 0x8d, 0xc3, 0xb6, 0xe2,
+	0x4a, 0x83, 0x3d, 0xc8, 0x64, 0x15, 0x01, 0x91, 0xc6, 0x3d, 0x32, 0x26, 0xf2, 0xc4, 0xa6, 0x69,
+	0x98, 0x1d, 0x0d, 0x5b, 0x96, 0xdc, 0xc3, 0x3c, 0x69, 0xad, 0xd2, 0xc9, 0x4d, 0x36, 0x27, 0x7e,
+	0x95, 0x85, 0xba, 0xb7, 0x15, 0xe7, 0x8e, 0x4d, 0x55, 0x9c, 0x3b, 0x36, 0x55, 0x21, 0xce, 0xdc,
+	0x64, 0xae, 0xd0, 0xe7, 0xcc, 0xf9, 0x4c, 0x5b, 0x21, 0x71, 0x90, 0x18, 0x98, 0x6e, 0x28, 0xd8,
+	0x3b, 0x58, 0x70, 0xa6, 0xf8, 0xb9, 0x06, 0xf4, 0x23, 0x97, 0x42, 0x3f, 0xf2, 0x29, 0xf4, 0xa3,
+	0x10, 0xa3, 0x1f, 0x73, 0x50, 0xd8, 0x1d, 0x76, 0x0f, 0xb0, 0xcd, 0xd3, 0x4b, 0x3e, 0x0a, 0xea,
+	0x4d, 0x29, 0xa4, 0x37, 0xae, 0x7a, 0x94, 0xfd, 0xea, 0x71, 0x0e, 0xca, 0xec, 0xa2, 0xa7, 0x63,
+	0x5b, 0xb4, 0xe3, 0x9d, 0x95, 0x4a, 0x6c, 0x62, 0xc7, 0x42, 0xef, 0x3a, 0xf9, 0x53, 0x25, 0xce,
+	0xd0, 0xa9, 0xc7, 0x09, 0x69, 0x88, 0x93, 0x3d, 0x5d, 0x86, 0x3a, 0xf9, 0xdc, 0x79, 0x36, 0xc4,
+	0xe6, 0x91, 0xbc, 0xdb, 0xc7, 0xcd, 0x2a, 0x65, 0xa7, 0x46, 0x66, 0x1f, 0x3b, 0x93, 0x44, 0x20,
+	0x14, 0x4c, 0xd5, 0x15, 0xfc, 0x02, 0x2b, 0xcd, 0x1a, 0x05, 0xa2, 0xa2, 0x6e, 0xb3, 0x29, 0xf1,
+	0x13, 0x40, 0xde, 0x1a, 0xd3, 0x25, 0x51, 0xa1, 0x43, 0xcc, 0x84, 0x0f, 0x51, 0xfc, 0xb9, 0x00,
+	0xb3, 0xfe, 0xc5, 0x26, 0x0d, 0x8d, 0x1f, 0x40, 0x85, 0xdd, 0x0c, 0x74, 0x88, 0x69, 0xf2, 0x1a,
+	0xfd, 0xc2, 0x48, 0xe9, 0x49, 0xa0, 0xba, 0xbf, 0x89, 0x12, 0x1c, 0x1a, 0xe6, 0x81, 0xaa, 0xf7,
+	0x3a, 0x84, 0x33, 0xc7, 0x20, 0xaa, 0x7c, 0x92, 0x74, 0x5b, 0xe9, 0x9b, 0x86, 0x85, 0x27, 0x03,
+	0x45, 0xb6, 0xb1, 0x2f, 0x47, 0x98, 0xf6, 0x15, 0xd6, 0x3b, 0xce, 0x43, 0xa8, 0x4c, 0xba, 0xee,
+	0x36, 0x83, 0x16, 0x37, 0xc9, 0x83, 0x20, 0x0b, 0xeb, 0x4a, 0xe0, 0xe3, 0xc4, 0x95, 0xf9, 0x00,
+	0x5a, 0x71, 0xe4, 0xa6, 0x39, 0x7b, 0x96, 0xac, 0x75, 0x4c, 0x6c, 0xb1, 0xae, 0x49, 0x96, 0xe7,
+	0x08, 0x74, 0x1d, 0x5b, 0xfc, 0xb3, 0x00, 0xb3, 0x77, 0x15, 0x67, 0xbd, 0x97, 0x96, 0x13, 0x86,
+	0x73, 0xa6, 0x6c, 0x34, 0x67, 0x3a, 0x29, 0x47, 0xc2, 0xdd, 0x29, 0x69, 0xb9, 0xf2, 0x30, 0x61,
+	0xd2, 0x7b, 0x76, 0x71, 0xcf, 0xbd, 0x7c, 0x95, 0xf0, 0x1e, 0x36, 0xb1, 0xde, 0xc5, 0xe4, 0xf9,
+	0x96, 0xef, 0x35, 0x95, 0xe0, 0x7f, 0x4d, 0x35, 0xe9, 0xeb, 0xac, 0x6b, 0x3f, 0x10, 0x60, 0x36,
+	0xd2, 0xe5, 0x41, 0x75, 0x80, 0x27, 0x7a, 0x97, 0xb7, 0xbf, 0x1a, 0xa7, 0x50, 0x15, 0x4a, 0x4e,
+	0x33, 0xac, 0x21, 0xa0, 0x0a, 0x14, 0x77, 0x0c, 0x0a, 0xdd, 0xc8, 0xa0, 0x06, 0x54, 0x19, 0xe2,
+	0xb0, 0xdb, 0xc5, 0x96, 0xd5, 0xc8, 0xba, 0x33, 0x0f, 0x64, 0xb5, 0x3f, 0x34, 0x71, 0x23, 0x87,
+	0x6a, 0x50, 0xde, 0x31, 0xf8, 0x5b, 0xb4, 0x46, 0x1e, 0x21, 0xa8, 0xf3, 0x81, 0x83, 0x54, 0xf0,
+	0xcd, 0x39, 0x68, 0xc5, 0x6b, 0x7b, 0x50, 0x0f, 0x16, 0xf6, 0x68, 0x1e, 0x4e, 0x3f, 0xd1, 0x15,
+	0xbc, 0xa7, 0xea, 0x58, 0xf1, 0x3e, 0x35, 0x4e, 0xa1, 0xd3, 0x30, 0xd3, 0xd6, 0x75, 0x6c, 0xfa,
+	0x26, 0x05, 0x32, 0xb9, 0x89, 0xcd, 0x1e, 0xf6, 0x4d, 0x66, 0xd0, 0x2c, 0xd4, 0x36, 0xd5, 0x17,
+	0xbe, 0xa9, 0xec, 0xca, 0x7f, 0xce, 0x43, 0x99, 0xdc, 0x8f, 0xdc, 0x33, 0x0c, 0x53, 0x41, 0x03,
+	0x40, 0xf4, 0x1d, 0xa7, 0x36, 0x30, 0x74, 0xf7, 0x75, 0x34, 0xba, 0x95, 0x90, 0x3e, 0x45, 0x41,
+	0xb9, 0x5a, 0xb6, 0xae, 0x24, 0x60, 0x84, 0xc0, 0xc5, 0x53, 0x48, 0xa3, 0x2b, 0x92, 0xc6, 0xc8,
+	0x8e, 0xda, 0x3d, 0x70, 0x9e, 0x78, 0x8c, 0x58, 0x31, 0x04, 0xea, 0xac, 0x18, 0x2a, 0x7e, 0xf9,
+	0x80, 0x3d, 0xb6, 0x75, 0xec, 0x52, 0x3c, 0x85, 0x9e, 0xc1, 0x99, 0x75, 0xec, 0xf3, 0x43, 0xce,
+	0x82, 0x2b, 0xc9, 0x0b, 0x46, 0x80, 0x8f, 0xb9, 0xe4, 0x06, 0xe4, 0x69, 0x47, 0x15, 0xc5, 0xb9,
+	0x2a, 0xff, 0x1f, 0x94, 0x5a, 0x8b, 0xc9, 0x00, 0x2e, 0xb5, 0x4f, 0x60, 0x26, 0xf4, 0x27, 0x0a,
+	0x74, 0x35, 0x06, 0x2d, 0xfe, 0xef, 0x30, 0xad, 0x6b, 0x69, 0x40, 0xdd, 0xb5, 0x7a, 0x50, 0x0f,
+	0xbe, 0x02, 0x45, 0x71, 0x35, 0x7d, 0xec, 0xeb, 0xf5, 0xd6, 0xd5, 0x14, 0x90, 0xee, 0x42, 0x1a,
+	0x34, 0xc2, 0x4f, 0xf2, 0xd1, 0xb5, 0x91, 0x04, 0x82, 0xea, 0xf6, 0x56, 0x2a, 0x58, 0x77, 0xb9,
+	0x23, 0x38, 0x13, 0xf7, 0xca, 0x1b, 0x2d, 0xc7, 0x93, 0x49, 0x7a, 0x7e, 0xde, 0xba, 0x99, 0x1a,
+	0xde, 0x5d, 0xfa, 0xbf, 0xd8, 0x4d, 0x4e, 0xdc, 0x4b, 0x69, 0xf4, 0x76, 0x3c, 0xb9, 0x11, 0x4f,
+	0xbc, 0x5b, 0x2b, 0xc7, 0x41, 0x71, 0x99, 0xf8, 0x0c, 0xe6, 0xe2, 0x5f, 0x1b, 0xa3, 0x5b, 0xf1,
+	0xf4, 0x92, 0x9f, 0x51, 0xb7, 0xde, 0x3e, 0x06, 0x86, 0xcb, 0x80, 0x11, 0xfe, 0xcf, 0x83, 0x63,
+	0x86, 0x37, 0xc7, 0x6a, 0xcd, 0x64, 0x36, 0xf8, 0x31, 0xcc, 0x84, 0x1e, 0xd3, 0xc4, 0x5a, 0x4d,
+	0xfc, 0x83, 0x9b, 0xd6, 0xa8, 0xf0, 0xcd, 0x4c, 0x32, 0x74, 0xa3, 0x85, 0x12, 0xb4, 0x3f, 0xe6,
+	0xd6, 0xab, 0x75, 0x2d, 0x0d, 0xa8, 0xbb, 0x11, 0x8b, 0xba, 0xcb, 0xd0, 0xad, 0x10, 0xba, 0x1e,
+	0x4f, 0x23, 0xfe, 0x46, 0xab, 0x75, 0x23, 0x25, 0xb4, 0xbb, 0x68, 0x07, 0x60, 0x1d, 0xdb, 0x9b,
+	0xd8, 0x36, 0x89, 0x8e, 0x5c, 0x89, 0x15, 0xb9, 0x07, 0xe0, 0x2c, 0xf3, 0xe6, 0x58, 0x38, 0x9f,
+	0x57, 0x6e, 0x6c, 0xca, 0x3a, 0x29, 0xa6, 0xbd, 0x87, 0x5c, 0xd7, 0x63, 0xd1, 0xc3, 0x60, 0x09,
+	0x7b, 0x4a, 0x84, 0x76, 0x97, 0x3c, 0x74, 0x23, 0x9d, 0xaf, 0x1f, 0x89, 0x96, 0x63, 0xc9, 0x44,
+	0x01, 0x13, 0x3c, 0xc0, 0x08, 0x78, 0x77, 0xe1, 0xcf, 0x05, 0x38, 0x17, 0x05, 0x78, 0xaa, 0xda,
+	0xfb, 0xe4, 0xa6, 0xc2, 0x4a, 0xc3, 0x02, 0x05, 0x3c, 0x06, 0x0b, 0x1c, 0xde, 0x65, 0x41, 0x81,
+	0x5a, 0xa0, 0x63, 0x89, 0xe2, 0x5e, 0x4d, 0xc5, 0xf5, 0x4c, 0x5b, 0x4b, 0xe3, 0x01, 0xdd, 0x55,
+	0xf6, 0xa1, 0xe6, 0x68, 0x15, 0x13, 0xee, 0xd5, 0x24, 0x4e, 0x3d, 0x98, 0x04, 0xa3, 0x88, 0x07,
+	0xf5, 0x1b, 0x45, 0xb4, 0x21, 0x83, 0xd2, 0x35, 0xf2, 0x46, 0x19, 0x45, 0x72, 0x97, 0x87, 0x59,
+	0x7d, 0xa8, 0xf9, 0x19, 0xef, 0x52, 0x62, 0x7b, 0xb9, 0xad, 0x6b, 0x69, 0x40, 0xdd, 0xb5, 0x9e,
+	0x42, 0x81, 0xd5, 0x61, 0xe8, 0x8d, 0xd1, 0x25, 0x1a, 0xa7, 0x7e, 0x79, 0x0c, 0x94, 0x4b, 0xf8,
+	0x00, 0xe6, 0x13, 0x0a, 0xb4, 0xd8, 0x68, 0x34, 0xba, 0x98, 0x1b, 0xe7, 0x27, 0x65, 0x40, 0xd1,
+	0xff, 0x8c, 0xc4, 0x1e, 0x53, 0xe2, 0x5f, 0x4b, 0x52, 0x2c, 0x11, 0xfd, 0xdb, 0x47, 0xec, 0x12,
+	0x89, 0xff, 0x0e, 0x19, 0xb7, 0xc4, 0x63, 0x00, 0xaf, 0x0c, 0x8b, 0x3d, 0x8f, 0x48, 0x95, 0x36,
+	0x86, 0xe4, 0xca, 0x1f, 0x8b, 0x50, 0x72, 0xde, 0x28, 0xbd, 0x82, 0x14, 0xfc, 0x15, 0xe4, 0xc4,
+	0x1f, 0xc3, 0x4c, 0xe8, 0xcf, 0x0e, 0xb1, 0xc6, 0x13, 0xff, 0x87, 0x88, 0x71, 0x27, 0xf4, 0x94,
+	0xff, 0xb9, 0xde, 0x0d, 0x8f, 0x6f, 0x26, 0xe5, 0xd5, 0xe1, 0xc8, 0x38, 0x86, 0xf0, 0x4b, 0x8f,
+	0x83, 0x8f, 0x00, 0x7c, 0x11, 0x70, 0xf4, 0x65, 0x2f, 0x71, 0xea, 0xe3, 0x18, 0xd6, 0x62, 0x83,
+	0xdc, 0xd5, 0x34, 0x17, 0x73, 0xc9, 0x6e, 0x2a, 0x39, 0xb4, 0x6d, 0x1e, 0xd3, 0x4d, 0x8d, 0xe1,
+	0xde, 0x02, 0x14, 0xed, 0xb1, 0x24, 0x18, 0x73, 0x42, 0x67, 0xa7, 0x75, 0x23, 0x25, 0xb4, 0xbb,
+	0x87, 0x93, 0x37, 0xef, 0xd5, 0xdb, 0xff, 0xf6, 0x76, 0x4f, 0xb5, 0xf7, 0x87, 0xbb, 0xe4, 0xcb,
+	0x4d, 0x06, 0x7a, 0x43, 0x35, 0xf8, 0xaf, 0x9b, 0x8e, 0x5d, 0xdd, 0xa4, 0xd8, 0x37, 0xc9, 0x1a,
+	0x83, 0xdd, 0xdd, 0x02, 0x1d, 0xdd, 0xfe, 0xdb, 0x00, 0x8a, 0x64, 0x6e, 0x19, 0x2e, 0x43, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
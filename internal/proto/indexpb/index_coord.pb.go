@@ -288,17 +288,21 @@ func (m *GetIndexStatesResponse) GetStates() []*IndexInfo {
 }
 
 type CreateIndexRequest struct {
-	IndexBuildID         int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
-	IndexName            string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	Version              int64                    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	MetaPath             string                   `protobuf:"bytes,5,opt,name=meta_path,json=metaPath,proto3" json:"meta_path,omitempty"`
-	DataPaths            []string                 `protobuf:"bytes,6,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
-	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	IndexBuildID int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	IndexName    string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID      int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	Version      int64                    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	MetaPath     string                   `protobuf:"bytes,5,opt,name=meta_path,json=metaPath,proto3" json:"meta_path,omitempty"`
+	DataPaths    []string                 `protobuf:"bytes,6,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	TypeParams   []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams  []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	// the trace context of the index task, so the IndexNode joins the trace of the BuildIndex request.
+	TraceContext map[string]string `protobuf:"bytes,9,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the checkpoint which the IndexNode resumes the build from, empty to build from scratch.
+	CheckpointPath       string   `protobuf:"bytes,10,opt,name=checkpoint_path,json=checkpointPath,proto3" json:"checkpoint_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
@@ -382,26 +386,89 @@ func (m *CreateIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CreateIndexRequest) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
+func (m *CreateIndexRequest) GetCheckpointPath() string {
+	if m != nil {
+		return m.CheckpointPath
+	}
+	return ""
+}
+
+type CancelIndexRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelIndexRequest) Reset()         { *m = CancelIndexRequest{} }
+func (m *CancelIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexRequest) ProtoMessage()    {}
+func (*CancelIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{6}
+}
+
+func (m *CancelIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexRequest.Unmarshal(m, b)
+}
+func (m *CancelIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexRequest.Merge(m, src)
+}
+func (m *CancelIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexRequest.Size(m)
+}
+func (m *CancelIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexRequest proto.InternalMessageInfo
+
+func (m *CancelIndexRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
 type BuildIndexRequest struct {
-	IndexBuildID         int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
-	IndexName            string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	DataPaths            []string                 `protobuf:"bytes,5,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
-	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	NumRows              int64                    `protobuf:"varint,8,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	FieldSchema          *schemapb.FieldSchema    `protobuf:"bytes,9,opt,name=field_schema,json=fieldSchema,proto3" json:"field_schema,omitempty"`
-	SegmentID            int64                    `protobuf:"varint,10,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	IndexBuildID int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	IndexName    string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID      int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	DataPaths    []string                 `protobuf:"bytes,5,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	TypeParams   []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams  []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	NumRows      int64                    `protobuf:"varint,8,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	FieldSchema  *schemapb.FieldSchema    `protobuf:"bytes,9,opt,name=field_schema,json=fieldSchema,proto3" json:"field_schema,omitempty"`
+	SegmentID    int64                    `protobuf:"varint,10,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// the fields below schedule the build, they are set by RootCoord and are not params to build the index.
+	CollectionID  int64  `protobuf:"varint,11,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentOrigin string `protobuf:"bytes,12,opt,name=segment_origin,json=segmentOrigin,proto3" json:"segment_origin,omitempty"`
+	ResourceGroup string `protobuf:"bytes,13,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	BuildPriority int64  `protobuf:"varint,14,opt,name=build_priority,json=buildPriority,proto3" json:"build_priority,omitempty"`
+	BuildDeadline int64  `protobuf:"varint,15,opt,name=build_deadline,json=buildDeadline,proto3" json:"build_deadline,omitempty"`
+	ShadowBuild   bool   `protobuf:"varint,16,opt,name=shadow_build,json=shadowBuild,proto3" json:"shadow_build,omitempty"`
+	PinnedNodeID  int64  `protobuf:"varint,17,opt,name=pinned_nodeID,json=pinnedNodeID,proto3" json:"pinned_nodeID,omitempty"`
+	// the trace context of the BuildIndex request, it is set by IndexCoord and recorded in meta with the request.
+	TraceContext         map[string]string `protobuf:"bytes,18,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *BuildIndexRequest) Reset()         { *m = BuildIndexRequest{} }
 func (m *BuildIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*BuildIndexRequest) ProtoMessage()    {}
 func (*BuildIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{6}
+	return fileDescriptor_f9e019eb3fda53c2, []int{7}
 }
 
 func (m *BuildIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -485,6 +552,62 @@ func (m *BuildIndexRequest) GetSegmentID() int64 {
 	return 0
 }
 
+func (m *BuildIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetSegmentOrigin() string {
+	if m != nil {
+		return m.SegmentOrigin
+	}
+	return ""
+}
+
+func (m *BuildIndexRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *BuildIndexRequest) GetBuildPriority() int64 {
+	if m != nil {
+		return m.BuildPriority
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetBuildDeadline() int64 {
+	if m != nil {
+		return m.BuildDeadline
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetShadowBuild() bool {
+	if m != nil {
+		return m.ShadowBuild
+	}
+	return false
+}
+
+func (m *BuildIndexRequest) GetPinnedNodeID() int64 {
+	if m != nil {
+		return m.PinnedNodeID
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
 type BuildIndexResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexBuildID         int64            `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
@@ -497,7 +620,7 @@ func (m *BuildIndexResponse) Reset()         { *m = BuildIndexResponse{} }
 func (m *BuildIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*BuildIndexResponse) ProtoMessage()    {}
 func (*BuildIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{7}
+	return fileDescriptor_f9e019eb3fda53c2, []int{8}
 }
 
 func (m *BuildIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -543,7 +666,7 @@ func (m *GetIndexFilePathsRequest) Reset()         { *m = GetIndexFilePathsReque
 func (m *GetIndexFilePathsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexFilePathsRequest) ProtoMessage()    {}
 func (*GetIndexFilePathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{8}
+	return fileDescriptor_f9e019eb3fda53c2, []int{9}
 }
 
 func (m *GetIndexFilePathsRequest) XXX_Unmarshal(b []byte) error {
@@ -585,7 +708,7 @@ func (m *IndexFilePathInfo) Reset()         { *m = IndexFilePathInfo{} }
 func (m *IndexFilePathInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexFilePathInfo) ProtoMessage()    {}
 func (*IndexFilePathInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{9}
+	return fileDescriptor_f9e019eb3fda53c2, []int{10}
 }
 
 func (m *IndexFilePathInfo) XXX_Unmarshal(b []byte) error {
@@ -646,7 +769,7 @@ func (m *GetIndexFilePathsResponse) Reset()         { *m = GetIndexFilePathsResp
 func (m *GetIndexFilePathsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexFilePathsResponse) ProtoMessage()    {}
 func (*GetIndexFilePathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{10}
+	return fileDescriptor_f9e019eb3fda53c2, []int{11}
 }
 
 func (m *GetIndexFilePathsResponse) XXX_Unmarshal(b []byte) error {
@@ -682,26 +805,31 @@ func (m *GetIndexFilePathsResponse) GetFilePaths() []*IndexFilePathInfo {
 }
 
 type IndexMeta struct {
-	IndexBuildID         int64               `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	Req                  *BuildIndexRequest  `protobuf:"bytes,4,opt,name=req,proto3" json:"req,omitempty"`
-	IndexFilePaths       []string            `protobuf:"bytes,5,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
-	MarkDeleted          bool                `protobuf:"varint,6,opt,name=mark_deleted,json=markDeleted,proto3" json:"mark_deleted,omitempty"`
-	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	IndexVersion         int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	Recycled             bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
-	SerializeSize        uint64              `protobuf:"varint,10,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	IndexBuildID   int64               `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	State          commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason     string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	Req            *BuildIndexRequest  `protobuf:"bytes,4,opt,name=req,proto3" json:"req,omitempty"`
+	IndexFilePaths []string            `protobuf:"bytes,5,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	MarkDeleted    bool                `protobuf:"varint,6,opt,name=mark_deleted,json=markDeleted,proto3" json:"mark_deleted,omitempty"`
+	NodeID         int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	IndexVersion   int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	Recycled       bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	SerializeSize  uint64              `protobuf:"varint,10,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
+	// the fraction of the rows built into the index by the IndexNode, 0 if it is not reported, such as by the IndexNodes
+	// of older versions.
+	Progress float32 `protobuf:"fixed32,11,opt,name=progress,proto3" json:"progress,omitempty"`
+	// the last checkpoint of the build reported by the IndexNode, the build is resumed from it when the task is reassigned.
+	CheckpointPath       string   `protobuf:"bytes,12,opt,name=checkpoint_path,json=checkpointPath,proto3" json:"checkpoint_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexMeta) Reset()         { *m = IndexMeta{} }
 func (m *IndexMeta) String() string { return proto.CompactTextString(m) }
 func (*IndexMeta) ProtoMessage()    {}
 func (*IndexMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{11}
+	return fileDescriptor_f9e019eb3fda53c2, []int{12}
 }
 
 func (m *IndexMeta) XXX_Unmarshal(b []byte) error {
@@ -792,6 +920,20 @@ func (m *IndexMeta) GetSerializeSize() uint64 {
 	return 0
 }
 
+func (m *IndexMeta) GetProgress() float32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetCheckpointPath() string {
+	if m != nil {
+		return m.CheckpointPath
+	}
+	return ""
+}
+
 type DropIndexRequest struct {
 	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -803,7 +945,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{12}
+	return fileDescriptor_f9e019eb3fda53c2, []int{13}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -842,7 +984,7 @@ func (m *RemoveIndexRequest) Reset()         { *m = RemoveIndexRequest{} }
 func (m *RemoveIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveIndexRequest) ProtoMessage()    {}
 func (*RemoveIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{13}
+	return fileDescriptor_f9e019eb3fda53c2, []int{14}
 }
 
 func (m *RemoveIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -880,7 +1022,7 @@ func (m *GetTaskSlotsRequest) Reset()         { *m = GetTaskSlotsRequest{} }
 func (m *GetTaskSlotsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskSlotsRequest) ProtoMessage()    {}
 func (*GetTaskSlotsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{14}
+	return fileDescriptor_f9e019eb3fda53c2, []int{15}
 }
 
 func (m *GetTaskSlotsRequest) XXX_Unmarshal(b []byte) error {
@@ -902,18 +1044,20 @@ func (m *GetTaskSlotsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetTaskSlotsRequest proto.InternalMessageInfo
 
 type GetTaskSlotsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Slots                int64            `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Slots  int64            `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	// the total task slots of the IndexNode, zero if it is not reported by the IndexNode of older versions.
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetTaskSlotsResponse) Reset()         { *m = GetTaskSlotsResponse{} }
 func (m *GetTaskSlotsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskSlotsResponse) ProtoMessage()    {}
 func (*GetTaskSlotsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{15}
+	return fileDescriptor_f9e019eb3fda53c2, []int{16}
 }
 
 func (m *GetTaskSlotsResponse) XXX_Unmarshal(b []byte) error {
@@ -948,216 +1092,1310 @@ func (m *GetTaskSlotsResponse) GetSlots() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
-	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
-	proto.RegisterType((*GetIndexStatesRequest)(nil), "milvus.proto.index.GetIndexStatesRequest")
-	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
-	proto.RegisterType((*GetIndexStatesResponse)(nil), "milvus.proto.index.GetIndexStatesResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.index.CreateIndexRequest")
-	proto.RegisterType((*BuildIndexRequest)(nil), "milvus.proto.index.BuildIndexRequest")
-	proto.RegisterType((*BuildIndexResponse)(nil), "milvus.proto.index.BuildIndexResponse")
-	proto.RegisterType((*GetIndexFilePathsRequest)(nil), "milvus.proto.index.GetIndexFilePathsRequest")
-	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
-	proto.RegisterType((*GetIndexFilePathsResponse)(nil), "milvus.proto.index.GetIndexFilePathsResponse")
-	proto.RegisterType((*IndexMeta)(nil), "milvus.proto.index.IndexMeta")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
-	proto.RegisterType((*RemoveIndexRequest)(nil), "milvus.proto.index.RemoveIndexRequest")
-	proto.RegisterType((*GetTaskSlotsRequest)(nil), "milvus.proto.index.GetTaskSlotsRequest")
-	proto.RegisterType((*GetTaskSlotsResponse)(nil), "milvus.proto.index.GetTaskSlotsResponse")
+func (m *GetTaskSlotsResponse) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
-
-var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x4d, 0x5b, 0x3f, 0x23, 0xc5, 0x8d, 0x37, 0x3f, 0x60, 0x94, 0x04, 0x51, 0x98, 0xa4,
-	0x51, 0x8b, 0x44, 0x0e, 0x94, 0xa6, 0x3d, 0x15, 0x68, 0x6d, 0x21, 0x86, 0x50, 0x24, 0x30, 0xd6,
-	0x46, 0x0e, 0x05, 0x0a, 0x61, 0x2d, 0x8e, 0xec, 0x85, 0xf9, 0x23, 0x73, 0x57, 0x4e, 0xed, 0x73,
-	0xaf, 0x45, 0x6f, 0xcd, 0x23, 0xf4, 0x11, 0x7a, 0xec, 0x33, 0xf4, 0x79, 0x7a, 0x29, 0xb8, 0x5c,
-	0x52, 0xa4, 0x44, 0xd9, 0x72, 0x5d, 0xf7, 0xd4, 0x1b, 0x67, 0xf6, 0x9b, 0x9f, 0xfd, 0x76, 0x66,
-	0x76, 0x09, 0x6b, 0xdc, 0x77, 0xf0, 0xc7, 0xfe, 0x20, 0x08, 0x42, 0xa7, 0x3d, 0x0a, 0x03, 0x19,
-	0x10, 0xe2, 0x71, 0xf7, 0x78, 0x2c, 0x62, 0xa9, 0xad, 0xd6, 0x1b, 0xf5, 0x41, 0xe0, 0x79, 0x81,
-	0x1f, 0xeb, 0x1a, 0xab, 0xdc, 0x97, 0x18, 0xfa, 0xcc, 0xd5, 0x72, 0x3d, 0x6b, 0xd1, 0xa8, 0x8b,
-	0xc1, 0x01, 0x7a, 0x2c, 0x96, 0xec, 0x8f, 0x06, 0xdc, 0xa4, 0xb8, 0xcf, 0x85, 0xc4, 0xf0, 0x5d,
-	0xe0, 0x20, 0xc5, 0xa3, 0x31, 0x0a, 0x49, 0x5e, 0xc2, 0xf2, 0x1e, 0x13, 0x68, 0x19, 0x4d, 0xa3,
-	0x55, 0xeb, 0xdc, 0x6f, 0xe7, 0x82, 0xea, 0x68, 0x6f, 0xc5, 0xfe, 0x06, 0x13, 0x48, 0x15, 0x92,
-	0x7c, 0x09, 0x65, 0xe6, 0x38, 0x21, 0x0a, 0x61, 0x2d, 0x9d, 0x61, 0xf4, 0x6d, 0x8c, 0xa1, 0x09,
-	0x98, 0xdc, 0x81, 0x92, 0x1f, 0x38, 0xd8, 0xeb, 0x5a, 0x66, 0xd3, 0x68, 0x99, 0x54, 0x4b, 0xf6,
-	0x2f, 0x06, 0xdc, 0xca, 0x67, 0x26, 0x46, 0x81, 0x2f, 0x90, 0xbc, 0x82, 0x92, 0x90, 0x4c, 0x8e,
-	0x85, 0x4e, 0xee, 0x5e, 0x61, 0x9c, 0x1d, 0x05, 0xa1, 0x1a, 0x4a, 0x36, 0xa0, 0xc6, 0x7d, 0x2e,
-	0xfb, 0x23, 0x16, 0x32, 0x2f, 0xc9, 0xf0, 0x51, 0x7b, 0x8a, 0x4b, 0x4d, 0x5b, 0xcf, 0xe7, 0x72,
-	0x5b, 0x01, 0x29, 0xf0, 0xf4, 0xdb, 0xfe, 0x1a, 0x6e, 0x6f, 0xa1, 0xec, 0x45, 0x8c, 0x47, 0xde,
-	0x51, 0x24, 0x64, 0x3d, 0x81, 0xeb, 0xea, 0x1c, 0x36, 0xc6, 0xdc, 0x75, 0x7a, 0xdd, 0x28, 0x31,
-	0xb3, 0x65, 0xd2, 0xbc, 0xd2, 0xfe, 0xdd, 0x80, 0xaa, 0x32, 0xee, 0xf9, 0xc3, 0x80, 0xbc, 0x86,
-	0x95, 0x28, 0xb5, 0x98, 0xe1, 0xd5, 0xce, 0xc3, 0xc2, 0x4d, 0x4c, 0x62, 0xd1, 0x18, 0x4d, 0x6c,
-	0xa8, 0x67, 0xbd, 0xaa, 0x8d, 0x98, 0x34, 0xa7, 0x23, 0x16, 0x94, 0x95, 0x9c, 0x52, 0x9a, 0x88,
-	0xe4, 0x01, 0x40, 0x5c, 0x50, 0x3e, 0xf3, 0xd0, 0x5a, 0x6e, 0x1a, 0xad, 0x2a, 0xad, 0x2a, 0xcd,
-	0x3b, 0xe6, 0x61, 0x74, 0x14, 0x21, 0x32, 0x11, 0xf8, 0xd6, 0x8a, 0x5a, 0xd2, 0x92, 0xfd, 0x93,
-	0x01, 0x77, 0xa6, 0x77, 0x7e, 0x99, 0xc3, 0x78, 0x1d, 0x1b, 0x61, 0x74, 0x0e, 0x66, 0xab, 0xd6,
-	0x79, 0xd0, 0x9e, 0xad, 0xe9, 0x76, 0x4a, 0x15, 0xd5, 0x60, 0xfb, 0xcf, 0x25, 0x20, 0x9b, 0x21,
-	0x32, 0x89, 0x6a, 0x2d, 0x61, 0x7f, 0x9a, 0x12, 0xa3, 0x80, 0x92, 0xfc, 0xc6, 0x97, 0xa6, 0x37,
-	0x3e, 0x9f, 0x31, 0x0b, 0xca, 0xc7, 0x18, 0x0a, 0x1e, 0xf8, 0x8a, 0x2e, 0x93, 0x26, 0x22, 0xb9,
-	0x07, 0x55, 0x0f, 0x25, 0xeb, 0x8f, 0x98, 0x3c, 0xd0, 0x7c, 0x55, 0x22, 0xc5, 0x36, 0x93, 0x07,
-	0x51, 0x3c, 0x87, 0xe9, 0x45, 0x61, 0x95, 0x9a, 0x66, 0x14, 0x2f, 0xd2, 0x44, 0xab, 0xaa, 0x1a,
-	0xe5, 0xc9, 0x08, 0x93, 0x6a, 0x2c, 0x2b, 0x16, 0x1e, 0x15, 0x52, 0xf7, 0x1d, 0x9e, 0xbc, 0x67,
-	0xee, 0x18, 0xb7, 0x19, 0x0f, 0x29, 0x44, 0x56, 0x71, 0x35, 0x92, 0xae, 0xde, 0x76, 0xe2, 0xa4,
-	0xb2, 0xa8, 0x93, 0x9a, 0x32, 0xd3, 0x35, 0xfd, 0xd1, 0x84, 0xb5, 0x98, 0xa4, 0xff, 0x8c, 0xd2,
-	0x3c, 0x37, 0x2b, 0xe7, 0x70, 0x53, 0xfa, 0x37, 0xb8, 0x29, 0xff, 0x13, 0x6e, 0xc8, 0x5d, 0xa8,
-	0xf8, 0x63, 0xaf, 0x1f, 0x06, 0x1f, 0x22, 0x76, 0xd5, 0x1e, 0xfc, 0xb1, 0x47, 0x83, 0x0f, 0x82,
-	0x6c, 0x42, 0x7d, 0xc8, 0xd1, 0x75, 0xfa, 0xf1, 0x30, 0xb5, 0xaa, 0xaa, 0xf8, 0x9b, 0xf9, 0x00,
-	0x7a, 0xd0, 0xbe, 0x89, 0x80, 0x3b, 0xea, 0x9b, 0xd6, 0x86, 0x13, 0x81, 0xdc, 0x87, 0xaa, 0xc0,
-	0x7d, 0x0f, 0x7d, 0xd9, 0xeb, 0x5a, 0xa0, 0x02, 0x4c, 0x14, 0xb6, 0x07, 0x24, 0x7b, 0x30, 0x97,
-	0xe9, 0xb7, 0x05, 0x86, 0x86, 0xfd, 0x0d, 0x58, 0x49, 0x8b, 0xbf, 0xe1, 0x2e, 0xaa, 0xb3, 0xb8,
-	0xd8, 0x7c, 0xfb, 0xc3, 0x80, 0xb5, 0x9c, 0xbd, 0x9a, 0x73, 0x57, 0x95, 0x30, 0x69, 0xc1, 0x8d,
-	0xf8, 0x8c, 0x87, 0xdc, 0x45, 0x5d, 0x4c, 0xa6, 0x2a, 0xa6, 0x55, 0x9e, 0xdb, 0x05, 0x79, 0x06,
-	0x9f, 0x08, 0x0c, 0x39, 0x73, 0xf9, 0x29, 0x3a, 0x7d, 0xc1, 0x4f, 0xe3, 0xd1, 0xb7, 0x4c, 0x57,
-	0x27, 0xea, 0x1d, 0x7e, 0x8a, 0xf6, 0xaf, 0x06, 0xdc, 0x2d, 0x20, 0xe1, 0x32, 0xd4, 0x77, 0x01,
-	0x32, 0xf9, 0xc5, 0xe3, 0xee, 0xe9, 0xdc, 0x71, 0x97, 0x65, 0x8e, 0x56, 0x87, 0x49, 0x0a, 0xf6,
-	0xcf, 0xa6, 0xbe, 0x3a, 0xde, 0xa2, 0x64, 0x0b, 0x75, 0x67, 0x7a, 0xbd, 0x2c, 0x5d, 0xe8, 0x7a,
-	0x79, 0x08, 0xb5, 0x21, 0xe3, 0x6e, 0x5f, 0x5f, 0x03, 0xa6, 0xea, 0x6a, 0x88, 0x54, 0x54, 0x69,
-	0xc8, 0x57, 0x60, 0x86, 0x78, 0xa4, 0xf8, 0x9b, 0xb3, 0x91, 0x99, 0x69, 0x42, 0x23, 0x8b, 0xc2,
-	0xe3, 0x5a, 0x29, 0x3c, 0xae, 0x47, 0x50, 0xf7, 0x58, 0x78, 0xd8, 0x77, 0xd0, 0x45, 0x89, 0x8e,
-	0x55, 0x6a, 0x1a, 0xad, 0x0a, 0xad, 0x45, 0xba, 0x6e, 0xac, 0xca, 0xbc, 0x19, 0xca, 0xd9, 0x37,
-	0x03, 0x79, 0xac, 0x0b, 0xb5, 0x9f, 0xcc, 0xec, 0x4a, 0x86, 0x9a, 0xf7, 0x7a, 0x70, 0x37, 0xa0,
-	0x12, 0xe2, 0xe0, 0x64, 0xe0, 0xa2, 0xa3, 0xfa, 0xb6, 0x42, 0x53, 0x99, 0x3c, 0x85, 0x49, 0x4d,
-	0xc4, 0x95, 0x02, 0xaa, 0x52, 0xae, 0xa7, 0x5a, 0x55, 0x28, 0xcf, 0xe1, 0x46, 0x37, 0x0c, 0x46,
-	0xb9, 0x99, 0x99, 0x19, 0x78, 0x46, 0x6e, 0xe0, 0xd9, 0x2f, 0x81, 0x50, 0xf4, 0x82, 0xe3, 0xfc,
-	0xb5, 0xd5, 0x80, 0xca, 0x5e, 0xbe, 0x9f, 0x52, 0xd9, 0xbe, 0x0d, 0x37, 0xb7, 0x50, 0xee, 0x32,
-	0x71, 0xb8, 0xe3, 0x06, 0x32, 0xe9, 0x43, 0x9b, 0xc1, 0xad, 0xbc, 0xfa, 0x32, 0x95, 0x79, 0x0b,
-	0x56, 0x44, 0xe4, 0x45, 0x37, 0x57, 0x2c, 0x74, 0x7e, 0x2b, 0x03, 0xa8, 0x34, 0x37, 0xa3, 0x27,
-	0x27, 0x19, 0x01, 0xd9, 0x42, 0xb9, 0x19, 0x78, 0xa3, 0xc0, 0x47, 0x5f, 0xc6, 0x97, 0x3f, 0x79,
-	0x39, 0xe7, 0xdd, 0x34, 0x0b, 0xd5, 0x99, 0x37, 0x3e, 0x9d, 0x63, 0x31, 0x05, 0xb7, 0xaf, 0x11,
-	0x4f, 0x45, 0xdc, 0xe5, 0x1e, 0xee, 0xf2, 0xc1, 0xe1, 0xe6, 0x01, 0xf3, 0x7d, 0x74, 0xcf, 0x8a,
-	0x38, 0x05, 0x4d, 0x22, 0x3e, 0xce, 0x5b, 0x68, 0x61, 0x47, 0x86, 0xdc, 0xdf, 0x4f, 0x88, 0xb3,
-	0xaf, 0x91, 0x23, 0x45, 0x69, 0x14, 0x9d, 0x0b, 0xc9, 0x07, 0x22, 0x09, 0xd8, 0x99, 0x1f, 0x70,
-	0x06, 0x7c, 0xc1, 0x90, 0x3f, 0x00, 0x4c, 0x7a, 0x84, 0x2c, 0xd6, 0x43, 0xb3, 0x04, 0x4e, 0xc3,
-	0x52, 0xf7, 0x1c, 0x56, 0xf3, 0x6f, 0x35, 0xf2, 0x59, 0x91, 0x6d, 0xe1, 0x4b, 0xb6, 0xf1, 0xf9,
-	0x22, 0xd0, 0x34, 0x54, 0x08, 0x6b, 0x33, 0xe3, 0x92, 0x3c, 0x3f, 0xcb, 0xc5, 0xf4, 0xd5, 0xd2,
-	0x78, 0xb1, 0x20, 0x3a, 0x8d, 0xb9, 0x0d, 0xd5, 0xb4, 0xf5, 0xc8, 0x93, 0x22, 0xeb, 0xe9, 0xce,
-	0x6c, 0x9c, 0xd5, 0x0e, 0xf6, 0x35, 0xb2, 0x0b, 0xb5, 0x4c, 0x7b, 0x92, 0x42, 0xa6, 0x67, 0xfb,
-	0xf7, 0x3c, 0xaf, 0x7d, 0x80, 0x2d, 0x94, 0x6f, 0x51, 0x86, 0x7c, 0x20, 0xa6, 0x9d, 0x6a, 0x61,
-	0x02, 0x48, 0x9c, 0x3e, 0x3b, 0x17, 0x97, 0x10, 0xd1, 0xf9, 0x6b, 0x59, 0xdf, 0x09, 0xd1, 0xcf,
-	0xd1, 0xff, 0x8d, 0x7a, 0x05, 0x8d, 0xba, 0x0b, 0xb5, 0xcc, 0xef, 0x46, 0x71, 0x61, 0xcc, 0xfe,
-	0x8f, 0x9c, 0x57, 0x18, 0x03, 0xa8, 0x67, 0x87, 0x38, 0x79, 0x36, 0xa7, 0x03, 0xa6, 0xa7, 0x7f,
-	0xa3, 0x75, 0x3e, 0x30, 0x4d, 0xfd, 0xaa, 0xab, 0x6f, 0xe3, 0x8b, 0xef, 0x3b, 0xfb, 0x5c, 0x1e,
-	0x8c, 0xf7, 0xa2, 0xfd, 0xad, 0xc7, 0xc8, 0x17, 0x3c, 0xd0, 0x5f, 0xeb, 0xc9, 0x31, 0xac, 0x2b,
-	0x4f, 0xeb, 0x2a, 0xd7, 0xd1, 0xde, 0x5e, 0x49, 0x89, 0xaf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0x71, 0x98, 0x62, 0xf8, 0xd7, 0x10, 0x00, 0x00,
+type EstimateBuildTimeResponse struct {
+	Status     *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DurationMs int64            `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// the number of the finished builds which the estimate is based on.
+	SampleCount          int64    `protobuf:"varint,3,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	SizeMatched          bool     `protobuf:"varint,4,opt,name=size_matched,json=sizeMatched,proto3" json:"size_matched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// IndexCoordClient is the client API for IndexCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type IndexCoordClient interface {
-	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	BuildIndex(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*BuildIndexResponse, error)
-	GetIndexStates(ctx context.Context, in *GetIndexStatesRequest, opts ...grpc.CallOption) (*GetIndexStatesResponse, error)
-	GetIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	RemoveIndex(ctx context.Context, in *RemoveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+func (m *EstimateBuildTimeResponse) Reset()         { *m = EstimateBuildTimeResponse{} }
+func (m *EstimateBuildTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBuildTimeResponse) ProtoMessage()    {}
+func (*EstimateBuildTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{17}
 }
 
-type indexCoordClient struct {
-	cc *grpc.ClientConn
+func (m *EstimateBuildTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateBuildTimeResponse.Unmarshal(m, b)
 }
-
-func NewIndexCoordClient(cc *grpc.ClientConn) IndexCoordClient {
-	return &indexCoordClient{cc}
+func (m *EstimateBuildTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateBuildTimeResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateBuildTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBuildTimeResponse.Merge(m, src)
+}
+func (m *EstimateBuildTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateBuildTimeResponse.Size(m)
+}
+func (m *EstimateBuildTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBuildTimeResponse.DiscardUnknown(m)
 }
 
-func (c *indexCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
-	out := new(internalpb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EstimateBuildTimeResponse proto.InternalMessageInfo
+
+func (m *EstimateBuildTimeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *indexCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateBuildTimeResponse) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *indexCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateBuildTimeResponse) GetSampleCount() int64 {
+	if m != nil {
+		return m.SampleCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *indexCoordClient) BuildIndex(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*BuildIndexResponse, error) {
-	out := new(BuildIndexResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/BuildIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateBuildTimeResponse) GetSizeMatched() bool {
+	if m != nil {
+		return m.SizeMatched
 	}
-	return out, nil
+	return false
 }
 
-func (c *indexCoordClient) GetIndexStates(ctx context.Context, in *GetIndexStatesRequest, opts ...grpc.CallOption) (*GetIndexStatesResponse, error) {
-	out := new(GetIndexStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListIndexTasksRequest struct {
+	// only the tasks of the build ids are listed if it is not empty.
+	IndexBuildIDs []int64 `protobuf:"varint,1,rep,packed,name=indexBuildIDs,proto3" json:"indexBuildIDs,omitempty"`
+	// only the tasks assigned to the IndexNode are listed if it is not zero.
+	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *indexCoordClient) GetIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error) {
-	out := new(GetIndexFilePathsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexFilePaths", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListIndexTasksRequest) Reset()         { *m = ListIndexTasksRequest{} }
+func (m *ListIndexTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIndexTasksRequest) ProtoMessage()    {}
+func (*ListIndexTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{18}
 }
 
-func (c *indexCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DropIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListIndexTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexTasksRequest.Unmarshal(m, b)
+}
+func (m *ListIndexTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListIndexTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexTasksRequest.Merge(m, src)
+}
+func (m *ListIndexTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIndexTasksRequest.Size(m)
+}
+func (m *ListIndexTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexTasksRequest.DiscardUnknown(m)
 }
 
-func (c *indexCoordClient) RemoveIndex(ctx context.Context, in *RemoveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/RemoveIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListIndexTasksRequest proto.InternalMessageInfo
+
+func (m *ListIndexTasksRequest) GetIndexBuildIDs() []int64 {
+	if m != nil {
+		return m.IndexBuildIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListIndexTasksRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
+}
+
+type IndexTaskInfo struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexTaskInfo) Reset()         { *m = IndexTaskInfo{} }
+func (m *IndexTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexTaskInfo) ProtoMessage()    {}
+func (*IndexTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{19}
+}
+
+func (m *IndexTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexTaskInfo.Unmarshal(m, b)
+}
+func (m *IndexTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *IndexTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexTaskInfo.Merge(m, src)
+}
+func (m *IndexTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_IndexTaskInfo.Size(m)
+}
+func (m *IndexTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexTaskInfo proto.InternalMessageInfo
+
+func (m *IndexTaskInfo) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type ListIndexTasksResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*IndexTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListIndexTasksResponse) Reset()         { *m = ListIndexTasksResponse{} }
+func (m *ListIndexTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIndexTasksResponse) ProtoMessage()    {}
+func (*ListIndexTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{20}
+}
+
+func (m *ListIndexTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexTasksResponse.Unmarshal(m, b)
+}
+func (m *ListIndexTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListIndexTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexTasksResponse.Merge(m, src)
+}
+func (m *ListIndexTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIndexTasksResponse.Size(m)
+}
+func (m *ListIndexTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexTasksResponse proto.InternalMessageInfo
+
+func (m *ListIndexTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListIndexTasksResponse) GetTasks() []*IndexTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type CancelIndexTaskRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelIndexTaskRequest) Reset()         { *m = CancelIndexTaskRequest{} }
+func (m *CancelIndexTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexTaskRequest) ProtoMessage()    {}
+func (*CancelIndexTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{21}
+}
+
+func (m *CancelIndexTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexTaskRequest.Unmarshal(m, b)
+}
+func (m *CancelIndexTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexTaskRequest.Merge(m, src)
+}
+func (m *CancelIndexTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexTaskRequest.Size(m)
+}
+func (m *CancelIndexTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexTaskRequest proto.InternalMessageInfo
+
+func (m *CancelIndexTaskRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+type CancelIndexTasksOlderThanRequest struct {
+	// the tasks enqueued before the cutoff are canceled, in unix milliseconds.
+	CutoffTime           int64    `protobuf:"varint,1,opt,name=cutoff_time,json=cutoffTime,proto3" json:"cutoff_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelIndexTasksOlderThanRequest) Reset()         { *m = CancelIndexTasksOlderThanRequest{} }
+func (m *CancelIndexTasksOlderThanRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexTasksOlderThanRequest) ProtoMessage()    {}
+func (*CancelIndexTasksOlderThanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{22}
+}
+
+func (m *CancelIndexTasksOlderThanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexTasksOlderThanRequest.Unmarshal(m, b)
+}
+func (m *CancelIndexTasksOlderThanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexTasksOlderThanRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexTasksOlderThanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexTasksOlderThanRequest.Merge(m, src)
+}
+func (m *CancelIndexTasksOlderThanRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexTasksOlderThanRequest.Size(m)
+}
+func (m *CancelIndexTasksOlderThanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexTasksOlderThanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexTasksOlderThanRequest proto.InternalMessageInfo
+
+func (m *CancelIndexTasksOlderThanRequest) GetCutoffTime() int64 {
+	if m != nil {
+		return m.CutoffTime
+	}
+	return 0
+}
+
+type CancelIndexTasksOlderThanResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CanceledCount        int64            `protobuf:"varint,2,opt,name=canceled_count,json=canceledCount,proto3" json:"canceled_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CancelIndexTasksOlderThanResponse) Reset()         { *m = CancelIndexTasksOlderThanResponse{} }
+func (m *CancelIndexTasksOlderThanResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexTasksOlderThanResponse) ProtoMessage()    {}
+func (*CancelIndexTasksOlderThanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{23}
+}
+
+func (m *CancelIndexTasksOlderThanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexTasksOlderThanResponse.Unmarshal(m, b)
+}
+func (m *CancelIndexTasksOlderThanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexTasksOlderThanResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexTasksOlderThanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexTasksOlderThanResponse.Merge(m, src)
+}
+func (m *CancelIndexTasksOlderThanResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexTasksOlderThanResponse.Size(m)
+}
+func (m *CancelIndexTasksOlderThanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexTasksOlderThanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexTasksOlderThanResponse proto.InternalMessageInfo
+
+func (m *CancelIndexTasksOlderThanResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CancelIndexTasksOlderThanResponse) GetCanceledCount() int64 {
+	if m != nil {
+		return m.CanceledCount
+	}
+	return 0
+}
+
+type ForceReassignIndexTaskRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceReassignIndexTaskRequest) Reset()         { *m = ForceReassignIndexTaskRequest{} }
+func (m *ForceReassignIndexTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceReassignIndexTaskRequest) ProtoMessage()    {}
+func (*ForceReassignIndexTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{24}
+}
+
+func (m *ForceReassignIndexTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceReassignIndexTaskRequest.Unmarshal(m, b)
+}
+func (m *ForceReassignIndexTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceReassignIndexTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ForceReassignIndexTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceReassignIndexTaskRequest.Merge(m, src)
+}
+func (m *ForceReassignIndexTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceReassignIndexTaskRequest.Size(m)
+}
+func (m *ForceReassignIndexTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceReassignIndexTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceReassignIndexTaskRequest proto.InternalMessageInfo
+
+func (m *ForceReassignIndexTaskRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+type SetIndexTaskPriorityRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	Priority             int64    `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetIndexTaskPriorityRequest) Reset()         { *m = SetIndexTaskPriorityRequest{} }
+func (m *SetIndexTaskPriorityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetIndexTaskPriorityRequest) ProtoMessage()    {}
+func (*SetIndexTaskPriorityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{25}
+}
+
+func (m *SetIndexTaskPriorityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetIndexTaskPriorityRequest.Unmarshal(m, b)
+}
+func (m *SetIndexTaskPriorityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetIndexTaskPriorityRequest.Marshal(b, m, deterministic)
+}
+func (m *SetIndexTaskPriorityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIndexTaskPriorityRequest.Merge(m, src)
+}
+func (m *SetIndexTaskPriorityRequest) XXX_Size() int {
+	return xxx_messageInfo_SetIndexTaskPriorityRequest.Size(m)
+}
+func (m *SetIndexTaskPriorityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIndexTaskPriorityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIndexTaskPriorityRequest proto.InternalMessageInfo
+
+func (m *SetIndexTaskPriorityRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *SetIndexTaskPriorityRequest) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type PauseIndexTaskRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseIndexTaskRequest) Reset()         { *m = PauseIndexTaskRequest{} }
+func (m *PauseIndexTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseIndexTaskRequest) ProtoMessage()    {}
+func (*PauseIndexTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{26}
+}
+
+func (m *PauseIndexTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseIndexTaskRequest.Unmarshal(m, b)
+}
+func (m *PauseIndexTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseIndexTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseIndexTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseIndexTaskRequest.Merge(m, src)
+}
+func (m *PauseIndexTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseIndexTaskRequest.Size(m)
+}
+func (m *PauseIndexTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseIndexTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseIndexTaskRequest proto.InternalMessageInfo
+
+func (m *PauseIndexTaskRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+type ResumeIndexTaskRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeIndexTaskRequest) Reset()         { *m = ResumeIndexTaskRequest{} }
+func (m *ResumeIndexTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeIndexTaskRequest) ProtoMessage()    {}
+func (*ResumeIndexTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{27}
+}
+
+func (m *ResumeIndexTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeIndexTaskRequest.Unmarshal(m, b)
+}
+func (m *ResumeIndexTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeIndexTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeIndexTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeIndexTaskRequest.Merge(m, src)
+}
+func (m *ResumeIndexTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeIndexTaskRequest.Size(m)
+}
+func (m *ResumeIndexTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeIndexTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeIndexTaskRequest proto.InternalMessageInfo
+
+func (m *ResumeIndexTaskRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+type ReconcileIndexTasksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileIndexTasksRequest) Reset()         { *m = ReconcileIndexTasksRequest{} }
+func (m *ReconcileIndexTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileIndexTasksRequest) ProtoMessage()    {}
+func (*ReconcileIndexTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{28}
+}
+
+func (m *ReconcileIndexTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileIndexTasksRequest.Unmarshal(m, b)
+}
+func (m *ReconcileIndexTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileIndexTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ReconcileIndexTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileIndexTasksRequest.Merge(m, src)
+}
+func (m *ReconcileIndexTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ReconcileIndexTasksRequest.Size(m)
+}
+func (m *ReconcileIndexTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileIndexTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileIndexTasksRequest proto.InternalMessageInfo
+
+type PauseIndexBuilderRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseIndexBuilderRequest) Reset()         { *m = PauseIndexBuilderRequest{} }
+func (m *PauseIndexBuilderRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseIndexBuilderRequest) ProtoMessage()    {}
+func (*PauseIndexBuilderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{29}
+}
+
+func (m *PauseIndexBuilderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseIndexBuilderRequest.Unmarshal(m, b)
+}
+func (m *PauseIndexBuilderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseIndexBuilderRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseIndexBuilderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseIndexBuilderRequest.Merge(m, src)
+}
+func (m *PauseIndexBuilderRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseIndexBuilderRequest.Size(m)
+}
+func (m *PauseIndexBuilderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseIndexBuilderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseIndexBuilderRequest proto.InternalMessageInfo
+
+type ResumeIndexBuilderRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeIndexBuilderRequest) Reset()         { *m = ResumeIndexBuilderRequest{} }
+func (m *ResumeIndexBuilderRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeIndexBuilderRequest) ProtoMessage()    {}
+func (*ResumeIndexBuilderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{30}
+}
+
+func (m *ResumeIndexBuilderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeIndexBuilderRequest.Unmarshal(m, b)
+}
+func (m *ResumeIndexBuilderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeIndexBuilderRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeIndexBuilderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeIndexBuilderRequest.Merge(m, src)
+}
+func (m *ResumeIndexBuilderRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeIndexBuilderRequest.Size(m)
+}
+func (m *ResumeIndexBuilderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeIndexBuilderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeIndexBuilderRequest proto.InternalMessageInfo
+
+type SetIndexNodeResourceGroupRequest struct {
+	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// the IndexNode is removed from its resource group if it is empty.
+	ResourceGroup        string   `protobuf:"bytes,2,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetIndexNodeResourceGroupRequest) Reset()         { *m = SetIndexNodeResourceGroupRequest{} }
+func (m *SetIndexNodeResourceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*SetIndexNodeResourceGroupRequest) ProtoMessage()    {}
+func (*SetIndexNodeResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{31}
+}
+
+func (m *SetIndexNodeResourceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetIndexNodeResourceGroupRequest.Unmarshal(m, b)
+}
+func (m *SetIndexNodeResourceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetIndexNodeResourceGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *SetIndexNodeResourceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIndexNodeResourceGroupRequest.Merge(m, src)
+}
+func (m *SetIndexNodeResourceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_SetIndexNodeResourceGroupRequest.Size(m)
+}
+func (m *SetIndexNodeResourceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIndexNodeResourceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIndexNodeResourceGroupRequest proto.InternalMessageInfo
+
+func (m *SetIndexNodeResourceGroupRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SetIndexNodeResourceGroupRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+type DrainIndexNodeRequest struct {
+	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// the maximum number of the builds moved per second.
+	Rate                 int64    `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainIndexNodeRequest) Reset()         { *m = DrainIndexNodeRequest{} }
+func (m *DrainIndexNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainIndexNodeRequest) ProtoMessage()    {}
+func (*DrainIndexNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{32}
+}
+
+func (m *DrainIndexNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainIndexNodeRequest.Unmarshal(m, b)
+}
+func (m *DrainIndexNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainIndexNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *DrainIndexNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainIndexNodeRequest.Merge(m, src)
+}
+func (m *DrainIndexNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainIndexNodeRequest.Size(m)
+}
+func (m *DrainIndexNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainIndexNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainIndexNodeRequest proto.InternalMessageInfo
+
+func (m *DrainIndexNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *DrainIndexNodeRequest) GetRate() int64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type IndexBuilderTaskDump struct {
+	IndexBuildID int64  `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	State        string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	NodeID       int64  `protobuf:"varint,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	PreferNodeID int64  `protobuf:"varint,4,opt,name=prefer_nodeID,json=preferNodeID,proto3" json:"prefer_nodeID,omitempty"`
+	Priority     int64  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	RetryCount   int64  `protobuf:"varint,6,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	FailReason   string `protobuf:"bytes,7,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	// the task is not reassigned before the deadline, in unix milliseconds, 0 if the task is not in retry.
+	RetryDeadline int64 `protobuf:"varint,8,opt,name=retry_deadline,json=retryDeadline,proto3" json:"retry_deadline,omitempty"`
+	// in unix milliseconds.
+	EnqueueTime          int64    `protobuf:"varint,9,opt,name=enqueue_time,json=enqueueTime,proto3" json:"enqueue_time,omitempty"`
+	BlockedReason        string   `protobuf:"bytes,10,opt,name=blocked_reason,json=blockedReason,proto3" json:"blocked_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexBuilderTaskDump) Reset()         { *m = IndexBuilderTaskDump{} }
+func (m *IndexBuilderTaskDump) String() string { return proto.CompactTextString(m) }
+func (*IndexBuilderTaskDump) ProtoMessage()    {}
+func (*IndexBuilderTaskDump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{33}
+}
+
+func (m *IndexBuilderTaskDump) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexBuilderTaskDump.Unmarshal(m, b)
+}
+func (m *IndexBuilderTaskDump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexBuilderTaskDump.Marshal(b, m, deterministic)
+}
+func (m *IndexBuilderTaskDump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexBuilderTaskDump.Merge(m, src)
+}
+func (m *IndexBuilderTaskDump) XXX_Size() int {
+	return xxx_messageInfo_IndexBuilderTaskDump.Size(m)
+}
+func (m *IndexBuilderTaskDump) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexBuilderTaskDump.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexBuilderTaskDump proto.InternalMessageInfo
+
+func (m *IndexBuilderTaskDump) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *IndexBuilderTaskDump) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetPreferNodeID() int64 {
+	if m != nil {
+		return m.PreferNodeID
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *IndexBuilderTaskDump) GetRetryDeadline() int64 {
+	if m != nil {
+		return m.RetryDeadline
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetEnqueueTime() int64 {
+	if m != nil {
+		return m.EnqueueTime
+	}
+	return 0
+}
+
+func (m *IndexBuilderTaskDump) GetBlockedReason() string {
+	if m != nil {
+		return m.BlockedReason
+	}
+	return ""
+}
+
+type IndexBuilderDump struct {
+	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Paused               bool                    `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Tasks                []*IndexBuilderTaskDump `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *IndexBuilderDump) Reset()         { *m = IndexBuilderDump{} }
+func (m *IndexBuilderDump) String() string { return proto.CompactTextString(m) }
+func (*IndexBuilderDump) ProtoMessage()    {}
+func (*IndexBuilderDump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{34}
+}
+
+func (m *IndexBuilderDump) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexBuilderDump.Unmarshal(m, b)
+}
+func (m *IndexBuilderDump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexBuilderDump.Marshal(b, m, deterministic)
+}
+func (m *IndexBuilderDump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexBuilderDump.Merge(m, src)
+}
+func (m *IndexBuilderDump) XXX_Size() int {
+	return xxx_messageInfo_IndexBuilderDump.Size(m)
+}
+func (m *IndexBuilderDump) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexBuilderDump.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexBuilderDump proto.InternalMessageInfo
+
+func (m *IndexBuilderDump) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IndexBuilderDump) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *IndexBuilderDump) GetTasks() []*IndexBuilderTaskDump {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
+	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
+	proto.RegisterType((*GetIndexStatesRequest)(nil), "milvus.proto.index.GetIndexStatesRequest")
+	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
+	proto.RegisterType((*GetIndexStatesResponse)(nil), "milvus.proto.index.GetIndexStatesResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.index.CreateIndexRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.index.CreateIndexRequest.TraceContextEntry")
+	proto.RegisterType((*CancelIndexRequest)(nil), "milvus.proto.index.CancelIndexRequest")
+	proto.RegisterType((*BuildIndexRequest)(nil), "milvus.proto.index.BuildIndexRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.index.BuildIndexRequest.TraceContextEntry")
+	proto.RegisterType((*BuildIndexResponse)(nil), "milvus.proto.index.BuildIndexResponse")
+	proto.RegisterType((*GetIndexFilePathsRequest)(nil), "milvus.proto.index.GetIndexFilePathsRequest")
+	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
+	proto.RegisterType((*GetIndexFilePathsResponse)(nil), "milvus.proto.index.GetIndexFilePathsResponse")
+	proto.RegisterType((*IndexMeta)(nil), "milvus.proto.index.IndexMeta")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
+	proto.RegisterType((*RemoveIndexRequest)(nil), "milvus.proto.index.RemoveIndexRequest")
+	proto.RegisterType((*GetTaskSlotsRequest)(nil), "milvus.proto.index.GetTaskSlotsRequest")
+	proto.RegisterType((*GetTaskSlotsResponse)(nil), "milvus.proto.index.GetTaskSlotsResponse")
+	proto.RegisterType((*EstimateBuildTimeResponse)(nil), "milvus.proto.index.EstimateBuildTimeResponse")
+	proto.RegisterType((*ListIndexTasksRequest)(nil), "milvus.proto.index.ListIndexTasksRequest")
+	proto.RegisterType((*IndexTaskInfo)(nil), "milvus.proto.index.IndexTaskInfo")
+	proto.RegisterType((*ListIndexTasksResponse)(nil), "milvus.proto.index.ListIndexTasksResponse")
+	proto.RegisterType((*CancelIndexTaskRequest)(nil), "milvus.proto.index.CancelIndexTaskRequest")
+	proto.RegisterType((*CancelIndexTasksOlderThanRequest)(nil), "milvus.proto.index.CancelIndexTasksOlderThanRequest")
+	proto.RegisterType((*CancelIndexTasksOlderThanResponse)(nil), "milvus.proto.index.CancelIndexTasksOlderThanResponse")
+	proto.RegisterType((*ForceReassignIndexTaskRequest)(nil), "milvus.proto.index.ForceReassignIndexTaskRequest")
+	proto.RegisterType((*SetIndexTaskPriorityRequest)(nil), "milvus.proto.index.SetIndexTaskPriorityRequest")
+	proto.RegisterType((*PauseIndexTaskRequest)(nil), "milvus.proto.index.PauseIndexTaskRequest")
+	proto.RegisterType((*ResumeIndexTaskRequest)(nil), "milvus.proto.index.ResumeIndexTaskRequest")
+	proto.RegisterType((*ReconcileIndexTasksRequest)(nil), "milvus.proto.index.ReconcileIndexTasksRequest")
+	proto.RegisterType((*PauseIndexBuilderRequest)(nil), "milvus.proto.index.PauseIndexBuilderRequest")
+	proto.RegisterType((*ResumeIndexBuilderRequest)(nil), "milvus.proto.index.ResumeIndexBuilderRequest")
+	proto.RegisterType((*SetIndexNodeResourceGroupRequest)(nil), "milvus.proto.index.SetIndexNodeResourceGroupRequest")
+	proto.RegisterType((*DrainIndexNodeRequest)(nil), "milvus.proto.index.DrainIndexNodeRequest")
+	proto.RegisterType((*IndexBuilderTaskDump)(nil), "milvus.proto.index.IndexBuilderTaskDump")
+	proto.RegisterType((*IndexBuilderDump)(nil), "milvus.proto.index.IndexBuilderDump")
+}
+
+func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
+
+var fileDescriptor_f9e019eb3fda53c2 = []byte{
+	// 2151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x73, 0xdb, 0xc8,
+	0xf1, 0x37, 0x45, 0xbd, 0xd8, 0x7c, 0xd8, 0x1a, 0xcb, 0xfa, 0x43, 0xb4, 0x5d, 0x96, 0xb1, 0xeb,
+	0xb5, 0xfe, 0x5b, 0x6b, 0xc9, 0xd1, 0xae, 0x63, 0x57, 0xb2, 0x79, 0x99, 0x5c, 0xab, 0x54, 0x89,
+	0xbc, 0x2a, 0x48, 0xf1, 0x61, 0x63, 0x17, 0x33, 0x02, 0x9a, 0xd2, 0x44, 0x78, 0xd0, 0x83, 0xa1,
+	0x6d, 0xb9, 0x2a, 0xc9, 0x25, 0x95, 0x43, 0x2e, 0xb9, 0x25, 0x55, 0xf9, 0x22, 0xb9, 0x25, 0xe7,
+	0x1c, 0x93, 0x73, 0x3e, 0x4c, 0x6a, 0x1e, 0x00, 0x01, 0x12, 0x14, 0x29, 0x29, 0xde, 0x53, 0x6e,
+	0xec, 0x46, 0x4f, 0xf7, 0x4c, 0xcf, 0xaf, 0xbb, 0x7f, 0x00, 0x61, 0x89, 0x85, 0x1e, 0xbe, 0xeb,
+	0xb8, 0x51, 0xc4, 0xbd, 0x8d, 0x1e, 0x8f, 0x44, 0x44, 0x48, 0xc0, 0xfc, 0x37, 0xfd, 0x58, 0x4b,
+	0x1b, 0xea, 0x79, 0xb3, 0xe6, 0x46, 0x41, 0x10, 0x85, 0x5a, 0xd7, 0x6c, 0xb0, 0x50, 0x20, 0x0f,
+	0xa9, 0x6f, 0xe4, 0x5a, 0x76, 0x45, 0xb3, 0x16, 0xbb, 0xc7, 0x18, 0x50, 0x2d, 0xd9, 0x7f, 0x2e,
+	0xc1, 0x75, 0x07, 0x8f, 0x58, 0x2c, 0x90, 0x3f, 0x8f, 0x3c, 0x74, 0xf0, 0x75, 0x1f, 0x63, 0x41,
+	0x1e, 0xc2, 0xec, 0x21, 0x8d, 0xd1, 0x2a, 0xad, 0x95, 0xd6, 0xab, 0x5b, 0xb7, 0x36, 0x72, 0x41,
+	0x4d, 0xb4, 0xdd, 0xf8, 0xe8, 0x29, 0x8d, 0xd1, 0x51, 0x96, 0xe4, 0xbb, 0xb0, 0x40, 0x3d, 0x8f,
+	0x63, 0x1c, 0x5b, 0x33, 0x67, 0x2c, 0xfa, 0x89, 0xb6, 0x71, 0x12, 0x63, 0xb2, 0x02, 0xf3, 0x61,
+	0xe4, 0xe1, 0x4e, 0xdb, 0x2a, 0xaf, 0x95, 0xd6, 0xcb, 0x8e, 0x91, 0xec, 0x3f, 0x96, 0x60, 0x39,
+	0xbf, 0xb3, 0xb8, 0x17, 0x85, 0x31, 0x92, 0xcf, 0x61, 0x3e, 0x16, 0x54, 0xf4, 0x63, 0xb3, 0xb9,
+	0x9b, 0x85, 0x71, 0xf6, 0x95, 0x89, 0x63, 0x4c, 0xc9, 0x53, 0xa8, 0xb2, 0x90, 0x89, 0x4e, 0x8f,
+	0x72, 0x1a, 0x24, 0x3b, 0xbc, 0xbb, 0x31, 0x94, 0x4b, 0x93, 0xb6, 0x9d, 0x90, 0x89, 0x3d, 0x65,
+	0xe8, 0x00, 0x4b, 0x7f, 0xdb, 0x3f, 0x80, 0x1b, 0xdb, 0x28, 0x76, 0x64, 0xc6, 0xa5, 0x77, 0x8c,
+	0x93, 0x64, 0x7d, 0x0c, 0x75, 0x75, 0x0f, 0x4f, 0xfb, 0xcc, 0xf7, 0x76, 0xda, 0x72, 0x63, 0xe5,
+	0xf5, 0xb2, 0x93, 0x57, 0xda, 0x7f, 0x2d, 0x41, 0x45, 0x2d, 0xde, 0x09, 0xbb, 0x11, 0x79, 0x04,
+	0x73, 0x72, 0x6b, 0x3a, 0xc3, 0x8d, 0xad, 0x3b, 0x85, 0x87, 0x18, 0xc4, 0x72, 0xb4, 0x35, 0xb1,
+	0xa1, 0x96, 0xf5, 0xaa, 0x0e, 0x52, 0x76, 0x72, 0x3a, 0x62, 0xc1, 0x82, 0x92, 0xd3, 0x94, 0x26,
+	0x22, 0xb9, 0x0d, 0xa0, 0x01, 0x15, 0xd2, 0x00, 0xad, 0xd9, 0xb5, 0xd2, 0x7a, 0xc5, 0xa9, 0x28,
+	0xcd, 0x73, 0x1a, 0xa0, 0xbc, 0x0a, 0x8e, 0x34, 0x8e, 0x42, 0x6b, 0x4e, 0x3d, 0x32, 0x92, 0xfd,
+	0xbb, 0x12, 0xac, 0x0c, 0x9f, 0xfc, 0x32, 0x97, 0xf1, 0x48, 0x2f, 0x42, 0x79, 0x0f, 0xe5, 0xf5,
+	0xea, 0xd6, 0xed, 0x8d, 0x51, 0x4c, 0x6f, 0xa4, 0xa9, 0x72, 0x8c, 0xb1, 0xfd, 0x97, 0x59, 0x20,
+	0x2d, 0x8e, 0x54, 0xa0, 0x7a, 0x96, 0x64, 0x7f, 0x38, 0x25, 0xa5, 0x82, 0x94, 0xe4, 0x0f, 0x3e,
+	0x33, 0x7c, 0xf0, 0xf1, 0x19, 0xb3, 0x60, 0xe1, 0x0d, 0xf2, 0x98, 0x45, 0xa1, 0x4a, 0x57, 0xd9,
+	0x49, 0x44, 0x72, 0x13, 0x2a, 0x01, 0x0a, 0xda, 0xe9, 0x51, 0x71, 0x6c, 0xf2, 0xb5, 0x28, 0x15,
+	0x7b, 0x54, 0x1c, 0xcb, 0x78, 0x1e, 0x35, 0x0f, 0x63, 0x6b, 0x7e, 0xad, 0x2c, 0xe3, 0x79, 0x54,
+	0x3f, 0x55, 0x68, 0x14, 0xa7, 0x3d, 0x4c, 0xd0, 0xb8, 0xb0, 0x56, 0x1e, 0x45, 0xa3, 0x49, 0xdd,
+	0x4f, 0xf1, 0xf4, 0x05, 0xf5, 0xfb, 0xb8, 0x47, 0x19, 0x77, 0x40, 0xae, 0xd2, 0x68, 0x24, 0x6d,
+	0x73, 0xec, 0xc4, 0xc9, 0xe2, 0xb4, 0x4e, 0xaa, 0x6a, 0x99, 0xf1, 0xf2, 0x0a, 0xea, 0x82, 0x53,
+	0x17, 0x3b, 0x6e, 0x14, 0x0a, 0x7c, 0x27, 0xac, 0x8a, 0x72, 0xf3, 0xa4, 0xe8, 0x46, 0x46, 0x73,
+	0xbf, 0x71, 0x20, 0xd7, 0xb6, 0xf4, 0xd2, 0xaf, 0x42, 0xc1, 0x4f, 0x9d, 0x9a, 0xc8, 0xa8, 0xc8,
+	0x7d, 0xb8, 0xea, 0x1e, 0xa3, 0x7b, 0xd2, 0x8b, 0x58, 0x28, 0x74, 0xaa, 0x40, 0xa5, 0xaa, 0x31,
+	0x50, 0xcb, 0x94, 0x34, 0x7f, 0x04, 0x4b, 0x23, 0xbe, 0xc8, 0x35, 0x28, 0x9f, 0xe0, 0xa9, 0xba,
+	0xd0, 0x8a, 0x23, 0x7f, 0x92, 0x65, 0x98, 0x7b, 0x23, 0x0f, 0x62, 0xae, 0x50, 0x0b, 0xdf, 0x9b,
+	0x79, 0x52, 0xb2, 0x9f, 0x00, 0x69, 0xd1, 0xd0, 0x45, 0xff, 0xbc, 0xd8, 0xb0, 0xff, 0x36, 0x0f,
+	0x4b, 0xfa, 0xf7, 0xb7, 0x86, 0xaa, 0x3c, 0x3c, 0xe6, 0x26, 0xc0, 0x63, 0xfe, 0xbf, 0x01, 0x8f,
+	0x85, 0x0b, 0xc1, 0x63, 0x15, 0x16, 0xc3, 0x7e, 0xd0, 0xe1, 0xd1, 0x5b, 0x09, 0x30, 0x75, 0x86,
+	0xb0, 0x1f, 0x38, 0xd1, 0xdb, 0x98, 0xb4, 0xa0, 0xd6, 0x65, 0xe8, 0x7b, 0x1d, 0x3d, 0x4f, 0xac,
+	0x8a, 0xaa, 0xff, 0xb5, 0x7c, 0x00, 0xfd, 0x6c, 0xe3, 0x99, 0x34, 0xdc, 0x57, 0xbf, 0x9d, 0x6a,
+	0x77, 0x20, 0x90, 0x5b, 0x50, 0x89, 0xf1, 0x28, 0xc0, 0x50, 0xec, 0xb4, 0x15, 0x32, 0xca, 0xce,
+	0x40, 0x21, 0xef, 0xc0, 0x8d, 0x7c, 0x1f, 0x5d, 0xc1, 0xa2, 0x70, 0xa7, 0x6d, 0x55, 0xf5, 0x1d,
+	0x64, 0x75, 0xe4, 0x1e, 0x34, 0xcc, 0x82, 0x4e, 0xc4, 0xd9, 0x11, 0x0b, 0xad, 0x9a, 0xba, 0x87,
+	0xba, 0xd1, 0x7e, 0xad, 0x94, 0xd2, 0x8c, 0x63, 0x1c, 0xf5, 0xb9, 0x8b, 0x9d, 0x23, 0x1e, 0xf5,
+	0x7b, 0x56, 0x5d, 0x9b, 0x25, 0xda, 0x6d, 0xa9, 0x94, 0x66, 0x87, 0xf2, 0x72, 0x3b, 0x3d, 0xce,
+	0x22, 0xce, 0xc4, 0xa9, 0xd5, 0x50, 0x31, 0xeb, 0x4a, 0xbb, 0x67, 0x94, 0x03, 0x33, 0x0f, 0xa9,
+	0xe7, 0xb3, 0x10, 0xad, 0xab, 0x19, 0xb3, 0xb6, 0x51, 0x92, 0xbb, 0x50, 0x8b, 0x8f, 0xa9, 0x17,
+	0xbd, 0xed, 0x28, 0xbd, 0x75, 0x6d, 0xad, 0xb4, 0xbe, 0xe8, 0x54, 0xb5, 0x4e, 0x81, 0x88, 0x7c,
+	0x04, 0xf5, 0x1e, 0x0b, 0x43, 0xf4, 0x3a, 0x66, 0x08, 0x2e, 0xe9, 0x33, 0x6a, 0xe5, 0x73, 0xa5,
+	0x23, 0x2f, 0x87, 0x8b, 0x94, 0xa8, 0xcb, 0x7c, 0x5c, 0x54, 0xa4, 0x23, 0x48, 0x9e, 0x54, 0xa3,
+	0x97, 0x2f, 0xbd, 0x00, 0x48, 0x36, 0xea, 0x65, 0x26, 0xc3, 0x14, 0xe3, 0xcd, 0xfe, 0x31, 0x58,
+	0xc9, 0x30, 0x7a, 0xc6, 0x7c, 0x54, 0x25, 0x73, 0xbe, 0x49, 0xfc, 0xf7, 0x12, 0x2c, 0xe5, 0xd6,
+	0xab, 0x89, 0xfc, 0xa1, 0x36, 0x4c, 0xd6, 0xe1, 0x9a, 0x2e, 0xc5, 0x2e, 0xf3, 0xd1, 0xd4, 0x7c,
+	0x59, 0xd5, 0x7c, 0x83, 0xe5, 0x4e, 0x21, 0xdb, 0x65, 0x8c, 0x9c, 0x51, 0x9f, 0xbd, 0x47, 0xaf,
+	0x13, 0xb3, 0xf7, 0x7a, 0x48, 0xcf, 0x3a, 0x8d, 0x81, 0x7a, 0x9f, 0xbd, 0x47, 0xfb, 0x4f, 0x25,
+	0x58, 0x2d, 0x48, 0xc2, 0x65, 0x52, 0xdf, 0x06, 0xc8, 0xec, 0x4f, 0x0f, 0xe6, 0x7b, 0x63, 0x07,
+	0x73, 0x36, 0x73, 0x4e, 0xa5, 0x6b, 0xa4, 0xd8, 0xfe, 0x57, 0xd9, 0x90, 0x9c, 0x5d, 0x14, 0x74,
+	0xaa, 0x26, 0x9a, 0x12, 0xa1, 0x99, 0x73, 0x11, 0xa1, 0x3b, 0x50, 0xed, 0x52, 0xe6, 0x77, 0x0c,
+	0x61, 0x29, 0x2b, 0x50, 0x82, 0x54, 0x39, 0x4a, 0x43, 0x1e, 0x43, 0x99, 0xe3, 0x6b, 0x95, 0xbf,
+	0x31, 0x07, 0x19, 0x29, 0x15, 0x47, 0xae, 0x28, 0xbc, 0xae, 0xb9, 0xc2, 0xeb, 0xba, 0x0b, 0xb5,
+	0x80, 0xf2, 0x93, 0x8e, 0x87, 0x3e, 0x0a, 0xf4, 0xac, 0x79, 0x5d, 0xdf, 0x52, 0xd7, 0xd6, 0xaa,
+	0x0c, 0xbb, 0x5d, 0xc8, 0xb2, 0x5b, 0x59, 0xf7, 0x3a, 0x48, 0xc2, 0x2e, 0x16, 0x33, 0xa9, 0x79,
+	0xa1, 0x75, 0xa4, 0x09, 0x8b, 0x1c, 0xdd, 0x53, 0xd7, 0x47, 0x4f, 0xb5, 0xd7, 0x45, 0x27, 0x95,
+	0x75, 0xdf, 0x33, 0x98, 0xd0, 0x48, 0x01, 0x85, 0x94, 0x7a, 0xaa, 0x95, 0x40, 0x91, 0x2e, 0x7a,
+	0x3c, 0x3a, 0x52, 0xb4, 0x5c, 0xb6, 0xcf, 0x19, 0x27, 0x95, 0x8b, 0x86, 0x73, 0xad, 0x68, 0x38,
+	0xdb, 0x9f, 0xc1, 0xb5, 0x36, 0x8f, 0x7a, 0xb9, 0xf9, 0x98, 0x19, 0x6e, 0xa5, 0xdc, 0x70, 0xb3,
+	0x1f, 0x02, 0x71, 0x30, 0x88, 0xde, 0xe4, 0x59, 0x5a, 0x13, 0x16, 0x0f, 0xf3, 0x45, 0x99, 0xca,
+	0xf6, 0x0d, 0xb8, 0xbe, 0x8d, 0xe2, 0x80, 0xc6, 0x27, 0xfb, 0x7e, 0x24, 0x92, 0x62, 0xb6, 0x7f,
+	0x0d, 0xcb, 0x79, 0xf5, 0x65, 0xe0, 0xbd, 0x0c, 0x73, 0xb1, 0xf4, 0x62, 0x2a, 0x54, 0x0b, 0x72,
+	0x57, 0x2e, 0xed, 0x51, 0x57, 0x76, 0x7a, 0x3d, 0xa3, 0x53, 0x59, 0xf2, 0xf5, 0xd5, 0xaf, 0x62,
+	0xc1, 0x02, 0x2a, 0x50, 0x41, 0xe5, 0x80, 0x05, 0x97, 0x7c, 0x0b, 0xb9, 0x03, 0x55, 0xaf, 0xcf,
+	0xa9, 0x60, 0x51, 0xd8, 0x09, 0x92, 0xad, 0x40, 0xa2, 0xda, 0x55, 0x88, 0x8a, 0x69, 0xd0, 0xf3,
+	0x65, 0xab, 0xef, 0x87, 0xc2, 0xec, 0xa9, 0xaa, 0x75, 0x2d, 0xa9, 0x52, 0x26, 0xf2, 0xce, 0x03,
+	0x2a, 0xdc, 0x63, 0xf4, 0xac, 0x59, 0x33, 0x54, 0xd8, 0x7b, 0xdc, 0xd5, 0x2a, 0xfb, 0xe7, 0x70,
+	0xe3, 0x67, 0x2c, 0xd6, 0xdd, 0x41, 0xa6, 0xef, 0x7c, 0xed, 0x31, 0x83, 0xd9, 0x99, 0xdc, 0x1b,
+	0xd9, 0x0e, 0xd4, 0x53, 0x97, 0xaa, 0x63, 0x4e, 0x53, 0xde, 0xcb, 0xd9, 0xf2, 0xae, 0x98, 0xea,
+	0xb5, 0x7f, 0x5f, 0x82, 0x95, 0xe1, 0x2d, 0x5e, 0x26, 0xb1, 0x8f, 0x61, 0x4e, 0x48, 0x2f, 0xd6,
+	0x4c, 0x11, 0xcd, 0xc9, 0xf4, 0xad, 0x64, 0xef, 0x8e, 0xb6, 0xb7, 0xbf, 0x84, 0x95, 0x0c, 0x6d,
+	0x94, 0x4f, 0xcf, 0x43, 0x1d, 0x5b, 0xb0, 0x36, 0xb4, 0x3a, 0xfe, 0xda, 0xf7, 0x90, 0x1f, 0x1c,
+	0xd3, 0x30, 0xf1, 0x73, 0x07, 0xaa, 0x6e, 0x5f, 0x44, 0xdd, 0x6e, 0x47, 0xb0, 0x00, 0x8d, 0x1b,
+	0xd0, 0x2a, 0x89, 0x28, 0xfb, 0xb7, 0x70, 0xf7, 0x0c, 0x27, 0x97, 0xc9, 0xca, 0x3d, 0x68, 0xb8,
+	0xca, 0x33, 0x7a, 0x06, 0x4f, 0xfa, 0x42, 0xeb, 0x89, 0x56, 0x21, 0xca, 0x6e, 0xc1, 0xed, 0x67,
+	0x11, 0x77, 0x51, 0x36, 0xce, 0x98, 0x1d, 0x85, 0x17, 0x4a, 0xc5, 0x2b, 0xb8, 0xb9, 0x8f, 0x83,
+	0xfb, 0x4c, 0xa8, 0xd2, 0x79, 0xe8, 0xb4, 0xea, 0x55, 0x7a, 0x99, 0xd9, 0x68, 0x2a, 0xdb, 0xdf,
+	0x87, 0x1b, 0x7b, 0xb4, 0x1f, 0xe3, 0x85, 0xf6, 0xf6, 0x25, 0xac, 0x38, 0x18, 0xf7, 0x83, 0x8b,
+	0xad, 0xbe, 0x05, 0x4d, 0x07, 0xdd, 0x28, 0x74, 0x99, 0x8f, 0x23, 0x25, 0x65, 0x37, 0xc1, 0x1a,
+	0x6c, 0x4c, 0x2d, 0x41, 0x9e, 0x3c, 0xbb, 0x09, 0xab, 0x99, 0xb8, 0x43, 0x0f, 0x29, 0xac, 0x25,
+	0x09, 0x33, 0x9f, 0x37, 0x06, 0x3c, 0x34, 0xd9, 0xde, 0xa0, 0x12, 0x4b, 0xb9, 0xe9, 0x31, 0xca,
+	0x66, 0x67, 0x0a, 0xd8, 0xac, 0xdd, 0x82, 0x1b, 0x6d, 0x4e, 0x59, 0x98, 0x09, 0x72, 0xb6, 0x5f,
+	0x02, 0xb3, 0x3c, 0xa9, 0xd5, 0xb2, 0xa3, 0x7e, 0xdb, 0xff, 0x9e, 0x81, 0xe5, 0xec, 0xfe, 0xe5,
+	0xe9, 0xdb, 0xfd, 0xa0, 0x77, 0xf1, 0xea, 0x1f, 0xf7, 0xc9, 0x47, 0x91, 0x61, 0x8e, 0x5d, 0xe4,
+	0x09, 0x19, 0x9e, 0x35, 0x64, 0x58, 0x29, 0x0d, 0x19, 0xce, 0xa2, 0x64, 0x2e, 0x8f, 0x12, 0x59,
+	0x6b, 0x1c, 0x05, 0x3f, 0x35, 0x68, 0x9f, 0x57, 0x8f, 0x41, 0xa9, 0x74, 0xf3, 0x1c, 0x62, 0x0d,
+	0x0b, 0x23, 0xac, 0x41, 0x65, 0x56, 0x7a, 0x48, 0x99, 0xbd, 0x1e, 0xcc, 0x75, 0xa5, 0xcd, 0x32,
+	0x7b, 0x0c, 0x5f, 0xf7, 0xb1, 0x8f, 0xba, 0xaa, 0x2b, 0xba, 0x4f, 0x1b, 0x9d, 0x2c, 0x6b, 0xf5,
+	0x8e, 0xe0, 0x47, 0xee, 0x09, 0x7a, 0x49, 0x34, 0xfd, 0xe6, 0x5b, 0x37, 0x5a, 0x1d, 0xd0, 0xfe,
+	0x0d, 0x5c, 0xcb, 0x66, 0x57, 0x65, 0x96, 0xc0, 0xac, 0x7a, 0xa3, 0xd4, 0xec, 0x5b, 0xfd, 0x96,
+	0x39, 0xeb, 0x49, 0x9c, 0x79, 0x2a, 0x95, 0x8b, 0x8e, 0x91, 0xc8, 0x0f, 0x93, 0xce, 0x57, 0x56,
+	0x9d, 0x6f, 0x7d, 0x6c, 0xe7, 0x1b, 0xba, 0x3e, 0xd3, 0x00, 0xb7, 0xfe, 0x71, 0x1d, 0x40, 0x3d,
+	0x6f, 0x45, 0x11, 0xf7, 0x48, 0x0f, 0xc8, 0x36, 0x8a, 0x56, 0x14, 0xf4, 0xa2, 0x10, 0x43, 0xa1,
+	0xbf, 0xf6, 0x90, 0x87, 0x63, 0x3e, 0x94, 0x8d, 0x9a, 0x1a, 0x84, 0x35, 0x3f, 0x19, 0xb3, 0x62,
+	0xc8, 0xdc, 0xbe, 0x42, 0x02, 0x15, 0x51, 0xa6, 0xec, 0x80, 0xb9, 0x27, 0xad, 0x63, 0x1a, 0x86,
+	0xe8, 0x9f, 0x15, 0x71, 0xc8, 0x34, 0x89, 0xf8, 0x51, 0x7e, 0x85, 0x11, 0xf6, 0x05, 0x67, 0xe1,
+	0x51, 0xd2, 0x46, 0xed, 0x2b, 0xe4, 0xb5, 0x22, 0x15, 0x32, 0x3a, 0x8b, 0x05, 0x73, 0xe3, 0x24,
+	0xe0, 0xd6, 0xf8, 0x80, 0x23, 0xc6, 0xe7, 0x0c, 0xf9, 0x0a, 0x60, 0x40, 0x35, 0xc9, 0x74, 0x54,
+	0xb4, 0xf9, 0xc9, 0x24, 0xb3, 0xd4, 0x3d, 0x83, 0x46, 0xfe, 0xe3, 0x1c, 0xf9, 0xff, 0xa2, 0xb5,
+	0x85, 0x9f, 0x2e, 0x9b, 0x9f, 0x4e, 0x63, 0x9a, 0x86, 0xe2, 0xb0, 0x34, 0xf2, 0xd6, 0x41, 0x3e,
+	0x3b, 0xcb, 0xc5, 0xf0, 0x1b, 0x5a, 0xf3, 0xc1, 0x94, 0xd6, 0x69, 0xcc, 0x3d, 0xa8, 0xa4, 0xe4,
+	0x93, 0x7c, 0x5c, 0xb4, 0x7a, 0x98, 0x9b, 0x36, 0xcf, 0x1a, 0x8e, 0xf6, 0x15, 0x72, 0x00, 0xd5,
+	0x0c, 0x41, 0x25, 0x85, 0x99, 0x1e, 0x65, 0xb0, 0x93, 0xbc, 0xbe, 0x83, 0xff, 0x93, 0x58, 0x51,
+	0xef, 0xf6, 0xdf, 0x6e, 0x86, 0x7e, 0x01, 0xd7, 0x5f, 0x50, 0x9f, 0x79, 0xc9, 0xc7, 0x39, 0xf3,
+	0xed, 0x66, 0x4a, 0xa0, 0x4d, 0x38, 0xd6, 0x09, 0x2c, 0x8d, 0x90, 0xe0, 0x69, 0x5d, 0x17, 0x9e,
+	0x64, 0x2c, 0xa5, 0xd6, 0x50, 0xce, 0xb3, 0xc2, 0x62, 0x28, 0x17, 0x92, 0xdb, 0xe6, 0xa7, 0xd3,
+	0x98, 0xa6, 0xa1, 0x5e, 0xc2, 0xd5, 0x21, 0xd6, 0x45, 0x0a, 0x1d, 0x14, 0xb3, 0xc3, 0x49, 0x59,
+	0xfb, 0x43, 0x09, 0x56, 0xc7, 0x92, 0x3a, 0xf2, 0xc5, 0x14, 0x81, 0x46, 0x88, 0x64, 0xf3, 0xd1,
+	0x39, 0x57, 0xa5, 0x47, 0xfd, 0x15, 0xac, 0x14, 0xf3, 0x3b, 0xf2, 0x9d, 0x22, 0x97, 0x67, 0x72,
+	0xc1, 0x49, 0x07, 0xef, 0xc2, 0x72, 0x11, 0x0d, 0x24, 0x9b, 0x45, 0x91, 0xce, 0x20, 0x8c, 0x93,
+	0xe2, 0x7c, 0x03, 0x8d, 0x3c, 0x1f, 0x2c, 0x46, 0x4a, 0x21, 0x67, 0x9c, 0xe4, 0xfb, 0x25, 0x5c,
+	0x1d, 0xa2, 0x8b, 0xc5, 0xd0, 0x28, 0xe6, 0x94, 0x93, 0xbc, 0x7b, 0x70, 0xbd, 0x80, 0x4e, 0x92,
+	0x8d, 0xe2, 0x08, 0xe3, 0x78, 0xe7, 0xa4, 0x28, 0xbf, 0x84, 0xa5, 0x11, 0x5a, 0x5a, 0xdc, 0x87,
+	0xc6, 0xb1, 0xd7, 0x49, 0x11, 0x0e, 0x81, 0x64, 0x12, 0x90, 0x84, 0x78, 0x30, 0x21, 0x51, 0xe7,
+	0x8b, 0xd1, 0x83, 0xd5, 0xb1, 0x1c, 0xb9, 0xb8, 0x8a, 0x26, 0x51, 0xea, 0x29, 0x70, 0x95, 0xa7,
+	0xcc, 0xc5, 0xb8, 0x2a, 0xa4, 0xd5, 0x93, 0x7c, 0x77, 0x00, 0xb6, 0x51, 0xec, 0xa2, 0xe0, 0xcc,
+	0x8d, 0x87, 0xc7, 0x8e, 0x11, 0x06, 0x06, 0x89, 0xd3, 0xfb, 0x13, 0xed, 0x92, 0x42, 0xdf, 0xfa,
+	0xe7, 0x1c, 0x54, 0xd2, 0x4d, 0xfd, 0x8f, 0xca, 0x7d, 0x00, 0x2a, 0x77, 0x00, 0xd5, 0xcc, 0xbf,
+	0x60, 0xc5, 0xd4, 0x61, 0xf4, 0x6f, 0xb2, 0x29, 0x08, 0x49, 0xa6, 0x8f, 0x8f, 0xf1, 0x3a, 0xf2,
+	0xe7, 0xd6, 0x24, 0xaf, 0x2e, 0xd4, 0xb2, 0x9f, 0xcf, 0xc8, 0xfd, 0x31, 0xbc, 0x62, 0xf8, 0xbb,
+	0x5b, 0x73, 0x7d, 0xb2, 0x61, 0x9a, 0x90, 0x0f, 0x8d, 0xe9, 0xa7, 0x5f, 0x7c, 0xb3, 0x75, 0xc4,
+	0xc4, 0x71, 0xff, 0x50, 0x9e, 0x6f, 0x53, 0x5b, 0x3e, 0x60, 0x91, 0xf9, 0xb5, 0x99, 0x5c, 0xee,
+	0xa6, 0xf2, 0xb4, 0xa9, 0xf6, 0xda, 0x3b, 0x3c, 0x9c, 0x57, 0xe2, 0xe7, 0xff, 0x19, 0x00, 0x93,
+	0x0c, 0x0e, 0x6b, 0x40, 0x21, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexCoordClient is the client API for IndexCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexCoordClient interface {
+	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	BuildIndex(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*BuildIndexResponse, error)
+	GetIndexStates(ctx context.Context, in *GetIndexStatesRequest, opts ...grpc.CallOption) (*GetIndexStatesResponse, error)
+	GetIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RemoveIndex(ctx context.Context, in *RemoveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetShadowIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error)
+	ValidateIndexParams(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	EstimateBuildTime(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*EstimateBuildTimeResponse, error)
+	// the admin rpcs to manage the index tasks and the IndexNodes.
+	ListIndexTasks(ctx context.Context, in *ListIndexTasksRequest, opts ...grpc.CallOption) (*ListIndexTasksResponse, error)
+	CancelIndexTask(ctx context.Context, in *CancelIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelIndexTasksOlderThan(ctx context.Context, in *CancelIndexTasksOlderThanRequest, opts ...grpc.CallOption) (*CancelIndexTasksOlderThanResponse, error)
+	ForceReassignIndexTask(ctx context.Context, in *ForceReassignIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SetIndexTaskPriority(ctx context.Context, in *SetIndexTaskPriorityRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	PauseIndexTask(ctx context.Context, in *PauseIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeIndexTask(ctx context.Context, in *ResumeIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReconcileIndexTasks(ctx context.Context, in *ReconcileIndexTasksRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	PauseIndexBuilder(ctx context.Context, in *PauseIndexBuilderRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeIndexBuilder(ctx context.Context, in *ResumeIndexBuilderRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SetIndexNodeResourceGroup(ctx context.Context, in *SetIndexNodeResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DrainIndexNode(ctx context.Context, in *DrainIndexNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+}
+
+type indexCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIndexCoordClient(cc *grpc.ClientConn) IndexCoordClient {
+	return &indexCoordClient{cc}
+}
+
+func (c *indexCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
+	out := new(internalpb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) BuildIndex(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*BuildIndexResponse, error) {
+	out := new(BuildIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/BuildIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexStates(ctx context.Context, in *GetIndexStatesRequest, opts ...grpc.CallOption) (*GetIndexStatesResponse, error) {
+	out := new(GetIndexStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error) {
+	out := new(GetIndexFilePathsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexFilePaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) RemoveIndex(ctx context.Context, in *RemoveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/RemoveIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetShadowIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error) {
+	out := new(GetIndexFilePathsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetShadowIndexFilePaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ValidateIndexParams(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ValidateIndexParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) EstimateBuildTime(ctx context.Context, in *BuildIndexRequest, opts ...grpc.CallOption) (*EstimateBuildTimeResponse, error) {
+	out := new(EstimateBuildTimeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/EstimateBuildTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ListIndexTasks(ctx context.Context, in *ListIndexTasksRequest, opts ...grpc.CallOption) (*ListIndexTasksResponse, error) {
+	out := new(ListIndexTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ListIndexTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CancelIndexTask(ctx context.Context, in *CancelIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CancelIndexTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CancelIndexTasksOlderThan(ctx context.Context, in *CancelIndexTasksOlderThanRequest, opts ...grpc.CallOption) (*CancelIndexTasksOlderThanResponse, error) {
+	out := new(CancelIndexTasksOlderThanResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CancelIndexTasksOlderThan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ForceReassignIndexTask(ctx context.Context, in *ForceReassignIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ForceReassignIndexTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) SetIndexTaskPriority(ctx context.Context, in *SetIndexTaskPriorityRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/SetIndexTaskPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) PauseIndexTask(ctx context.Context, in *PauseIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/PauseIndexTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ResumeIndexTask(ctx context.Context, in *ResumeIndexTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ResumeIndexTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ReconcileIndexTasks(ctx context.Context, in *ReconcileIndexTasksRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ReconcileIndexTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) PauseIndexBuilder(ctx context.Context, in *PauseIndexBuilderRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/PauseIndexBuilder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ResumeIndexBuilder(ctx context.Context, in *ResumeIndexBuilderRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ResumeIndexBuilder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) SetIndexNodeResourceGroup(ctx context.Context, in *SetIndexNodeResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/SetIndexNodeResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) DrainIndexNode(ctx context.Context, in *DrainIndexNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DrainIndexNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 // IndexCoordServer is the server API for IndexCoord service.
@@ -1170,6 +2408,22 @@ type IndexCoordServer interface {
 	GetIndexFilePaths(context.Context, *GetIndexFilePathsRequest) (*GetIndexFilePathsResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	RemoveIndex(context.Context, *RemoveIndexRequest) (*commonpb.Status, error)
+	GetShadowIndexFilePaths(context.Context, *GetIndexFilePathsRequest) (*GetIndexFilePathsResponse, error)
+	ValidateIndexParams(context.Context, *BuildIndexRequest) (*commonpb.Status, error)
+	EstimateBuildTime(context.Context, *BuildIndexRequest) (*EstimateBuildTimeResponse, error)
+	// the admin rpcs to manage the index tasks and the IndexNodes.
+	ListIndexTasks(context.Context, *ListIndexTasksRequest) (*ListIndexTasksResponse, error)
+	CancelIndexTask(context.Context, *CancelIndexTaskRequest) (*commonpb.Status, error)
+	CancelIndexTasksOlderThan(context.Context, *CancelIndexTasksOlderThanRequest) (*CancelIndexTasksOlderThanResponse, error)
+	ForceReassignIndexTask(context.Context, *ForceReassignIndexTaskRequest) (*commonpb.Status, error)
+	SetIndexTaskPriority(context.Context, *SetIndexTaskPriorityRequest) (*commonpb.Status, error)
+	PauseIndexTask(context.Context, *PauseIndexTaskRequest) (*commonpb.Status, error)
+	ResumeIndexTask(context.Context, *ResumeIndexTaskRequest) (*commonpb.Status, error)
+	ReconcileIndexTasks(context.Context, *ReconcileIndexTasksRequest) (*commonpb.Status, error)
+	PauseIndexBuilder(context.Context, *PauseIndexBuilderRequest) (*commonpb.Status, error)
+	ResumeIndexBuilder(context.Context, *ResumeIndexBuilderRequest) (*commonpb.Status, error)
+	SetIndexNodeResourceGroup(context.Context, *SetIndexNodeResourceGroupRequest) (*commonpb.Status, error)
+	DrainIndexNode(context.Context, *DrainIndexNodeRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1196,11 +2450,56 @@ func (*UnimplementedIndexCoordServer) GetIndexStates(ctx context.Context, req *G
 func (*UnimplementedIndexCoordServer) GetIndexFilePaths(ctx context.Context, req *GetIndexFilePathsRequest) (*GetIndexFilePathsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIndexFilePaths not implemented")
 }
-func (*UnimplementedIndexCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+func (*UnimplementedIndexCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+}
+func (*UnimplementedIndexCoordServer) RemoveIndex(ctx context.Context, req *RemoveIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIndex not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetShadowIndexFilePaths(ctx context.Context, req *GetIndexFilePathsRequest) (*GetIndexFilePathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShadowIndexFilePaths not implemented")
+}
+func (*UnimplementedIndexCoordServer) ValidateIndexParams(ctx context.Context, req *BuildIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateIndexParams not implemented")
+}
+func (*UnimplementedIndexCoordServer) EstimateBuildTime(ctx context.Context, req *BuildIndexRequest) (*EstimateBuildTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBuildTime not implemented")
+}
+func (*UnimplementedIndexCoordServer) ListIndexTasks(ctx context.Context, req *ListIndexTasksRequest) (*ListIndexTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexTasks not implemented")
+}
+func (*UnimplementedIndexCoordServer) CancelIndexTask(ctx context.Context, req *CancelIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexTask not implemented")
+}
+func (*UnimplementedIndexCoordServer) CancelIndexTasksOlderThan(ctx context.Context, req *CancelIndexTasksOlderThanRequest) (*CancelIndexTasksOlderThanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexTasksOlderThan not implemented")
+}
+func (*UnimplementedIndexCoordServer) ForceReassignIndexTask(ctx context.Context, req *ForceReassignIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceReassignIndexTask not implemented")
+}
+func (*UnimplementedIndexCoordServer) SetIndexTaskPriority(ctx context.Context, req *SetIndexTaskPriorityRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexTaskPriority not implemented")
+}
+func (*UnimplementedIndexCoordServer) PauseIndexTask(ctx context.Context, req *PauseIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseIndexTask not implemented")
+}
+func (*UnimplementedIndexCoordServer) ResumeIndexTask(ctx context.Context, req *ResumeIndexTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeIndexTask not implemented")
+}
+func (*UnimplementedIndexCoordServer) ReconcileIndexTasks(ctx context.Context, req *ReconcileIndexTasksRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileIndexTasks not implemented")
+}
+func (*UnimplementedIndexCoordServer) PauseIndexBuilder(ctx context.Context, req *PauseIndexBuilderRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseIndexBuilder not implemented")
+}
+func (*UnimplementedIndexCoordServer) ResumeIndexBuilder(ctx context.Context, req *ResumeIndexBuilderRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeIndexBuilder not implemented")
 }
-func (*UnimplementedIndexCoordServer) RemoveIndex(ctx context.Context, req *RemoveIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveIndex not implemented")
+func (*UnimplementedIndexCoordServer) SetIndexNodeResourceGroup(ctx context.Context, req *SetIndexNodeResourceGroupRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexNodeResourceGroup not implemented")
+}
+func (*UnimplementedIndexCoordServer) DrainIndexNode(ctx context.Context, req *DrainIndexNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainIndexNode not implemented")
 }
 func (*UnimplementedIndexCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
@@ -1354,6 +2653,276 @@ func _IndexCoord_RemoveIndex_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexCoord_GetShadowIndexFilePaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexFilePathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).GetShadowIndexFilePaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/GetShadowIndexFilePaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).GetShadowIndexFilePaths(ctx, req.(*GetIndexFilePathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ValidateIndexParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ValidateIndexParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ValidateIndexParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ValidateIndexParams(ctx, req.(*BuildIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_EstimateBuildTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).EstimateBuildTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/EstimateBuildTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).EstimateBuildTime(ctx, req.(*BuildIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ListIndexTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ListIndexTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ListIndexTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ListIndexTasks(ctx, req.(*ListIndexTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_CancelIndexTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CancelIndexTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CancelIndexTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CancelIndexTask(ctx, req.(*CancelIndexTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_CancelIndexTasksOlderThan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexTasksOlderThanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CancelIndexTasksOlderThan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CancelIndexTasksOlderThan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CancelIndexTasksOlderThan(ctx, req.(*CancelIndexTasksOlderThanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ForceReassignIndexTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceReassignIndexTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ForceReassignIndexTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ForceReassignIndexTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ForceReassignIndexTask(ctx, req.(*ForceReassignIndexTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_SetIndexTaskPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIndexTaskPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).SetIndexTaskPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/SetIndexTaskPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).SetIndexTaskPriority(ctx, req.(*SetIndexTaskPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_PauseIndexTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseIndexTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).PauseIndexTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/PauseIndexTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).PauseIndexTask(ctx, req.(*PauseIndexTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ResumeIndexTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeIndexTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ResumeIndexTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ResumeIndexTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ResumeIndexTask(ctx, req.(*ResumeIndexTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ReconcileIndexTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileIndexTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ReconcileIndexTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ReconcileIndexTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ReconcileIndexTasks(ctx, req.(*ReconcileIndexTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_PauseIndexBuilder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseIndexBuilderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).PauseIndexBuilder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/PauseIndexBuilder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).PauseIndexBuilder(ctx, req.(*PauseIndexBuilderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ResumeIndexBuilder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeIndexBuilderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ResumeIndexBuilder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ResumeIndexBuilder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ResumeIndexBuilder(ctx, req.(*ResumeIndexBuilderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_SetIndexNodeResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIndexNodeResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).SetIndexNodeResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/SetIndexNodeResourceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).SetIndexNodeResourceGroup(ctx, req.(*SetIndexNodeResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_DrainIndexNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainIndexNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).DrainIndexNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/DrainIndexNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).DrainIndexNode(ctx, req.(*DrainIndexNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -1408,6 +2977,66 @@ var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveIndex",
 			Handler:    _IndexCoord_RemoveIndex_Handler,
 		},
+		{
+			MethodName: "GetShadowIndexFilePaths",
+			Handler:    _IndexCoord_GetShadowIndexFilePaths_Handler,
+		},
+		{
+			MethodName: "ValidateIndexParams",
+			Handler:    _IndexCoord_ValidateIndexParams_Handler,
+		},
+		{
+			MethodName: "EstimateBuildTime",
+			Handler:    _IndexCoord_EstimateBuildTime_Handler,
+		},
+		{
+			MethodName: "ListIndexTasks",
+			Handler:    _IndexCoord_ListIndexTasks_Handler,
+		},
+		{
+			MethodName: "CancelIndexTask",
+			Handler:    _IndexCoord_CancelIndexTask_Handler,
+		},
+		{
+			MethodName: "CancelIndexTasksOlderThan",
+			Handler:    _IndexCoord_CancelIndexTasksOlderThan_Handler,
+		},
+		{
+			MethodName: "ForceReassignIndexTask",
+			Handler:    _IndexCoord_ForceReassignIndexTask_Handler,
+		},
+		{
+			MethodName: "SetIndexTaskPriority",
+			Handler:    _IndexCoord_SetIndexTaskPriority_Handler,
+		},
+		{
+			MethodName: "PauseIndexTask",
+			Handler:    _IndexCoord_PauseIndexTask_Handler,
+		},
+		{
+			MethodName: "ResumeIndexTask",
+			Handler:    _IndexCoord_ResumeIndexTask_Handler,
+		},
+		{
+			MethodName: "ReconcileIndexTasks",
+			Handler:    _IndexCoord_ReconcileIndexTasks_Handler,
+		},
+		{
+			MethodName: "PauseIndexBuilder",
+			Handler:    _IndexCoord_PauseIndexBuilder_Handler,
+		},
+		{
+			MethodName: "ResumeIndexBuilder",
+			Handler:    _IndexCoord_ResumeIndexBuilder_Handler,
+		},
+		{
+			MethodName: "SetIndexNodeResourceGroup",
+			Handler:    _IndexCoord_SetIndexNodeResourceGroup_Handler,
+		},
+		{
+			MethodName: "DrainIndexNode",
+			Handler:    _IndexCoord_DrainIndexNode_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _IndexCoord_GetMetrics_Handler,
@@ -1425,6 +3054,7 @@ type IndexNodeClient interface {
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelIndex(ctx context.Context, in *CancelIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetTaskSlots(ctx context.Context, in *GetTaskSlotsRequest, opts ...grpc.CallOption) (*GetTaskSlotsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
@@ -1474,6 +3104,15 @@ func (c *indexNodeClient) CreateIndex(ctx context.Context, in *CreateIndexReques
 	return out, nil
 }
 
+func (c *indexNodeClient) CancelIndex(ctx context.Context, in *CancelIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/CancelIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexNodeClient) GetTaskSlots(ctx context.Context, in *GetTaskSlotsRequest, opts ...grpc.CallOption) (*GetTaskSlotsResponse, error) {
 	out := new(GetTaskSlotsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/GetTaskSlots", in, out, opts...)
@@ -1498,6 +3137,7 @@ type IndexNodeServer interface {
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
+	CancelIndex(context.Context, *CancelIndexRequest) (*commonpb.Status, error)
 	GetTaskSlots(context.Context, *GetTaskSlotsRequest) (*GetTaskSlotsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -1519,6 +3159,9 @@ func (*UnimplementedIndexNodeServer) GetStatisticsChannel(ctx context.Context, r
 func (*UnimplementedIndexNodeServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
+func (*UnimplementedIndexNodeServer) CancelIndex(ctx context.Context, req *CancelIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndex not implemented")
+}
 func (*UnimplementedIndexNodeServer) GetTaskSlots(ctx context.Context, req *GetTaskSlotsRequest) (*GetTaskSlotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskSlots not implemented")
 }
@@ -1602,6 +3245,24 @@ func _IndexNode_CreateIndex_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_CancelIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).CancelIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/CancelIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).CancelIndex(ctx, req.(*CancelIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexNode_GetTaskSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTaskSlotsRequest)
 	if err := dec(in); err != nil {
@@ -1658,6 +3319,10 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateIndex",
 			Handler:    _IndexNode_CreateIndex_Handler,
 		},
+		{
+			MethodName: "CancelIndex",
+			Handler:    _IndexNode_CancelIndex_Handler,
+		},
 		{
 			MethodName: "GetTaskSlots",
 			Handler:    _IndexNode_GetTaskSlots_Handler,
@@ -469,6 +469,20 @@ func (m *importManager) appendTaskSegments(taskID int64, segIDs []int64) error {
 	return nil
 }
 
+// isImportedSegment returns whether the segment is created by a working import task.
+func (m *importManager) isImportedSegment(segID int64) bool {
+	m.workingLock.RLock()
+	defer m.workingLock.RUnlock()
+	for _, t := range m.workingTasks {
+		for _, id := range t.GetState().GetSegments() {
+			if id == segID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // getTaskState looks for task with the given ID and returns its import state.
 func (m *importManager) getTaskState(tID int64) *milvuspb.GetImportStateResponse {
 	resp := &milvuspb.GetImportStateResponse{
@@ -382,6 +382,9 @@ func TestImportManager_TaskState(t *testing.T) {
 
 	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, nil)
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
+	assert.NoError(t, mgr.appendTaskSegments(2, []int64{10}))
+	assert.True(t, mgr.isImportedSegment(10))
+	assert.False(t, mgr.isImportedSegment(11))
 
 	state := &rootcoordpb.ImportResult{
 		TaskId: 10000,
@@ -133,7 +133,7 @@ type Core struct {
 	CallGetRecoveryInfoService    func(ctx context.Context, collID, partID UniqueID) ([]*datapb.SegmentBinlogs, error)
 
 	//call index builder's client to build index, return build id or get index state.
-	CallBuildIndexService     func(ctx context.Context, segID UniqueID, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, numRows int64) (typeutil.UniqueID, error)
+	CallBuildIndexService     func(ctx context.Context, req *indexpb.BuildIndexRequest) (typeutil.UniqueID, error)
 	CallDropIndexService      func(ctx context.Context, indexID typeutil.UniqueID) error
 	CallRemoveIndexService    func(ctx context.Context, buildIDs []UniqueID) error
 	CallGetIndexStatesService func(ctx context.Context, IndexBuildIDs []int64) ([]*indexpb.IndexInfo, error)
@@ -449,7 +449,7 @@ func (c *Core) createIndexForSegment(ctx context.Context, collID, partID, segID
 			EnableIndex:  false,
 			CreateTime:   createTS,
 		}
-		buildID, err := c.BuildIndex(ctx, segID, numRows, binlogs, field, &indexMeta, false)
+		buildID, err := c.BuildIndex(ctx, collID, segID, numRows, binlogs, field, &indexMeta, nil, false)
 		if err != nil {
 			log.Debug("build index failed",
 				zap.Int64("segmentID", segID),
@@ -838,23 +838,14 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 		}
 	}()
 
-	c.CallBuildIndexService = func(ctx context.Context, segID UniqueID, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, numRows int64) (retID typeutil.UniqueID, retErr error) {
+	c.CallBuildIndexService = func(ctx context.Context, req *indexpb.BuildIndexRequest) (retID typeutil.UniqueID, retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
 				retErr = fmt.Errorf("build index panic, msg = %v", err)
 			}
 		}()
 		<-initCh
-		rsp, err := s.BuildIndex(ctx, &indexpb.BuildIndexRequest{
-			DataPaths:   binlog,
-			TypeParams:  field.TypeParams,
-			IndexParams: idxInfo.IndexParams,
-			IndexID:     idxInfo.IndexID,
-			IndexName:   idxInfo.IndexName,
-			NumRows:     numRows,
-			FieldSchema: field,
-			SegmentID:   segID,
-		})
+		rsp, err := s.BuildIndex(ctx, req)
 		if err != nil {
 			return retID, err
 		}
@@ -1018,8 +1009,9 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 	return nil
 }
 
-// BuildIndex will check row num and call build index service
-func (c *Core) BuildIndex(ctx context.Context, segID UniqueID, numRows int64, binlogs []*datapb.FieldBinlog, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, isFlush bool) (typeutil.UniqueID, error) {
+// BuildIndex will check row num and call build index service, buildOpts carries the options to schedule the build set
+// by CreateIndex, it is nil for the segments flushed after the index is created.
+func (c *Core) BuildIndex(ctx context.Context, collID, segID UniqueID, numRows int64, binlogs []*datapb.FieldBinlog, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, buildOpts *indexpb.BuildIndexRequest, isFlush bool) (typeutil.UniqueID, error) {
 	log.Debug("start build index", zap.String("index name", idxInfo.IndexName),
 		zap.String("field name", field.Name), zap.Int64("segment id", segID))
 	sp, ctx := trace.StartSpanFromContext(ctx)
@@ -1042,12 +1034,33 @@ func (c *Core) BuildIndex(ctx context.Context, segID UniqueID, numRows int64, bi
 				break
 			}
 		}
-		bldID, err = c.CallBuildIndexService(ctx, segID, binLogs, field, idxInfo, numRows)
+		req := &indexpb.BuildIndexRequest{
+			DataPaths:     binLogs,
+			TypeParams:    field.TypeParams,
+			IndexParams:   idxInfo.IndexParams,
+			IndexID:       idxInfo.IndexID,
+			IndexName:     idxInfo.IndexName,
+			NumRows:       numRows,
+			FieldSchema:   field,
+			SegmentID:     segID,
+			CollectionID:  collID,
+			SegmentOrigin: c.getSegmentOrigin(segID),
+		}
+		copyIndexBuildOptions(req, buildOpts)
+		bldID, err = c.CallBuildIndexService(ctx, req)
 	}
 
 	return bldID, err
 }
 
+// getSegmentOrigin returns how the segment is created, the segments of the working import tasks are imported.
+func (c *Core) getSegmentOrigin(segID UniqueID) string {
+	if c.importManager != nil && c.importManager.isImportedSegment(segID) {
+		return common.SegmentOriginImported
+	}
+	return common.SegmentOriginFlushed
+}
+
 // ExpireMetaCache will call invalidate collection meta cache
 func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, collectionID UniqueID, ts typeutil.Timestamp) error {
 	// if collectionID is specified, invalidate all the collection meta cache with the specified collectionID and return
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/rand"
 	"path"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -1311,6 +1312,23 @@ func TestRootCoord_Base(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, Params.CommonCfg.DefaultIndexName, idxMeta.IndexName)
 
+		// the options to schedule the builds are not saved with the index, so it is the same index
+		req.ExtraParams = append(req.ExtraParams,
+			&commonpb.KeyValuePair{Key: common.IndexBuildPriorityKey, Value: "2"},
+			&commonpb.KeyValuePair{Key: common.IndexPinnedNodeKey, Value: "3"},
+			&commonpb.KeyValuePair{Key: common.IndexResourceGroupKey, Value: "g1"})
+		rsp, err = core.CreateIndex(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, 6*3, len(im.getFileArray()))
+		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(collMeta.FieldIndexes))
+		idxMeta, err = core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: "ik2", Value: "iv2"}}, idxMeta.IndexParams)
+
 		req.FieldName = "no field"
 		rsp, err = core.CreateIndex(ctx, req)
 		assert.NoError(t, err)
@@ -3078,7 +3096,7 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.Error(t, err)
 
-	c.CallBuildIndexService = func(ctx context.Context, segID UniqueID, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, numRows int64) (typeutil.UniqueID, error) {
+	c.CallBuildIndexService = func(ctx context.Context, req *indexpb.BuildIndexRequest) (typeutil.UniqueID, error) {
 		return 0, nil
 	}
 	err = c.checkInit()
@@ -3281,20 +3299,45 @@ func TestCheckFlushedSegments(t *testing.T) {
 		core.MetaTable.indexID2Meta[indexID] = etcdpb.IndexInfo{
 			IndexID: indexID,
 		}
-		core.CallBuildIndexService = func(_ context.Context, segID UniqueID, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo, numRows int64) (int64, error) {
-			assert.Equal(t, fieldID, field.FieldID)
-			assert.Equal(t, indexID, idx.IndexID)
+		core.CallBuildIndexService = func(_ context.Context, req *indexpb.BuildIndexRequest) (int64, error) {
+			assert.Equal(t, fieldID, req.GetFieldSchema().GetFieldID())
+			assert.Equal(t, indexID, req.GetIndexID())
+			assert.Equal(t, collID, req.GetCollectionID())
+			assert.Equal(t, common.SegmentOriginFlushed, req.GetSegmentOrigin())
 			return -1, errors.New("build index build")
 		}
 
 		core.checkFlushedSegments(ctx)
 
 		var indexBuildID int64 = 10001
-		core.CallBuildIndexService = func(_ context.Context, segID UniqueID, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo, numRows int64) (int64, error) {
+		core.CallBuildIndexService = func(_ context.Context, req *indexpb.BuildIndexRequest) (int64, error) {
 			return indexBuildID, nil
 		}
 		core.checkFlushedSegments(core.ctx)
 
+		// the deadline of CreateIndex has passed, the segment flushed later is still built
+		buildOpts := &indexpb.BuildIndexRequest{}
+		err = SetIndexBuildOptions(buildOpts, []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "IVF_FLAT"},
+			{Key: common.IndexBuildDeadlineKey, Value: strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)},
+		})
+		assert.NoError(t, err)
+		core.MetaTable.indexID2Meta[indexID] = etcdpb.IndexInfo{
+			IndexID:     indexID,
+			IndexParams: buildOpts.GetIndexParams(),
+		}
+		var flushedSegID int64 = 1002
+		built := false
+		core.CallBuildIndexService = func(_ context.Context, req *indexpb.BuildIndexRequest) (int64, error) {
+			assert.Equal(t, flushedSegID, req.GetSegmentID())
+			assert.Equal(t, buildOpts.GetIndexParams(), req.GetIndexParams())
+			assert.Equal(t, int64(0), req.GetBuildDeadline())
+			built = true
+			return indexBuildID + 1, nil
+		}
+		err = core.createIndexForSegment(ctx, collID, partID, flushedSegID, Params.RootCoordCfg.MinSegmentSizeToEnableIndex, nil)
+		assert.NoError(t, err)
+		assert.True(t, built)
 	})
 	wg.Wait()
 	err = core.Stop()
@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
@@ -947,6 +948,13 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	if len(indexName) <= 0 {
 		indexName = Params.CommonCfg.DefaultIndexName //TODO, get name from request
 	}
+	// the options to schedule the builds are checked before the index is created, the invalid ones fail every build.
+	// They only apply to the builds started by this request and are not saved with the index, so the segments flushed
+	// later are built with the default options.
+	buildOpts := &indexpb.BuildIndexRequest{}
+	if err := SetIndexBuildOptions(buildOpts, t.Req.GetExtraParams()); err != nil {
+		return err
+	}
 	indexID, _, err := t.core.IDAllocator(1)
 	log.Debug("RootCoord CreateIndexReqTask", zap.Any("indexID", indexID), zap.Error(err))
 	if err != nil {
@@ -959,7 +967,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	idxInfo := &etcdpb.IndexInfo{
 		IndexName:   indexName,
 		IndexID:     indexID,
-		IndexParams: t.Req.ExtraParams,
+		IndexParams: buildOpts.GetIndexParams(),
 		CreateTime:  createTS,
 	}
 	log.Info("create index for collection",
@@ -1001,7 +1009,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 			EnableIndex:  false,
 			CreateTime:   createTS,
 		}
-		info.BuildID, err = t.core.BuildIndex(ctx, segID, segID2Binlog[segID].GetNumOfRows(), segID2Binlog[segID].GetFieldBinlogs(), &field, idxInfo, false)
+		info.BuildID, err = t.core.BuildIndex(ctx, collectionID, segID, segID2Binlog[segID].GetNumOfRows(), segID2Binlog[segID].GetFieldBinlogs(), &field, idxInfo, buildOpts, false)
 		if err != nil {
 			return err
 		}
@@ -18,13 +18,18 @@ package rootcoord
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -76,6 +81,43 @@ func GetFieldSchemaByIndexID(coll *etcdpb.CollectionInfo, idxID typeutil.UniqueI
 	return GetFieldSchemaByID(coll, fieldID)
 }
 
+// SetIndexBuildOptions sets the params to build the index as the index params of the request, and moves the options to
+// schedule the builds, such as the resource group, to the fields of the request. The invalid options and the reserved
+// keys which are set by the coordinators are rejected.
+func SetIndexBuildOptions(req *indexpb.BuildIndexRequest, indexParams []*commonpb.KeyValuePair) error {
+	req.IndexParams = make([]*commonpb.KeyValuePair, 0, len(indexParams))
+	for _, kv := range indexParams {
+		var err error
+		switch kv.GetKey() {
+		case common.IndexResourceGroupKey:
+			req.ResourceGroup = kv.GetValue()
+		case common.IndexBuildPriorityKey:
+			req.BuildPriority, err = strconv.ParseInt(kv.GetValue(), 10, 64)
+		case common.IndexBuildDeadlineKey:
+			req.BuildDeadline, err = strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err == nil && req.BuildDeadline <= 0 {
+				err = errors.New("deadline is not positive")
+			}
+		case common.IndexShadowBuildKey:
+			req.ShadowBuild, err = strconv.ParseBool(kv.GetValue())
+		case common.IndexPinnedNodeKey:
+			req.PinnedNodeID, err = strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err == nil && req.PinnedNodeID <= 0 {
+				err = errors.New("node id is not positive")
+			}
+		default:
+			if funcutil.SliceContain(common.ReservedIndexParamKeys, kv.GetKey()) {
+				return fmt.Errorf("index param %s is reserved", kv.GetKey())
+			}
+			req.IndexParams = append(req.IndexParams, kv)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid index param %s = %s: %w", kv.GetKey(), kv.GetValue(), err)
+		}
+	}
+	return nil
+}
+
 // EncodeDdOperation serialize DdOperation into string
 func EncodeDdOperation(m proto.Message, ddType string) (string, error) {
 	mByte, err := proto.Marshal(m)
@@ -127,3 +169,15 @@ func DecodeMsgPositions(str string, msgPositions *[]*msgstream.MsgPosition) erro
 	}
 	return json.Unmarshal([]byte(str), msgPositions)
 }
+
+// copyIndexBuildOptions copies the options to schedule the build from opts to req, nothing is copied if opts is nil.
+func copyIndexBuildOptions(req *indexpb.BuildIndexRequest, opts *indexpb.BuildIndexRequest) {
+	if opts == nil {
+		return
+	}
+	req.ResourceGroup = opts.GetResourceGroup()
+	req.BuildPriority = opts.GetBuildPriority()
+	req.BuildDeadline = opts.GetBuildDeadline()
+	req.ShadowBuild = opts.GetShadowBuild()
+	req.PinnedNodeID = opts.GetPinnedNodeID()
+}
@@ -19,9 +19,11 @@ package rootcoord
 import (
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
 )
@@ -132,3 +134,35 @@ func Test_DecodeMsgPositions(t *testing.T) {
 	err = DecodeMsgPositions("null", &mpOut)
 	assert.Nil(t, err)
 }
+
+func Test_SetIndexBuildOptions(t *testing.T) {
+	indexParams := []*commonpb.KeyValuePair{
+		{Key: "index_type", Value: "HNSW"},
+		{Key: common.IndexResourceGroupKey, Value: "g1"},
+		{Key: common.IndexBuildPriorityKey, Value: "2"},
+		{Key: common.IndexBuildDeadlineKey, Value: "1700000000"},
+		{Key: common.IndexShadowBuildKey, Value: "true"},
+		{Key: common.IndexPinnedNodeKey, Value: "3"},
+	}
+	req := &indexpb.BuildIndexRequest{}
+	err := SetIndexBuildOptions(req, indexParams)
+	assert.Nil(t, err)
+	assert.Equal(t, indexParams[:1], req.IndexParams)
+	assert.Equal(t, "g1", req.ResourceGroup)
+	assert.Equal(t, int64(2), req.BuildPriority)
+	assert.Equal(t, int64(1700000000), req.BuildDeadline)
+	assert.True(t, req.ShadowBuild)
+	assert.Equal(t, int64(3), req.PinnedNodeID)
+
+	for _, kv := range []*commonpb.KeyValuePair{
+		{Key: common.IndexBuildPriorityKey, Value: "high"},
+		{Key: common.IndexBuildDeadlineKey, Value: "-1"},
+		{Key: common.IndexShadowBuildKey, Value: "invalid"},
+		{Key: common.IndexPinnedNodeKey, Value: "0"},
+		{Key: "collection_id", Value: "1"},
+		{Key: "segment_origin", Value: common.SegmentOriginImported},
+	} {
+		err = SetIndexBuildOptions(&indexpb.BuildIndexRequest{}, []*commonpb.KeyValuePair{kv})
+		assert.NotNil(t, err, kv.Key)
+	}
+}
@@ -311,6 +311,8 @@ type IndexNode interface {
 	// CreateIndex receives request from IndexCoordinator to build an index.
 	// Index building is asynchronous, so when an index building request comes, IndexNode records the task and returns.
 	CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
+	// CancelIndex stops the build of the index task on IndexNode, the task is abandoned without saving the index.
+	CancelIndex(ctx context.Context, req *indexpb.CancelIndexRequest) (*commonpb.Status, error)
 	GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error)
 
 	// GetMetrics gets the metrics about IndexNode.
@@ -356,6 +358,51 @@ type IndexCoord interface {
 
 	// RemoveIndex removes the index on specify segments.
 	RemoveIndex(ctx context.Context, req *indexpb.RemoveIndexRequest) (*commonpb.Status, error)
+
+	// GetShadowIndexFilePaths gets the index files of the shadow builds, which are never loaded by the queries.
+	GetShadowIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error)
+
+	// ValidateIndexParams checks whether the index can be built with the params of the request without building it.
+	ValidateIndexParams(ctx context.Context, req *indexpb.BuildIndexRequest) (*commonpb.Status, error)
+
+	// EstimateBuildTime predicts the time to build the index of the request by the durations of the finished builds.
+	EstimateBuildTime(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.EstimateBuildTimeResponse, error)
+
+	// ListIndexTasks lists the states of the index tasks tracked by IndexCoord.
+	ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error)
+
+	// CancelIndexTask cancels the build of a segment index without dropping the whole index.
+	CancelIndexTask(ctx context.Context, req *indexpb.CancelIndexTaskRequest) (*commonpb.Status, error)
+
+	// CancelIndexTasksOlderThan cancels the builds enqueued before the cutoff, it is only allowed for the root user.
+	CancelIndexTasksOlderThan(ctx context.Context, req *indexpb.CancelIndexTasksOlderThanRequest) (*indexpb.CancelIndexTasksOlderThanResponse, error)
+
+	// ForceReassignIndexTask reassigns the unfinished build of a segment index to another IndexNode.
+	ForceReassignIndexTask(ctx context.Context, req *indexpb.ForceReassignIndexTaskRequest) (*commonpb.Status, error)
+
+	// SetIndexTaskPriority changes the priority of a build which has not been assigned.
+	SetIndexTaskPriority(ctx context.Context, req *indexpb.SetIndexTaskPriorityRequest) (*commonpb.Status, error)
+
+	// PauseIndexTask holds the unfinished build of a segment index until it is resumed.
+	PauseIndexTask(ctx context.Context, req *indexpb.PauseIndexTaskRequest) (*commonpb.Status, error)
+
+	// ResumeIndexTask continues the build paused by PauseIndexTask.
+	ResumeIndexTask(ctx context.Context, req *indexpb.ResumeIndexTaskRequest) (*commonpb.Status, error)
+
+	// ReconcileIndexTasks reloads the index meta and reconciles the index tasks with it.
+	ReconcileIndexTasks(ctx context.Context, req *indexpb.ReconcileIndexTasksRequest) (*commonpb.Status, error)
+
+	// PauseIndexBuilder stops assigning index tasks to IndexNodes.
+	PauseIndexBuilder(ctx context.Context, req *indexpb.PauseIndexBuilderRequest) (*commonpb.Status, error)
+
+	// ResumeIndexBuilder continues assigning index tasks to IndexNodes.
+	ResumeIndexBuilder(ctx context.Context, req *indexpb.ResumeIndexBuilderRequest) (*commonpb.Status, error)
+
+	// SetIndexNodeResourceGroup puts the IndexNode into a resource group.
+	SetIndexNodeResourceGroup(ctx context.Context, req *indexpb.SetIndexNodeResourceGroupRequest) (*commonpb.Status, error)
+
+	// DrainIndexNode moves the builds off the IndexNode gradually for its planned decommission.
+	DrainIndexNode(ctx context.Context, req *indexpb.DrainIndexNodeRequest) (*commonpb.Status, error)
 }
 
 // IndexCoordComponent is used by grpc server of IndexCoord
@@ -25,6 +25,15 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// IndexTaskMetrics means users request for the index tasks which are scheduled by IndexCoord.
+	IndexTaskMetrics = "index_tasks"
+
+	// IndexBuilderDumpMetrics means users request for the whole scheduling state of the index builder of IndexCoord.
+	IndexBuilderDumpMetrics = "index_builder_dump"
+
+	// CompletedIndexTaskMetrics means users request for the index tasks which are completed by IndexCoord recently.
+	CompletedIndexTaskMetrics = "completed_index_tasks"
 )
 
 // ParseMetricType returns the metric type of req
@@ -149,6 +149,65 @@ type IndexCoordInfos struct {
 	SystemConfigurations IndexCoordConfiguration `json:"system_configurations"`
 }
 
+// IndexTaskInfo records the state of an index task which is scheduled by IndexCoord.
+type IndexTaskInfo struct {
+	BuildID     int64  `json:"build_id"`
+	State       string `json:"state"`
+	NodeID      int64  `json:"node_id"`
+	EnqueueTime string `json:"enqueue_time"`
+	RetryCount  int    `json:"retry_count"`
+	// Priority decides the order of assignment with the default priority ordering policy: the tasks with higher
+	// priority are assigned first, and the tasks with the same priority are assigned in the order they are enqueued.
+	// The rebuilds triggered by users go ahead of the retries, and the retries go ahead of the background builds. The
+	// other ordering policies ignore it.
+	Priority int `json:"priority"`
+	// Progress is the fraction of the rows built by the IndexNode, it is omitted if it is not reported, such as by the
+	// IndexNodes of older versions.
+	Progress *float32 `json:"progress,omitempty"`
+	// BlockedReason is the reason why the task waiting to be assigned is not assigned last time, such as there is no
+	// IndexNode online or all IndexNodes are busy.
+	BlockedReason string `json:"blocked_reason,omitempty"`
+	// The index which the task builds, IndexParams is the summary of the build params except the index type.
+	IndexID     int64  `json:"index_id"`
+	IndexName   string `json:"index_name"`
+	IndexType   string `json:"index_type"`
+	IndexParams string `json:"index_params"`
+	SegmentID   int64  `json:"segment_id"`
+	// Shadow means the task is a shadow build to evaluate new index params, it never replaces the active index.
+	Shadow bool `json:"shadow,omitempty"`
+	// SegmentOrigin is how the segment is created, such as flushed or imported by bulk insert.
+	SegmentOrigin string `json:"segment_origin"`
+	// FailReason is the reason of the last failure, it is kept along with the failed task until its retention expires.
+	FailReason string `json:"fail_reason,omitempty"`
+	// LastError is the error which the processing of the task failed on last time, such as the failure to assign the
+	// task or to update meta, along with the time of the failure. It is kept after the task recovers.
+	LastError     string `json:"last_error,omitempty"`
+	LastErrorTime string `json:"last_error_time,omitempty"`
+}
+
+// IndexTaskInfos implements ComponentInfos
+type IndexTaskInfos struct {
+	Name  string          `json:"name"`
+	Tasks []IndexTaskInfo `json:"tasks"`
+}
+
+// CompletedIndexTaskInfo records the summary of an index task which is completed by IndexCoord.
+type CompletedIndexTaskInfo struct {
+	BuildID int64 `json:"build_id"`
+	// NodeID is the IndexNode which built the index, zero if the task failed before it was assigned.
+	NodeID int64 `json:"node_id"`
+	// Duration is the time from the task was scheduled to it was completed.
+	Duration     string `json:"duration"`
+	Result       string `json:"result"`
+	CompleteTime string `json:"complete_time"`
+}
+
+// CompletedIndexTaskInfos implements ComponentInfos
+type CompletedIndexTaskInfos struct {
+	Name  string                   `json:"name"`
+	Tasks []CompletedIndexTaskInfo `json:"tasks"`
+}
+
 // DataNodeConfiguration records the configuration of DataNode.
 type DataNodeConfiguration struct {
 	FlushInsertBufferSize int64 `json:"flush_insert_buffer_size"`
@@ -16,12 +16,14 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
 	"sync"
 	"syscall"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
@@ -74,6 +76,16 @@ type BaseTable struct {
 	RoleName   string
 	Log        log.Config
 	LogCfgFunc func(log.Config)
+
+	handlerLock    sync.RWMutex
+	changeHandlers map[string][]*changeHandler
+	// stopWatchYaml stops watching the config file, nil if it is not watched.
+	stopWatchYaml func()
+}
+
+// changeHandler is the handler registered by AddChangeHandler, the pointer identifies the handler to remove.
+type changeHandler struct {
+	handle func(value string)
 }
 
 // GlobalInitWithYaml initializes the param table with the given yaml.
@@ -184,17 +196,34 @@ func (gp *BaseTable) LoadRange(key, endKey string, limit int) ([]string, []strin
 }
 
 func (gp *BaseTable) LoadYaml(fileName string) error {
-	config := viper.New()
 	configFile := gp.configDir + fileName
 	if _, err := os.Stat(configFile); err != nil {
 		panic("cannot access config file: " + configFile)
 	}
 
+	values, err := readYaml(configFile)
+	if err != nil {
+		panic(err)
+	}
+	for key, str := range values {
+		err = gp.params.Save(key, str)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return nil
+}
+
+// readYaml reads the config file and returns the values by the lower case keys.
+func readYaml(configFile string) (map[string]string, error) {
+	config := viper.New()
 	config.SetConfigFile(configFile)
 	if err := config.ReadInConfig(); err != nil {
-		panic(err)
+		return nil, err
 	}
 
+	values := make(map[string]string)
 	for _, key := range config.AllKeys() {
 		val := config.Get(key)
 		str, err := cast.ToStringE(val)
@@ -205,7 +234,7 @@ func (gp *BaseTable) LoadYaml(fileName string) error {
 				for _, v := range val {
 					ss, err := cast.ToStringE(v)
 					if err != nil {
-						panic(err)
+						return nil, err
 					}
 					if str == "" {
 						str = ss
@@ -215,17 +244,95 @@ func (gp *BaseTable) LoadYaml(fileName string) error {
 				}
 
 			default:
-				panic("undefined config type, key=" + key)
+				return nil, fmt.Errorf("undefined config type, key=%s", key)
 			}
 		}
-		err = gp.params.Save(strings.ToLower(key), str)
-		if err != nil {
-			panic(err)
+		values[strings.ToLower(key)] = str
+	}
+	return values, nil
+}
+
+// watchYaml reloads the keys which have change handlers when the config file is changed, so the handlers are called
+// with the new values, the other keys still take effect after restart only. The directory is watched instead of the
+// file, since the file may be replaced rather than written, e.g. kubernetes updates a mounted ConfigMap by switching
+// a symlink. It returns the function to stop watching, nil if the file is not watched.
+func (gp *BaseTable) watchYaml(fileName string) func() {
+	if gp.configDir == "" {
+		return nil
+	}
+	configFile := filepath.Clean(gp.configDir + fileName)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Warn("failed to watch the config file", zap.String("file", configFile), zap.Error(err))
+		return nil
+	}
+	if err := watcher.Add(filepath.Dir(configFile)); err != nil {
+		log.Warn("failed to watch the config file", zap.String("file", configFile), zap.Error(err))
+		watcher.Close()
+		return nil
+	}
+
+	realConfigFile, _ := filepath.EvalSymlinks(configFile)
+	done := make(chan struct{})
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				currentConfigFile, _ := filepath.EvalSymlinks(configFile)
+				written := filepath.Clean(event.Name) == configFile && event.Op&(fsnotify.Write|fsnotify.Create) != 0
+				if !written && (currentConfigFile == "" || currentConfigFile == realConfigFile) {
+					continue
+				}
+				realConfigFile = currentConfigFile
+				gp.reloadYaml(configFile)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warn("config file watcher error", zap.String("file", configFile), zap.Error(err))
+			}
 		}
+	}()
+	// the handler may be removed while it is called by the reload, so the stop does not wait for the reload.
+	var stopOnce sync.Once
+	return func() {
+		stopOnce.Do(func() {
+			close(done)
+		})
+	}
+}
 
+// reloadYaml saves the changed values of the keys which have change handlers from the config file.
+func (gp *BaseTable) reloadYaml(configFile string) {
+	values, err := readYaml(configFile)
+	if err != nil {
+		log.Warn("failed to reload the config file", zap.String("file", configFile), zap.Error(err))
+		return
 	}
 
-	return nil
+	gp.handlerLock.RLock()
+	keys := make([]string, 0, len(gp.changeHandlers))
+	for key := range gp.changeHandlers {
+		keys = append(keys, key)
+	}
+	gp.handlerLock.RUnlock()
+
+	for _, key := range keys {
+		value, ok := values[key]
+		if !ok || value == gp.Get(key) {
+			continue
+		}
+		log.Info("config is changed in the config file", zap.String("key", key), zap.String("value", value))
+		if err := gp.Save(key, value); err != nil {
+			log.Warn("failed to save the changed config", zap.String("key", key), zap.Error(err))
+		}
+	}
 }
 
 func (gp *BaseTable) Get(key string) string {
@@ -237,7 +344,63 @@ func (gp *BaseTable) Remove(key string) error {
 }
 
 func (gp *BaseTable) Save(key, value string) error {
-	return gp.params.Save(strings.ToLower(key), value)
+	key = strings.ToLower(key)
+	if err := gp.params.Save(key, value); err != nil {
+		return err
+	}
+
+	gp.handlerLock.RLock()
+	handlers := gp.changeHandlers[key]
+	gp.handlerLock.RUnlock()
+	for _, handler := range handlers {
+		handler.handle(value)
+	}
+	return nil
+}
+
+// AddChangeHandler registers the handler which is called with the new value when the key is saved at runtime or
+// changed in the config file, the config file is watched once the first handler is registered. It returns the
+// function to remove the handler, the config file is not watched any more once all the handlers are removed.
+func (gp *BaseTable) AddChangeHandler(key string, handler func(value string)) func() {
+	gp.handlerLock.Lock()
+	defer gp.handlerLock.Unlock()
+
+	if gp.changeHandlers == nil {
+		gp.changeHandlers = make(map[string][]*changeHandler)
+		gp.stopWatchYaml = gp.watchYaml(defaultYaml)
+	}
+	key = strings.ToLower(key)
+	h := &changeHandler{handle: handler}
+	gp.changeHandlers[key] = append(gp.changeHandlers[key], h)
+	return func() {
+		gp.removeChangeHandler(key, h)
+	}
+}
+
+// removeChangeHandler removes the handler of the key, it stops watching the config file if no handler is left.
+func (gp *BaseTable) removeChangeHandler(key string, h *changeHandler) {
+	gp.handlerLock.Lock()
+	defer gp.handlerLock.Unlock()
+
+	// the handlers being called by Save are not changed, the slice is replaced instead.
+	handlers := make([]*changeHandler, 0, len(gp.changeHandlers[key]))
+	for _, handler := range gp.changeHandlers[key] {
+		if handler != h {
+			handlers = append(handlers, handler)
+		}
+	}
+	if len(handlers) == 0 {
+		delete(gp.changeHandlers, key)
+	} else {
+		gp.changeHandlers[key] = handlers
+	}
+	if len(gp.changeHandlers) == 0 && gp.changeHandlers != nil {
+		gp.changeHandlers = nil
+		if gp.stopWatchYaml != nil {
+			gp.stopWatchYaml()
+			gp.stopWatchYaml = nil
+		}
+	}
 }
 
 func (gp *BaseTable) ParseBool(key string, defaultValue bool) bool {
@@ -15,9 +15,11 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"path/filepath"
 
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/grpclog"
@@ -60,6 +62,94 @@ func TestBaseTable_SaveAndLoad(t *testing.T) {
 	assert.Nil(t, err6)
 }
 
+func TestBaseTable_AddChangeHandler(t *testing.T) {
+	var values []string
+	remove := baseParams.AddChangeHandler("Handler.Key", func(value string) {
+		values = append(values, value)
+	})
+
+	err := baseParams.Save("handler.key", "v1")
+	assert.Nil(t, err)
+	err = baseParams.LoadFromKVPair([]*commonpb.KeyValuePair{{Key: "handler.KEY", Value: "v2"}})
+	assert.Nil(t, err)
+	err = baseParams.Save("handler.other", "v3")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, values)
+
+	// the removed handler is not called, and removing it again changes nothing.
+	remove()
+	remove()
+	err = baseParams.Save("handler.key", "v4")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, values)
+}
+
+func TestBaseTable_WatchYaml(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, defaultYaml)
+	err := os.WriteFile(configFile, []byte("watch:\n  key: v1\n  other: o1\n"), 0600)
+	assert.Nil(t, err)
+
+	bt := BaseTable{params: memkv.NewMemoryKV(), configDir: dir + "/"}
+	err = bt.LoadYaml(defaultYaml)
+	assert.Nil(t, err)
+	values := make(chan string, 10)
+	bt.AddChangeHandler("watch.key", func(value string) {
+		values <- value
+	})
+
+	err = os.WriteFile(configFile, []byte("watch:\n  key: v2\n  other: o2\n"), 0600)
+	assert.Nil(t, err)
+	select {
+	case value := <-values:
+		assert.Equal(t, "v2", value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change handler is not called after the config file is changed")
+	}
+	assert.Equal(t, "v2", bt.Get("watch.key"))
+	// only the keys with change handlers are reloaded.
+	assert.Equal(t, "o1", bt.Get("watch.other"))
+}
+
+func TestBaseTable_StopWatchYaml(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, defaultYaml)
+	err := os.WriteFile(configFile, []byte("watch:\n  key: v1\n"), 0600)
+	assert.Nil(t, err)
+
+	bt := BaseTable{params: memkv.NewMemoryKV(), configDir: dir + "/"}
+	err = bt.LoadYaml(defaultYaml)
+	assert.Nil(t, err)
+	removeKey := bt.AddChangeHandler("watch.key", func(value string) {})
+	removeOther := bt.AddChangeHandler("watch.other", func(value string) {})
+	assert.NotNil(t, bt.stopWatchYaml)
+
+	// the config file is watched until all the handlers are removed.
+	removeKey()
+	assert.NotNil(t, bt.stopWatchYaml)
+	removeOther()
+	assert.Nil(t, bt.stopWatchYaml)
+	err = os.WriteFile(configFile, []byte("watch:\n  key: v2\n"), 0600)
+	assert.Nil(t, err)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, "v1", bt.Get("watch.key"))
+
+	// the config file is watched again with the new handler.
+	values := make(chan string, 10)
+	defer bt.AddChangeHandler("watch.key", func(value string) {
+		values <- value
+	})()
+	assert.NotNil(t, bt.stopWatchYaml)
+	err = os.WriteFile(configFile, []byte("watch:\n  key: v3\n"), 0600)
+	assert.Nil(t, err)
+	select {
+	case value := <-values:
+		assert.Equal(t, "v3", value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change handler is not called after the config file is changed")
+	}
+}
+
 func TestBaseTable_LoadFromKVPair(t *testing.T) {
 	var kvPairs []*commonpb.KeyValuePair
 	kvPairs = append(kvPairs, &commonpb.KeyValuePair{Key: "k1", Value: "v1"}, &commonpb.KeyValuePair{Key: "k2", Value: "v2"})
@@ -309,20 +399,22 @@ func Test_ConvertRangeToIntSlice(t *testing.T) {
 }
 
 func Test_SetLogger(t *testing.T) {
+	// the log files are written to the temp dir, so they are not left in the source tree.
+	rootPath := t.TempDir()
 	t.Run("TestSetLooger", func(t *testing.T) {
 		baseParams.RoleName = "rootcoord"
-		baseParams.Save("log.file.rootPath", ".")
+		baseParams.Save("log.file.rootPath", rootPath)
 		baseParams.SetLogger(UniqueID(-1))
 		fmt.Println(baseParams.Log.File.Filename)
-		assert.Equal(t, "rootcoord.log", baseParams.Log.File.Filename)
+		assert.Equal(t, filepath.Join(rootPath, "rootcoord.log"), baseParams.Log.File.Filename)
 
 		baseParams.RoleName = "datanode"
 		baseParams.SetLogger(UniqueID(1))
-		assert.Equal(t, "datanode-1.log", baseParams.Log.File.Filename)
+		assert.Equal(t, filepath.Join(rootPath, "datanode-1.log"), baseParams.Log.File.Filename)
 
 		baseParams.RoleName = "datanode"
 		baseParams.SetLogger(UniqueID(0))
-		assert.Equal(t, "datanode-0.log", baseParams.Log.File.Filename)
+		assert.Equal(t, filepath.Join(rootPath, "datanode-0.log"), baseParams.Log.File.Filename)
 	})
 
 	t.Run("TestGrpclog", func(t *testing.T) {
@@ -1176,6 +1176,68 @@ type indexCoordConfig struct {
 
 	GCInterval time.Duration
 
+	RebuildInterval    time.Duration
+	RebuildDeleteRatio float64
+	RebuildMaxTasks    int64
+
+	MaxTaskRetry     int64
+	RetryBackoffBase time.Duration
+	RetryBackoffMax  time.Duration
+	RetryJitter      time.Duration
+
+	MaxConcurrentTasksPerNode int64
+
+	TaskTimeout time.Duration
+
+	MaxReleaseLockRetry   int64
+	LockHoldWarnThreshold time.Duration
+
+	FairnessWindow int64
+
+	MaxTasksPerRun     int64
+	ProcessParallelism int64
+
+	GracefulStopTimeout time.Duration
+
+	NodeSelectPolicy string
+	OrderingPolicy   string
+
+	NodeAssignFailureThreshold int64
+	NodeAssignFailureCooldown  time.Duration
+
+	PeakWindows string
+
+	BalanceByCost bool
+
+	VerifyIndexFiles bool
+
+	StarvingTaskThreshold time.Duration
+
+	CleanupParallelism  int64
+	DoneTaskLockRelease string
+
+	PausedTaskLockGracePeriod time.Duration
+
+	CompletedTaskHistorySize int64
+
+	NotifyDebounce time.Duration
+
+	FailedTaskRetention time.Duration
+
+	MaxTrackedTasks int64
+
+	LockAcquireRate float64
+
+	ScheduleLogChangeRatio float64
+
+	MaxConcurrentTasksPerCollection int64
+
+	AllowNodePinning bool
+
+	LivenessCheckInterval time.Duration
+
+	ScheduleInterval time.Duration
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -1184,6 +1246,42 @@ func (p *indexCoordConfig) init(base *BaseTable) {
 	p.Base = base
 
 	p.initGCInterval()
+	p.initRebuildInterval()
+	p.initRebuildDeleteRatio()
+	p.initRebuildMaxTasks()
+	p.initMaxTaskRetry()
+	p.initRetryBackoffBase()
+	p.initRetryBackoffMax()
+	p.initRetryJitter()
+	p.initMaxConcurrentTasksPerNode()
+	p.initTaskTimeout()
+	p.initMaxReleaseLockRetry()
+	p.initLockHoldWarnThreshold()
+	p.initFairnessWindow()
+	p.initMaxTasksPerRun()
+	p.initProcessParallelism()
+	p.initGracefulStopTimeout()
+	p.initNodeSelectPolicy()
+	p.initOrderingPolicy()
+	p.initNodeAssignFailureThreshold()
+	p.initNodeAssignFailureCooldown()
+	p.initPeakWindows()
+	p.initBalanceByCost()
+	p.initVerifyIndexFiles()
+	p.initStarvingTaskThreshold()
+	p.initCleanupParallelism()
+	p.initDoneTaskLockRelease()
+	p.initPausedTaskLockGracePeriod()
+	p.initCompletedTaskHistorySize()
+	p.initNotifyDebounce()
+	p.initFailedTaskRetention()
+	p.initMaxTrackedTasks()
+	p.initLockAcquireRate()
+	p.initScheduleLogChangeRatio()
+	p.initMaxConcurrentTasksPerCollection()
+	p.initAllowNodePinning()
+	p.initLivenessCheckInterval()
+	p.initScheduleInterval()
 }
 
 func (p *indexCoordConfig) initMinSegmentNumRowsToEnableIndex() {
@@ -1194,6 +1292,174 @@ func (p *indexCoordConfig) initGCInterval() {
 	p.GCInterval = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.gc.interval", 60*10)) * time.Second
 }
 
+func (p *indexCoordConfig) initRebuildInterval() {
+	p.RebuildInterval = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.rebuild.interval", 0)) * time.Second
+}
+
+func (p *indexCoordConfig) initRebuildDeleteRatio() {
+	p.RebuildDeleteRatio = p.Base.ParseFloatWithDefault("indexCoord.rebuild.deleteRatio", 0)
+}
+
+func (p *indexCoordConfig) initRebuildMaxTasks() {
+	p.RebuildMaxTasks = p.Base.ParseInt64WithDefault("indexCoord.rebuild.maxTasks", 10)
+}
+
+func (p *indexCoordConfig) initMaxTaskRetry() {
+	p.MaxTaskRetry = p.Base.ParseInt64WithDefault("indexCoord.scheduler.maxTaskRetry", 10)
+}
+
+func (p *indexCoordConfig) initRetryBackoffBase() {
+	p.RetryBackoffBase = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.retryBackoffBase", 2)) * time.Second
+}
+
+func (p *indexCoordConfig) initRetryBackoffMax() {
+	p.RetryBackoffMax = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.retryBackoffMax", 60)) * time.Second
+}
+
+func (p *indexCoordConfig) initRetryJitter() {
+	p.RetryJitter = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.retryJitter", 0)) * time.Millisecond
+}
+
+func (p *indexCoordConfig) initMaxConcurrentTasksPerNode() {
+	p.MaxConcurrentTasksPerNode = p.Base.ParseInt64WithDefault("indexCoord.scheduler.maxConcurrentTasksPerNode", 0)
+}
+
+func (p *indexCoordConfig) initTaskTimeout() {
+	p.TaskTimeout = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.taskTimeout", 0)) * time.Second
+}
+
+func (p *indexCoordConfig) initMaxReleaseLockRetry() {
+	p.MaxReleaseLockRetry = p.Base.ParseInt64WithDefault("indexCoord.scheduler.maxReleaseLockRetry", 10)
+}
+
+func (p *indexCoordConfig) initLockHoldWarnThreshold() {
+	p.LockHoldWarnThreshold = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.lockHoldWarnThreshold", 3600)) * time.Second
+}
+
+func (p *indexCoordConfig) initFairnessWindow() {
+	p.FairnessWindow = p.Base.ParseInt64WithDefault("indexCoord.scheduler.fairnessWindow", 0)
+}
+
+func (p *indexCoordConfig) initMaxTasksPerRun() {
+	p.MaxTasksPerRun = p.Base.ParseInt64WithDefault("indexCoord.scheduler.maxTasksPerRun", 0)
+}
+
+func (p *indexCoordConfig) initProcessParallelism() {
+	p.ProcessParallelism = p.Base.ParseInt64WithDefault("indexCoord.scheduler.processParallelism", 0)
+}
+
+func (p *indexCoordConfig) initGracefulStopTimeout() {
+	p.GracefulStopTimeout = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.gracefulStopTimeout", 5)) * time.Second
+}
+
+func (p *indexCoordConfig) initNodeSelectPolicy() {
+	p.NodeSelectPolicy = p.Base.LoadWithDefault("indexCoord.scheduler.nodeSelectPolicy", "roundRobin")
+}
+
+// initOrderingPolicy loads the order in which the index tasks are processed, they are processed by buildID by default.
+// The priorities of the tasks, such as the retries, the manual rebuilds and the priorities set by users, only apply
+// with the priority policy, the other policies ignore them.
+func (p *indexCoordConfig) initOrderingPolicy() {
+	p.OrderingPolicy = p.Base.LoadWithDefault("indexCoord.scheduler.orderingPolicy", "buildID")
+}
+
+func (p *indexCoordConfig) initNodeAssignFailureThreshold() {
+	p.NodeAssignFailureThreshold = p.Base.ParseInt64WithDefault("indexCoord.scheduler.nodeAssignFailureThreshold", 5)
+}
+
+func (p *indexCoordConfig) initNodeAssignFailureCooldown() {
+	p.NodeAssignFailureCooldown = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.nodeAssignFailureCooldown", 30)) * time.Second
+}
+
+func (p *indexCoordConfig) initPeakWindows() {
+	p.PeakWindows = p.Base.LoadWithDefault("indexCoord.scheduler.peakWindows", "")
+}
+
+func (p *indexCoordConfig) initBalanceByCost() {
+	p.BalanceByCost = p.Base.ParseBool("indexCoord.scheduler.balanceByCost", false)
+}
+
+func (p *indexCoordConfig) initVerifyIndexFiles() {
+	p.VerifyIndexFiles = p.Base.ParseBool("indexCoord.scheduler.verifyIndexFiles", false)
+}
+
+func (p *indexCoordConfig) initStarvingTaskThreshold() {
+	p.StarvingTaskThreshold = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.starvingTaskThreshold", 600)) * time.Second
+}
+
+func (p *indexCoordConfig) initPausedTaskLockGracePeriod() {
+	p.PausedTaskLockGracePeriod = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.pausedTaskLockGracePeriod", 0)) * time.Second
+}
+
+func (p *indexCoordConfig) initCompletedTaskHistorySize() {
+	p.CompletedTaskHistorySize = p.Base.ParseInt64WithDefault("indexCoord.scheduler.completedTaskHistorySize", 0)
+}
+
+func (p *indexCoordConfig) initNotifyDebounce() {
+	p.NotifyDebounce = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.notifyDebounce", 50)) * time.Millisecond
+}
+
+func (p *indexCoordConfig) initFailedTaskRetention() {
+	p.FailedTaskRetention = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.failedTaskRetention", 0)) * time.Second
+}
+
+func (p *indexCoordConfig) initMaxTrackedTasks() {
+	p.MaxTrackedTasks = p.Base.ParseInt64WithDefault("indexCoord.scheduler.maxTrackedTasks", 0)
+}
+
+func (p *indexCoordConfig) initLockAcquireRate() {
+	p.LockAcquireRate = p.Base.ParseFloatWithDefault("indexCoord.scheduler.lockAcquireRate", 0)
+}
+
+func (p *indexCoordConfig) initScheduleLogChangeRatio() {
+	p.ScheduleLogChangeRatio = p.Base.ParseFloatWithDefault("indexCoord.scheduler.scheduleLogChangeRatio", 0.1)
+}
+
+func (p *indexCoordConfig) initMaxConcurrentTasksPerCollection() {
+	p.MaxConcurrentTasksPerCollection = p.Base.ParseInt64WithDefault("indexCoord.scheduler.maxConcurrentTasksPerCollection", 0)
+}
+
+func (p *indexCoordConfig) initAllowNodePinning() {
+	p.AllowNodePinning = p.Base.ParseBool("indexCoord.scheduler.allowNodePinning", false)
+}
+
+func (p *indexCoordConfig) initLivenessCheckInterval() {
+	p.LivenessCheckInterval = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.livenessCheckInterval", 0)) * time.Second
+}
+
+func (p *indexCoordConfig) initCleanupParallelism() {
+	p.CleanupParallelism = p.Base.ParseInt64WithDefault("indexCoord.scheduler.cleanupParallelism", 4)
+}
+
+func (p *indexCoordConfig) initDoneTaskLockRelease() {
+	p.DoneTaskLockRelease = p.Base.LoadWithDefault("indexCoord.scheduler.doneTaskLockRelease", "async")
+}
+
+func (p *indexCoordConfig) initScheduleInterval() {
+	p.ScheduleInterval = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.scheduler.scheduleInterval", 3000)) * time.Millisecond
+}
+
+// WatchScheduleInterval calls the handler with the new schedule interval when it is updated at runtime,
+// the invalid values are ignored. It returns the function to stop calling the handler.
+func (p *indexCoordConfig) WatchScheduleInterval(handler func(interval time.Duration)) func() {
+	return p.Base.AddChangeHandler("indexCoord.scheduler.scheduleInterval", func(value string) {
+		interval, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || interval <= 0 {
+			log.Warn("invalid index coord schedule interval, ignore it", zap.String("value", value))
+			return
+		}
+		handler(time.Duration(interval) * time.Millisecond)
+	})
+}
+
+// WatchPeakWindows calls the handler with the new peak windows when they are updated at runtime. It returns the
+// function to stop calling the handler.
+func (p *indexCoordConfig) WatchPeakWindows(handler func(value string)) func() {
+	return p.Base.AddChangeHandler("indexCoord.scheduler.peakWindows", func(value string) {
+		handler(value)
+	})
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- indexnode ---
 type indexNodeConfig struct {
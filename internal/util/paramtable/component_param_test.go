@@ -318,6 +318,70 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("Port: %v", Params.Port)
 
+		assert.Equal(t, time.Duration(0), Params.RebuildInterval)
+		assert.Equal(t, float64(0), Params.RebuildDeleteRatio)
+		assert.Equal(t, int64(10), Params.RebuildMaxTasks)
+
+		assert.Equal(t, int64(10), Params.MaxTaskRetry)
+		assert.Equal(t, 2*time.Second, Params.RetryBackoffBase)
+		assert.Equal(t, 60*time.Second, Params.RetryBackoffMax)
+		assert.Equal(t, time.Duration(0), Params.RetryJitter)
+		assert.Equal(t, int64(0), Params.MaxConcurrentTasksPerNode)
+		assert.Equal(t, time.Duration(0), Params.TaskTimeout)
+		assert.Equal(t, int64(10), Params.MaxReleaseLockRetry)
+		assert.Equal(t, time.Hour, Params.LockHoldWarnThreshold)
+		assert.Equal(t, int64(0), Params.FairnessWindow)
+		assert.Equal(t, int64(0), Params.MaxTasksPerRun)
+		assert.Equal(t, int64(0), Params.ProcessParallelism)
+		assert.Equal(t, 5*time.Second, Params.GracefulStopTimeout)
+		assert.Equal(t, "roundRobin", Params.NodeSelectPolicy)
+		assert.Equal(t, "buildID", Params.OrderingPolicy)
+		// the tasks are ordered by buildID if the ordering policy is not set.
+		Params.Base.Remove("indexCoord.scheduler.orderingPolicy")
+		Params.initOrderingPolicy()
+		assert.Equal(t, "buildID", Params.OrderingPolicy)
+		assert.Equal(t, int64(5), Params.NodeAssignFailureThreshold)
+		assert.Equal(t, 30*time.Second, Params.NodeAssignFailureCooldown)
+		assert.Equal(t, "", Params.PeakWindows)
+		assert.False(t, Params.BalanceByCost)
+		assert.False(t, Params.VerifyIndexFiles)
+		assert.Equal(t, 600*time.Second, Params.StarvingTaskThreshold)
+		assert.Equal(t, int64(4), Params.CleanupParallelism)
+		assert.Equal(t, "async", Params.DoneTaskLockRelease)
+		assert.Equal(t, time.Duration(0), Params.PausedTaskLockGracePeriod)
+		assert.Equal(t, int64(0), Params.CompletedTaskHistorySize)
+		assert.Equal(t, 50*time.Millisecond, Params.NotifyDebounce)
+		assert.Equal(t, time.Duration(0), Params.FailedTaskRetention)
+		assert.Equal(t, int64(0), Params.MaxTrackedTasks)
+		assert.Equal(t, float64(0), Params.LockAcquireRate)
+		assert.Equal(t, 0.1, Params.ScheduleLogChangeRatio)
+		assert.Equal(t, int64(0), Params.MaxConcurrentTasksPerCollection)
+		assert.False(t, Params.AllowNodePinning)
+		assert.Equal(t, time.Duration(0), Params.LivenessCheckInterval)
+		assert.Equal(t, 3*time.Second, Params.ScheduleInterval)
+
+		var interval time.Duration
+		unwatch := Params.WatchScheduleInterval(func(newInterval time.Duration) {
+			interval = newInterval
+		})
+		Params.Base.Save("indexCoord.scheduler.scheduleInterval", "-1")
+		assert.Equal(t, time.Duration(0), interval)
+		Params.Base.Save("indexCoord.scheduler.scheduleInterval", "500")
+		assert.Equal(t, 500*time.Millisecond, interval)
+		unwatch()
+		Params.Base.Save("indexCoord.scheduler.scheduleInterval", "3000")
+		assert.Equal(t, 500*time.Millisecond, interval)
+
+		var peakWindows string
+		unwatch = Params.WatchPeakWindows(func(value string) {
+			peakWindows = value
+		})
+		Params.Base.Save("indexCoord.scheduler.peakWindows", "09:00-18:00=2")
+		assert.Equal(t, "09:00-18:00=2", peakWindows)
+		unwatch()
+		Params.Base.Save("indexCoord.scheduler.peakWindows", "")
+		assert.Equal(t, "09:00-18:00=2", peakWindows)
+
 		Params.CreatedTime = time.Now()
 		t.Logf("CreatedTime: %v", Params.CreatedTime)
 
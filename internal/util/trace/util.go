@@ -192,6 +192,28 @@ func InjectContextToPulsarMsgProperties(sc opentracing.SpanContext, properties m
 	tracer.Inject(sc, opentracing.TextMap, PropertiesReaderWriter{properties})
 }
 
+// InjectContextToMap returns the span context as a map, such as to carry it in a field of a request, nil is returned
+// if the tracer injects nothing, such as the noop tracer.
+func InjectContextToMap(sc opentracing.SpanContext) map[string]string {
+	carrier := make(map[string]string)
+	if err := opentracing.GlobalTracer().Inject(sc, opentracing.TextMap, PropertiesReaderWriter{carrier}); err != nil || len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// ExtractContextFromMap returns the span context carried in the map, nil if there is no valid span context.
+func ExtractContextFromMap(carrier map[string]string) opentracing.SpanContext {
+	if len(carrier) == 0 {
+		return nil
+	}
+	sc, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, PropertiesReaderWriter{carrier})
+	if err != nil {
+		return nil
+	}
+	return sc
+}
+
 // PropertiesReaderWriter is for saving trce in pulsar msg properties.
 // Implement Set and ForeachKey methods.
 type PropertiesReaderWriter struct {
@@ -107,6 +107,19 @@ func TestInject(t *testing.T) {
 
 }
 
+func TestInjectContextToMap(t *testing.T) {
+	sp, _ := StartSpanFromContext(context.Background())
+	defer sp.Finish()
+	carrier := InjectContextToMap(sp.Context())
+	assert.NotEmpty(t, carrier)
+	assert.NotNil(t, ExtractContextFromMap(carrier))
+
+	assert.Nil(t, ExtractContextFromMap(nil))
+	assert.Nil(t, ExtractContextFromMap(map[string]string{"uber-trace-id": "invalid"}))
+	// nothing is injected by the noop tracer.
+	assert.Nil(t, InjectContextToMap(NoopSpan().Context()))
+}
+
 func TestTraceError(t *testing.T) {
 	// context normally can be propagated through func params
 	sp, ctx := StartSpanFromContext(nil)